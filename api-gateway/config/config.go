@@ -36,6 +36,7 @@ type (
 		AuthServiceURL       string `env:"GRPC_AUTH_SERVICE_URL,required" envDefault:"0.0.0.0:8080"`
 		StatisticsServiceURL string `env:"GRPC_STATISTICS_SERVICE_URL,required" envDefault:"0.0.0.0:8083"`
 		UserServiceURL       string `env:"GRPC_USER_SERVICE_URL,required" envDefault:"0.0.0.0:8082"`
+		GameServiceURL       string `env:"GRPC_GAME_SERVICE_URL,required" envDefault:"0.0.0.0:8084"`
 	}
 	JWTManager struct {
 		SecretKey string `env:"JWT_MANAGER_SECRET_KEY,notEmpty"`
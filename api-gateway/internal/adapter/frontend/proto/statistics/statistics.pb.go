@@ -65,6 +65,8 @@ type GeneralGameStats struct {
 	TotalGamesPlayed int64                  `protobuf:"varint,2,opt,name=total_games_played,json=totalGamesPlayed,proto3" json:"total_games_played,omitempty"`
 	TotalBetAmount   int64                  `protobuf:"varint,3,opt,name=total_bet_amount,json=totalBetAmount,proto3" json:"total_bet_amount,omitempty"`
 	LastUpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_updated_at,json=lastUpdatedAt,proto3" json:"last_updated_at,omitempty"`
+	TotalRake        int64                  `protobuf:"varint,5,opt,name=total_rake,json=totalRake,proto3" json:"total_rake,omitempty"`
+	TotalJackpotPaid int64                  `protobuf:"varint,6,opt,name=total_jackpot_paid,json=totalJackpotPaid,proto3" json:"total_jackpot_paid,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -127,6 +129,20 @@ func (x *GeneralGameStats) GetLastUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *GeneralGameStats) GetTotalRake() int64 {
+	if x != nil {
+		return x.TotalRake
+	}
+	return 0
+}
+
+func (x *GeneralGameStats) GetTotalJackpotPaid() int64 {
+	if x != nil {
+		return x.TotalJackpotPaid
+	}
+	return 0
+}
+
 type GetGeneralGameStatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Stats         *GeneralGameStats      `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
@@ -217,22 +233,23 @@ func (x *GetUserGameStatsRequest) GetUserId() int64 {
 }
 
 type UserGameStats struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	UserId           int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	GamesPlayed      int64                  `protobuf:"varint,2,opt,name=games_played,json=gamesPlayed,proto3" json:"games_played,omitempty"`
-	GamesWon         int64                  `protobuf:"varint,3,opt,name=games_won,json=gamesWon,proto3" json:"games_won,omitempty"`
-	GamesLost        int64                  `protobuf:"varint,4,opt,name=games_lost,json=gamesLost,proto3" json:"games_lost,omitempty"`
-	GamesDrawn       int64                  `protobuf:"varint,5,opt,name=games_drawn,json=gamesDrawn,proto3" json:"games_drawn,omitempty"`
-	TotalBet         int64                  `protobuf:"varint,6,opt,name=total_bet,json=totalBet,proto3" json:"total_bet,omitempty"`
-	TotalWinnings    int64                  `protobuf:"varint,7,opt,name=total_winnings,json=totalWinnings,proto3" json:"total_winnings,omitempty"`
-	TotalLosses      int64                  `protobuf:"varint,8,opt,name=total_losses,json=totalLosses,proto3" json:"total_losses,omitempty"`
-	WinRate          float64                `protobuf:"fixed64,9,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`     // float64 maps to double
-	LossRate         float64                `protobuf:"fixed64,10,opt,name=loss_rate,json=lossRate,proto3" json:"loss_rate,omitempty"` // float64 maps to double
-	WinStreak        int64                  `protobuf:"varint,11,opt,name=win_streak,json=winStreak,proto3" json:"win_streak,omitempty"`
-	LossStreak       int64                  `protobuf:"varint,12,opt,name=loss_streak,json=lossStreak,proto3" json:"loss_streak,omitempty"`
-	LastGamePlayedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=last_game_played_at,json=lastGamePlayedAt,proto3" json:"last_game_played_at,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GamesPlayed       int64                  `protobuf:"varint,2,opt,name=games_played,json=gamesPlayed,proto3" json:"games_played,omitempty"`
+	GamesWon          int64                  `protobuf:"varint,3,opt,name=games_won,json=gamesWon,proto3" json:"games_won,omitempty"`
+	GamesLost         int64                  `protobuf:"varint,4,opt,name=games_lost,json=gamesLost,proto3" json:"games_lost,omitempty"`
+	GamesDrawn        int64                  `protobuf:"varint,5,opt,name=games_drawn,json=gamesDrawn,proto3" json:"games_drawn,omitempty"`
+	TotalBet          int64                  `protobuf:"varint,6,opt,name=total_bet,json=totalBet,proto3" json:"total_bet,omitempty"`
+	TotalWinnings     int64                  `protobuf:"varint,7,opt,name=total_winnings,json=totalWinnings,proto3" json:"total_winnings,omitempty"`
+	TotalLosses       int64                  `protobuf:"varint,8,opt,name=total_losses,json=totalLosses,proto3" json:"total_losses,omitempty"`
+	WinRate           float64                `protobuf:"fixed64,9,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`     // float64 maps to double
+	LossRate          float64                `protobuf:"fixed64,10,opt,name=loss_rate,json=lossRate,proto3" json:"loss_rate,omitempty"` // float64 maps to double
+	WinStreak         int64                  `protobuf:"varint,11,opt,name=win_streak,json=winStreak,proto3" json:"win_streak,omitempty"`
+	LossStreak        int64                  `protobuf:"varint,12,opt,name=loss_streak,json=lossStreak,proto3" json:"loss_streak,omitempty"`
+	LastGamePlayedAt  *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=last_game_played_at,json=lastGamePlayedAt,proto3" json:"last_game_played_at,omitempty"`
+	RankedGamesPlayed int64                  `protobuf:"varint,14,opt,name=ranked_games_played,json=rankedGamesPlayed,proto3" json:"ranked_games_played,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *UserGameStats) Reset() {
@@ -356,6 +373,13 @@ func (x *UserGameStats) GetLastGamePlayedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *UserGameStats) GetRankedGamesPlayed() int64 {
+	if x != nil {
+		return x.RankedGamesPlayed
+	}
+	return 0
+}
+
 type GetUserGameStatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Stats         *UserGameStats         `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
@@ -617,61 +641,870 @@ func (x *GetLeaderboardResponse) GetLeaderboard() *Leaderboard {
 	return nil
 }
 
-var File_statistics_proto protoreflect.FileDescriptor
+// --- Weekly League ---
+type GetUserLeagueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_statistics_proto_rawDesc = "" +
-	"\n" +
-	"\x10statistics.proto\x12\n" +
-	"statistics\x1a\x1fgoogle/protobuf/timestamp.proto\"\x1c\n" +
-	"\x1aGetGeneralGameStatsRequest\"\xcf\x01\n" +
-	"\x10GeneralGameStats\x12\x1f\n" +
-	"\vtotal_users\x18\x01 \x01(\x03R\n" +
-	"totalUsers\x12,\n" +
-	"\x12total_games_played\x18\x02 \x01(\x03R\x10totalGamesPlayed\x12(\n" +
-	"\x10total_bet_amount\x18\x03 \x01(\x03R\x0etotalBetAmount\x12B\n" +
-	"\x0flast_updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastUpdatedAt\"Q\n" +
-	"\x1bGetGeneralGameStatsResponse\x122\n" +
-	"\x05stats\x18\x01 \x01(\v2\x1c.statistics.GeneralGameStatsR\x05stats\"2\n" +
-	"\x17GetUserGameStatsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\xd2\x03\n" +
-	"\rUserGameStats\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12!\n" +
-	"\fgames_played\x18\x02 \x01(\x03R\vgamesPlayed\x12\x1b\n" +
-	"\tgames_won\x18\x03 \x01(\x03R\bgamesWon\x12\x1d\n" +
-	"\n" +
-	"games_lost\x18\x04 \x01(\x03R\tgamesLost\x12\x1f\n" +
-	"\vgames_drawn\x18\x05 \x01(\x03R\n" +
-	"gamesDrawn\x12\x1b\n" +
-	"\ttotal_bet\x18\x06 \x01(\x03R\btotalBet\x12%\n" +
-	"\x0etotal_winnings\x18\a \x01(\x03R\rtotalWinnings\x12!\n" +
-	"\ftotal_losses\x18\b \x01(\x03R\vtotalLosses\x12\x19\n" +
-	"\bwin_rate\x18\t \x01(\x01R\awinRate\x12\x1b\n" +
-	"\tloss_rate\x18\n" +
-	" \x01(\x01R\blossRate\x12\x1d\n" +
-	"\n" +
-	"win_streak\x18\v \x01(\x03R\twinStreak\x12\x1f\n" +
-	"\vloss_streak\x18\f \x01(\x03R\n" +
-	"lossStreak\x12I\n" +
-	"\x13last_game_played_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x10lastGamePlayedAt\"K\n" +
-	"\x18GetUserGameStatsResponse\x12/\n" +
-	"\x05stats\x18\x01 \x01(\v2\x19.statistics.UserGameStatsR\x05stats\"X\n" +
-	"\x15GetLeaderboardRequest\x12)\n" +
-	"\x10leaderboard_type\x18\x01 \x01(\tR\x0fleaderboardType\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"q\n" +
-	"\x10LeaderboardEntry\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
-	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
-	"\x05score\x18\x03 \x01(\x03R\x05score\x12\x12\n" +
-	"\x04rank\x18\x04 \x01(\x05R\x04rank\"Y\n" +
-	"\vLeaderboard\x12\x12\n" +
-	"\x04type\x18\x01 \x01(\tR\x04type\x126\n" +
-	"\aentries\x18\x02 \x03(\v2\x1c.statistics.LeaderboardEntryR\aentries\"S\n" +
-	"\x16GetLeaderboardResponse\x129\n" +
-	"\vleaderboard\x18\x01 \x01(\v2\x17.statistics.LeaderboardR\vleaderboard2\xb3\x02\n" +
+func (x *GetUserLeagueRequest) Reset() {
+	*x = GetUserLeagueRequest{}
+	mi := &file_statistics_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserLeagueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserLeagueRequest) ProtoMessage() {}
+
+func (x *GetUserLeagueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_statistics_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserLeagueRequest.ProtoReflect.Descriptor instead.
+func (*GetUserLeagueRequest) Descriptor() ([]byte, []int) {
+	return file_statistics_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetUserLeagueRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type LeagueStanding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Score         int64                  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"` // chips won this week
+	Rank          int32                  `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	Zone          string                 `protobuf:"bytes,4,opt,name=zone,proto3" json:"zone,omitempty"` // "promotion", "relegation" or empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeagueStanding) Reset() {
+	*x = LeagueStanding{}
+	mi := &file_statistics_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeagueStanding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeagueStanding) ProtoMessage() {}
+
+func (x *LeagueStanding) ProtoReflect() protoreflect.Message {
+	mi := &file_statistics_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeagueStanding.ProtoReflect.Descriptor instead.
+func (*LeagueStanding) Descriptor() ([]byte, []int) {
+	return file_statistics_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LeagueStanding) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LeagueStanding) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *LeagueStanding) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeagueStanding) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+type LeagueGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Week          string                 `protobuf:"bytes,1,opt,name=week,proto3" json:"week,omitempty"`          // ISO week, e.g. "2026-W43"
+	Division      int32                  `protobuf:"varint,2,opt,name=division,proto3" json:"division,omitempty"` // 0 is the lowest
+	DivisionName  string                 `protobuf:"bytes,3,opt,name=division_name,json=divisionName,proto3" json:"division_name,omitempty"`
+	Group         int32                  `protobuf:"varint,4,opt,name=group,proto3" json:"group,omitempty"`
+	Joined        bool                   `protobuf:"varint,5,opt,name=joined,proto3" json:"joined,omitempty"` // false until the player finishes a game this week
+	Standings     []*LeagueStanding      `protobuf:"bytes,6,rep,name=standings,proto3" json:"standings,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	PromoteCount  int32                  `protobuf:"varint,8,opt,name=promote_count,json=promoteCount,proto3" json:"promote_count,omitempty"`
+	RelegateCount int32                  `protobuf:"varint,9,opt,name=relegate_count,json=relegateCount,proto3" json:"relegate_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeagueGroup) Reset() {
+	*x = LeagueGroup{}
+	mi := &file_statistics_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeagueGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeagueGroup) ProtoMessage() {}
+
+func (x *LeagueGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_statistics_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeagueGroup.ProtoReflect.Descriptor instead.
+func (*LeagueGroup) Descriptor() ([]byte, []int) {
+	return file_statistics_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LeagueGroup) GetWeek() string {
+	if x != nil {
+		return x.Week
+	}
+	return ""
+}
+
+func (x *LeagueGroup) GetDivision() int32 {
+	if x != nil {
+		return x.Division
+	}
+	return 0
+}
+
+func (x *LeagueGroup) GetDivisionName() string {
+	if x != nil {
+		return x.DivisionName
+	}
+	return ""
+}
+
+func (x *LeagueGroup) GetGroup() int32 {
+	if x != nil {
+		return x.Group
+	}
+	return 0
+}
+
+func (x *LeagueGroup) GetJoined() bool {
+	if x != nil {
+		return x.Joined
+	}
+	return false
+}
+
+func (x *LeagueGroup) GetStandings() []*LeagueStanding {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+func (x *LeagueGroup) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *LeagueGroup) GetPromoteCount() int32 {
+	if x != nil {
+		return x.PromoteCount
+	}
+	return 0
+}
+
+func (x *LeagueGroup) GetRelegateCount() int32 {
+	if x != nil {
+		return x.RelegateCount
+	}
+	return 0
+}
+
+type GetUserLeagueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	League        *LeagueGroup           `protobuf:"bytes,1,opt,name=league,proto3" json:"league,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserLeagueResponse) Reset() {
+	*x = GetUserLeagueResponse{}
+	mi := &file_statistics_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserLeagueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserLeagueResponse) ProtoMessage() {}
+
+func (x *GetUserLeagueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_statistics_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserLeagueResponse.ProtoReflect.Descriptor instead.
+func (*GetUserLeagueResponse) Descriptor() ([]byte, []int) {
+	return file_statistics_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetUserLeagueResponse) GetLeague() *LeagueGroup {
+	if x != nil {
+		return x.League
+	}
+	return nil
+}
+
+// --- Achievements ---
+type GetUserAchievementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserAchievementsRequest) Reset() {
+	*x = GetUserAchievementsRequest{}
+	mi := &file_statistics_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserAchievementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserAchievementsRequest) ProtoMessage() {}
+
+func (x *GetUserAchievementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_statistics_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserAchievementsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserAchievementsRequest) Descriptor() ([]byte, []int) {
+	return file_statistics_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetUserAchievementsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type Achievement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Unlocked      bool                   `protobuf:"varint,4,opt,name=unlocked,proto3" json:"unlocked,omitempty"`
+	UnlockedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=unlocked_at,json=unlockedAt,proto3" json:"unlocked_at,omitempty"` // unset while locked
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Achievement) Reset() {
+	*x = Achievement{}
+	mi := &file_statistics_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Achievement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Achievement) ProtoMessage() {}
+
+func (x *Achievement) ProtoReflect() protoreflect.Message {
+	mi := &file_statistics_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Achievement.ProtoReflect.Descriptor instead.
+func (*Achievement) Descriptor() ([]byte, []int) {
+	return file_statistics_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Achievement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Achievement) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Achievement) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Achievement) GetUnlocked() bool {
+	if x != nil {
+		return x.Unlocked
+	}
+	return false
+}
+
+func (x *Achievement) GetUnlockedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnlockedAt
+	}
+	return nil
+}
+
+type GetUserAchievementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Achievements  []*Achievement         `protobuf:"bytes,1,rep,name=achievements,proto3" json:"achievements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserAchievementsResponse) Reset() {
+	*x = GetUserAchievementsResponse{}
+	mi := &file_statistics_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserAchievementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserAchievementsResponse) ProtoMessage() {}
+
+func (x *GetUserAchievementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_statistics_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserAchievementsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserAchievementsResponse) Descriptor() ([]byte, []int) {
+	return file_statistics_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetUserAchievementsResponse) GetAchievements() []*Achievement {
+	if x != nil {
+		return x.Achievements
+	}
+	return nil
+}
+
+// --- Missions ---
+type GetUserMissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserMissionsRequest) Reset() {
+	*x = GetUserMissionsRequest{}
+	mi := &file_statistics_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserMissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserMissionsRequest) ProtoMessage() {}
+
+func (x *GetUserMissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_statistics_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserMissionsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserMissionsRequest) Descriptor() ([]byte, []int) {
+	return file_statistics_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetUserMissionsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type Mission struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Period        string                 `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"` // daily or weekly
+	Progress      int64                  `protobuf:"varint,5,opt,name=progress,proto3" json:"progress,omitempty"`
+	Target        int64                  `protobuf:"varint,6,opt,name=target,proto3" json:"target,omitempty"`
+	Reward        int64                  `protobuf:"varint,7,opt,name=reward,proto3" json:"reward,omitempty"` // chips
+	Completed     bool                   `protobuf:"varint,8,opt,name=completed,proto3" json:"completed,omitempty"`
+	Claimed       bool                   `protobuf:"varint,9,opt,name=claimed,proto3" json:"claimed,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mission) Reset() {
+	*x = Mission{}
+	mi := &file_statistics_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mission) ProtoMessage() {}
+
+func (x *Mission) ProtoReflect() protoreflect.Message {
+	mi := &file_statistics_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mission.ProtoReflect.Descriptor instead.
+func (*Mission) Descriptor() ([]byte, []int) {
+	return file_statistics_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Mission) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Mission) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Mission) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Mission) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *Mission) GetProgress() int64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *Mission) GetTarget() int64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *Mission) GetReward() int64 {
+	if x != nil {
+		return x.Reward
+	}
+	return 0
+}
+
+func (x *Mission) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *Mission) GetClaimed() bool {
+	if x != nil {
+		return x.Claimed
+	}
+	return false
+}
+
+func (x *Mission) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+type GetUserMissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Missions      []*Mission             `protobuf:"bytes,1,rep,name=missions,proto3" json:"missions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserMissionsResponse) Reset() {
+	*x = GetUserMissionsResponse{}
+	mi := &file_statistics_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserMissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserMissionsResponse) ProtoMessage() {}
+
+func (x *GetUserMissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_statistics_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserMissionsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserMissionsResponse) Descriptor() ([]byte, []int) {
+	return file_statistics_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetUserMissionsResponse) GetMissions() []*Mission {
+	if x != nil {
+		return x.Missions
+	}
+	return nil
+}
+
+type ClaimMissionRewardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MissionId     string                 `protobuf:"bytes,2,opt,name=mission_id,json=missionId,proto3" json:"mission_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimMissionRewardRequest) Reset() {
+	*x = ClaimMissionRewardRequest{}
+	mi := &file_statistics_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimMissionRewardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimMissionRewardRequest) ProtoMessage() {}
+
+func (x *ClaimMissionRewardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_statistics_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimMissionRewardRequest.ProtoReflect.Descriptor instead.
+func (*ClaimMissionRewardRequest) Descriptor() ([]byte, []int) {
+	return file_statistics_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ClaimMissionRewardRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ClaimMissionRewardRequest) GetMissionId() string {
+	if x != nil {
+		return x.MissionId
+	}
+	return ""
+}
+
+type ClaimMissionRewardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mission       *Mission               `protobuf:"bytes,1,opt,name=mission,proto3" json:"mission,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Balance       int64                  `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"` // balance after the reward
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimMissionRewardResponse) Reset() {
+	*x = ClaimMissionRewardResponse{}
+	mi := &file_statistics_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimMissionRewardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimMissionRewardResponse) ProtoMessage() {}
+
+func (x *ClaimMissionRewardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_statistics_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimMissionRewardResponse.ProtoReflect.Descriptor instead.
+func (*ClaimMissionRewardResponse) Descriptor() ([]byte, []int) {
+	return file_statistics_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ClaimMissionRewardResponse) GetMission() *Mission {
+	if x != nil {
+		return x.Mission
+	}
+	return nil
+}
+
+func (x *ClaimMissionRewardResponse) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ClaimMissionRewardResponse) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+var File_statistics_proto protoreflect.FileDescriptor
+
+const file_statistics_proto_rawDesc = "" +
+	"\n" +
+	"\x10statistics.proto\x12\n" +
+	"statistics\x1a\x1fgoogle/protobuf/timestamp.proto\"\x1c\n" +
+	"\x1aGetGeneralGameStatsRequest\"\x9c\x02\n" +
+	"\x10GeneralGameStats\x12\x1f\n" +
+	"\vtotal_users\x18\x01 \x01(\x03R\n" +
+	"totalUsers\x12,\n" +
+	"\x12total_games_played\x18\x02 \x01(\x03R\x10totalGamesPlayed\x12(\n" +
+	"\x10total_bet_amount\x18\x03 \x01(\x03R\x0etotalBetAmount\x12B\n" +
+	"\x0flast_updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastUpdatedAt\x12\x1d\n" +
+	"\n" +
+	"total_rake\x18\x05 \x01(\x03R\ttotalRake\x12,\n" +
+	"\x12total_jackpot_paid\x18\x06 \x01(\x03R\x10totalJackpotPaid\"Q\n" +
+	"\x1bGetGeneralGameStatsResponse\x122\n" +
+	"\x05stats\x18\x01 \x01(\v2\x1c.statistics.GeneralGameStatsR\x05stats\"2\n" +
+	"\x17GetUserGameStatsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\x82\x04\n" +
+	"\rUserGameStats\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12!\n" +
+	"\fgames_played\x18\x02 \x01(\x03R\vgamesPlayed\x12\x1b\n" +
+	"\tgames_won\x18\x03 \x01(\x03R\bgamesWon\x12\x1d\n" +
+	"\n" +
+	"games_lost\x18\x04 \x01(\x03R\tgamesLost\x12\x1f\n" +
+	"\vgames_drawn\x18\x05 \x01(\x03R\n" +
+	"gamesDrawn\x12\x1b\n" +
+	"\ttotal_bet\x18\x06 \x01(\x03R\btotalBet\x12%\n" +
+	"\x0etotal_winnings\x18\a \x01(\x03R\rtotalWinnings\x12!\n" +
+	"\ftotal_losses\x18\b \x01(\x03R\vtotalLosses\x12\x19\n" +
+	"\bwin_rate\x18\t \x01(\x01R\awinRate\x12\x1b\n" +
+	"\tloss_rate\x18\n" +
+	" \x01(\x01R\blossRate\x12\x1d\n" +
+	"\n" +
+	"win_streak\x18\v \x01(\x03R\twinStreak\x12\x1f\n" +
+	"\vloss_streak\x18\f \x01(\x03R\n" +
+	"lossStreak\x12I\n" +
+	"\x13last_game_played_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x10lastGamePlayedAt\x12.\n" +
+	"\x13ranked_games_played\x18\x0e \x01(\x03R\x11rankedGamesPlayed\"K\n" +
+	"\x18GetUserGameStatsResponse\x12/\n" +
+	"\x05stats\x18\x01 \x01(\v2\x19.statistics.UserGameStatsR\x05stats\"X\n" +
+	"\x15GetLeaderboardRequest\x12)\n" +
+	"\x10leaderboard_type\x18\x01 \x01(\tR\x0fleaderboardType\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"q\n" +
+	"\x10LeaderboardEntry\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x03R\x05score\x12\x12\n" +
+	"\x04rank\x18\x04 \x01(\x05R\x04rank\"Y\n" +
+	"\vLeaderboard\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x126\n" +
+	"\aentries\x18\x02 \x03(\v2\x1c.statistics.LeaderboardEntryR\aentries\"S\n" +
+	"\x16GetLeaderboardResponse\x129\n" +
+	"\vleaderboard\x18\x01 \x01(\v2\x17.statistics.LeaderboardR\vleaderboard\"/\n" +
+	"\x14GetUserLeagueRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"g\n" +
+	"\x0eLeagueStanding\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x03R\x05score\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\x05R\x04rank\x12\x12\n" +
+	"\x04zone\x18\x04 \x01(\tR\x04zone\"\xcb\x02\n" +
+	"\vLeagueGroup\x12\x12\n" +
+	"\x04week\x18\x01 \x01(\tR\x04week\x12\x1a\n" +
+	"\bdivision\x18\x02 \x01(\x05R\bdivision\x12#\n" +
+	"\rdivision_name\x18\x03 \x01(\tR\fdivisionName\x12\x14\n" +
+	"\x05group\x18\x04 \x01(\x05R\x05group\x12\x16\n" +
+	"\x06joined\x18\x05 \x01(\bR\x06joined\x128\n" +
+	"\tstandings\x18\x06 \x03(\v2\x1a.statistics.LeagueStandingR\tstandings\x123\n" +
+	"\aends_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12#\n" +
+	"\rpromote_count\x18\b \x01(\x05R\fpromoteCount\x12%\n" +
+	"\x0erelegate_count\x18\t \x01(\x05R\rrelegateCount\"H\n" +
+	"\x15GetUserLeagueResponse\x12/\n" +
+	"\x06league\x18\x01 \x01(\v2\x17.statistics.LeagueGroupR\x06league\"5\n" +
+	"\x1aGetUserAchievementsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\xac\x01\n" +
+	"\vAchievement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bunlocked\x18\x04 \x01(\bR\bunlocked\x12;\n" +
+	"\vunlocked_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"unlockedAt\"Z\n" +
+	"\x1bGetUserAchievementsResponse\x12;\n" +
+	"\fachievements\x18\x01 \x03(\v2\x17.statistics.AchievementR\fachievements\"1\n" +
+	"\x16GetUserMissionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\xa0\x02\n" +
+	"\aMission\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06period\x18\x04 \x01(\tR\x06period\x12\x1a\n" +
+	"\bprogress\x18\x05 \x01(\x03R\bprogress\x12\x16\n" +
+	"\x06target\x18\x06 \x01(\x03R\x06target\x12\x16\n" +
+	"\x06reward\x18\a \x01(\x03R\x06reward\x12\x1c\n" +
+	"\tcompleted\x18\b \x01(\bR\tcompleted\x12\x18\n" +
+	"\aclaimed\x18\t \x01(\bR\aclaimed\x123\n" +
+	"\aends_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\"J\n" +
+	"\x17GetUserMissionsResponse\x12/\n" +
+	"\bmissions\x18\x01 \x03(\v2\x13.statistics.MissionR\bmissions\"S\n" +
+	"\x19ClaimMissionRewardRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"mission_id\x18\x02 \x01(\tR\tmissionId\"}\n" +
+	"\x1aClaimMissionRewardResponse\x12-\n" +
+	"\amission\x18\x01 \x01(\v2\x13.statistics.MissionR\amission\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\x03R\abalance2\xb2\x05\n" +
 	"\x11StatisticsService\x12f\n" +
 	"\x13GetGeneralGameStats\x12&.statistics.GetGeneralGameStatsRequest\x1a'.statistics.GetGeneralGameStatsResponse\x12]\n" +
 	"\x10GetUserGameStats\x12#.statistics.GetUserGameStatsRequest\x1a$.statistics.GetUserGameStatsResponse\x12W\n" +
-	"\x0eGetLeaderboard\x12!.statistics.GetLeaderboardRequest\x1a\".statistics.GetLeaderboardResponseBDZBapi-gateway/internal/adapter/grpc/server/frontend/proto/statisticsb\x06proto3"
+	"\x0eGetLeaderboard\x12!.statistics.GetLeaderboardRequest\x1a\".statistics.GetLeaderboardResponse\x12T\n" +
+	"\rGetUserLeague\x12 .statistics.GetUserLeagueRequest\x1a!.statistics.GetUserLeagueResponse\x12f\n" +
+	"\x13GetUserAchievements\x12&.statistics.GetUserAchievementsRequest\x1a'.statistics.GetUserAchievementsResponse\x12Z\n" +
+	"\x0fGetUserMissions\x12\".statistics.GetUserMissionsRequest\x1a#.statistics.GetUserMissionsResponse\x12c\n" +
+	"\x12ClaimMissionReward\x12%.statistics.ClaimMissionRewardRequest\x1a&.statistics.ClaimMissionRewardResponseBDZBapi-gateway/internal/adapter/grpc/server/frontend/proto/statisticsb\x06proto3"
 
 var (
 	file_statistics_proto_rawDescOnce sync.Once
@@ -685,7 +1518,7 @@ func file_statistics_proto_rawDescGZIP() []byte {
 	return file_statistics_proto_rawDescData
 }
 
-var file_statistics_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_statistics_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_statistics_proto_goTypes = []any{
 	(*GetGeneralGameStatsRequest)(nil),  // 0: statistics.GetGeneralGameStatsRequest
 	(*GeneralGameStats)(nil),            // 1: statistics.GeneralGameStats
@@ -697,26 +1530,54 @@ var file_statistics_proto_goTypes = []any{
 	(*LeaderboardEntry)(nil),            // 7: statistics.LeaderboardEntry
 	(*Leaderboard)(nil),                 // 8: statistics.Leaderboard
 	(*GetLeaderboardResponse)(nil),      // 9: statistics.GetLeaderboardResponse
-	(*timestamppb.Timestamp)(nil),       // 10: google.protobuf.Timestamp
+	(*GetUserLeagueRequest)(nil),        // 10: statistics.GetUserLeagueRequest
+	(*LeagueStanding)(nil),              // 11: statistics.LeagueStanding
+	(*LeagueGroup)(nil),                 // 12: statistics.LeagueGroup
+	(*GetUserLeagueResponse)(nil),       // 13: statistics.GetUserLeagueResponse
+	(*GetUserAchievementsRequest)(nil),  // 14: statistics.GetUserAchievementsRequest
+	(*Achievement)(nil),                 // 15: statistics.Achievement
+	(*GetUserAchievementsResponse)(nil), // 16: statistics.GetUserAchievementsResponse
+	(*GetUserMissionsRequest)(nil),      // 17: statistics.GetUserMissionsRequest
+	(*Mission)(nil),                     // 18: statistics.Mission
+	(*GetUserMissionsResponse)(nil),     // 19: statistics.GetUserMissionsResponse
+	(*ClaimMissionRewardRequest)(nil),   // 20: statistics.ClaimMissionRewardRequest
+	(*ClaimMissionRewardResponse)(nil),  // 21: statistics.ClaimMissionRewardResponse
+	(*timestamppb.Timestamp)(nil),       // 22: google.protobuf.Timestamp
 }
 var file_statistics_proto_depIdxs = []int32{
-	10, // 0: statistics.GeneralGameStats.last_updated_at:type_name -> google.protobuf.Timestamp
+	22, // 0: statistics.GeneralGameStats.last_updated_at:type_name -> google.protobuf.Timestamp
 	1,  // 1: statistics.GetGeneralGameStatsResponse.stats:type_name -> statistics.GeneralGameStats
-	10, // 2: statistics.UserGameStats.last_game_played_at:type_name -> google.protobuf.Timestamp
+	22, // 2: statistics.UserGameStats.last_game_played_at:type_name -> google.protobuf.Timestamp
 	4,  // 3: statistics.GetUserGameStatsResponse.stats:type_name -> statistics.UserGameStats
 	7,  // 4: statistics.Leaderboard.entries:type_name -> statistics.LeaderboardEntry
 	8,  // 5: statistics.GetLeaderboardResponse.leaderboard:type_name -> statistics.Leaderboard
-	0,  // 6: statistics.StatisticsService.GetGeneralGameStats:input_type -> statistics.GetGeneralGameStatsRequest
-	3,  // 7: statistics.StatisticsService.GetUserGameStats:input_type -> statistics.GetUserGameStatsRequest
-	6,  // 8: statistics.StatisticsService.GetLeaderboard:input_type -> statistics.GetLeaderboardRequest
-	2,  // 9: statistics.StatisticsService.GetGeneralGameStats:output_type -> statistics.GetGeneralGameStatsResponse
-	5,  // 10: statistics.StatisticsService.GetUserGameStats:output_type -> statistics.GetUserGameStatsResponse
-	9,  // 11: statistics.StatisticsService.GetLeaderboard:output_type -> statistics.GetLeaderboardResponse
-	9,  // [9:12] is the sub-list for method output_type
-	6,  // [6:9] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	11, // 6: statistics.LeagueGroup.standings:type_name -> statistics.LeagueStanding
+	22, // 7: statistics.LeagueGroup.ends_at:type_name -> google.protobuf.Timestamp
+	12, // 8: statistics.GetUserLeagueResponse.league:type_name -> statistics.LeagueGroup
+	22, // 9: statistics.Achievement.unlocked_at:type_name -> google.protobuf.Timestamp
+	15, // 10: statistics.GetUserAchievementsResponse.achievements:type_name -> statistics.Achievement
+	22, // 11: statistics.Mission.ends_at:type_name -> google.protobuf.Timestamp
+	18, // 12: statistics.GetUserMissionsResponse.missions:type_name -> statistics.Mission
+	18, // 13: statistics.ClaimMissionRewardResponse.mission:type_name -> statistics.Mission
+	0,  // 14: statistics.StatisticsService.GetGeneralGameStats:input_type -> statistics.GetGeneralGameStatsRequest
+	3,  // 15: statistics.StatisticsService.GetUserGameStats:input_type -> statistics.GetUserGameStatsRequest
+	6,  // 16: statistics.StatisticsService.GetLeaderboard:input_type -> statistics.GetLeaderboardRequest
+	10, // 17: statistics.StatisticsService.GetUserLeague:input_type -> statistics.GetUserLeagueRequest
+	14, // 18: statistics.StatisticsService.GetUserAchievements:input_type -> statistics.GetUserAchievementsRequest
+	17, // 19: statistics.StatisticsService.GetUserMissions:input_type -> statistics.GetUserMissionsRequest
+	20, // 20: statistics.StatisticsService.ClaimMissionReward:input_type -> statistics.ClaimMissionRewardRequest
+	2,  // 21: statistics.StatisticsService.GetGeneralGameStats:output_type -> statistics.GetGeneralGameStatsResponse
+	5,  // 22: statistics.StatisticsService.GetUserGameStats:output_type -> statistics.GetUserGameStatsResponse
+	9,  // 23: statistics.StatisticsService.GetLeaderboard:output_type -> statistics.GetLeaderboardResponse
+	13, // 24: statistics.StatisticsService.GetUserLeague:output_type -> statistics.GetUserLeagueResponse
+	16, // 25: statistics.StatisticsService.GetUserAchievements:output_type -> statistics.GetUserAchievementsResponse
+	19, // 26: statistics.StatisticsService.GetUserMissions:output_type -> statistics.GetUserMissionsResponse
+	21, // 27: statistics.StatisticsService.ClaimMissionReward:output_type -> statistics.ClaimMissionRewardResponse
+	21, // [21:28] is the sub-list for method output_type
+	14, // [14:21] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_statistics_proto_init() }
@@ -730,7 +1591,7 @@ func file_statistics_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_statistics_proto_rawDesc), len(file_statistics_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,13 +20,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_GetBalance_FullMethodName      = "/user_svc.userService/GetBalance"
-	UserService_AddBalance_FullMethodName      = "/user_svc.userService/AddBalance"
-	UserService_SubtractBalance_FullMethodName = "/user_svc.userService/SubtractBalance"
-	UserService_GetProfile_FullMethodName      = "/user_svc.userService/GetProfile"
-	UserService_UpdateProfile_FullMethodName   = "/user_svc.userService/UpdateProfile"
-	UserService_GetRating_FullMethodName       = "/user_svc.userService/GetRating"
-	UserService_UpdateRating_FullMethodName    = "/user_svc.userService/UpdateRating"
+	UserService_GetBalance_FullMethodName           = "/user_svc.userService/GetBalance"
+	UserService_AddBalance_FullMethodName           = "/user_svc.userService/AddBalance"
+	UserService_SubtractBalance_FullMethodName      = "/user_svc.userService/SubtractBalance"
+	UserService_GetProfile_FullMethodName           = "/user_svc.userService/GetProfile"
+	UserService_UpdateProfile_FullMethodName        = "/user_svc.userService/UpdateProfile"
+	UserService_GetRating_FullMethodName            = "/user_svc.userService/GetRating"
+	UserService_UpdateRating_FullMethodName         = "/user_svc.userService/UpdateRating"
+	UserService_PlaceHold_FullMethodName            = "/user_svc.userService/PlaceHold"
+	UserService_ReleaseHold_FullMethodName          = "/user_svc.userService/ReleaseHold"
+	UserService_CaptureHold_FullMethodName          = "/user_svc.userService/CaptureHold"
+	UserService_GetTransactions_FullMethodName      = "/user_svc.userService/GetTransactions"
+	UserService_SettleMatch_FullMethodName          = "/user_svc.userService/SettleMatch"
+	UserService_ClaimDailyBonus_FullMethodName      = "/user_svc.userService/ClaimDailyBonus"
+	UserService_ClaimRefill_FullMethodName          = "/user_svc.userService/ClaimRefill"
+	UserService_TransferChips_FullMethodName        = "/user_svc.userService/TransferChips"
+	UserService_GetResponsibleGaming_FullMethodName = "/user_svc.userService/GetResponsibleGaming"
+	UserService_SetGamingLimit_FullMethodName       = "/user_svc.userService/SetGamingLimit"
+	UserService_SelfExclude_FullMethodName          = "/user_svc.userService/SelfExclude"
+	UserService_CheckPlayAllowed_FullMethodName     = "/user_svc.userService/CheckPlayAllowed"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -42,6 +54,25 @@ type UserServiceClient interface {
 	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetRating(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*GetRatingResponse, error)
 	UpdateRating(ctx context.Context, in *RatingUpdateResponse, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Escrow of chips bet in a running game
+	PlaceHold(ctx context.Context, in *PlaceHoldRequest, opts ...grpc.CallOption) (*ChipHold, error)
+	ReleaseHold(ctx context.Context, in *HoldRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CaptureHold(ctx context.Context, in *CaptureHoldRequest, opts ...grpc.CallOption) (*CaptureHoldResponse, error)
+	// Chip ledger history of a user, newest first
+	GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error)
+	// Applies the outcome of a match in one transaction, at most once per game id
+	SettleMatch(ctx context.Context, in *SettleMatchRequest, opts ...grpc.CallOption) (*SettleMatchResponse, error)
+	// Free chips: once a day with a streak bonus, and a refill for players who ran out
+	ClaimDailyBonus(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*RewardClaimResponse, error)
+	ClaimRefill(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*RewardClaimResponse, error)
+	// Gift chips to another player, limited by daily caps and account age
+	TransferChips(ctx context.Context, in *TransferChipsRequest, opts ...grpc.CallOption) (*TransferChipsResponse, error)
+	// Responsible gaming: loss and wager limits, session reminders and self-exclusion
+	GetResponsibleGaming(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*ResponsibleGamingResponse, error)
+	SetGamingLimit(ctx context.Context, in *SetGamingLimitRequest, opts ...grpc.CallOption) (*ResponsibleGamingResponse, error)
+	SelfExclude(ctx context.Context, in *SelfExcludeRequest, opts ...grpc.CallOption) (*ResponsibleGamingResponse, error)
+	// Fails with PERMISSION_DENIED for self-excluded players and FAILED_PRECONDITION when a limit is reached
+	CheckPlayAllowed(ctx context.Context, in *CheckPlayAllowedRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type userServiceClient struct {
@@ -122,6 +153,126 @@ func (c *userServiceClient) UpdateRating(ctx context.Context, in *RatingUpdateRe
 	return out, nil
 }
 
+func (c *userServiceClient) PlaceHold(ctx context.Context, in *PlaceHoldRequest, opts ...grpc.CallOption) (*ChipHold, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChipHold)
+	err := c.cc.Invoke(ctx, UserService_PlaceHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ReleaseHold(ctx context.Context, in *HoldRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_ReleaseHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CaptureHold(ctx context.Context, in *CaptureHoldRequest, opts ...grpc.CallOption) (*CaptureHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaptureHoldResponse)
+	err := c.cc.Invoke(ctx, UserService_CaptureHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransactionsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SettleMatch(ctx context.Context, in *SettleMatchRequest, opts ...grpc.CallOption) (*SettleMatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettleMatchResponse)
+	err := c.cc.Invoke(ctx, UserService_SettleMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ClaimDailyBonus(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*RewardClaimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RewardClaimResponse)
+	err := c.cc.Invoke(ctx, UserService_ClaimDailyBonus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ClaimRefill(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*RewardClaimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RewardClaimResponse)
+	err := c.cc.Invoke(ctx, UserService_ClaimRefill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) TransferChips(ctx context.Context, in *TransferChipsRequest, opts ...grpc.CallOption) (*TransferChipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferChipsResponse)
+	err := c.cc.Invoke(ctx, UserService_TransferChips_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetResponsibleGaming(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*ResponsibleGamingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResponsibleGamingResponse)
+	err := c.cc.Invoke(ctx, UserService_GetResponsibleGaming_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetGamingLimit(ctx context.Context, in *SetGamingLimitRequest, opts ...grpc.CallOption) (*ResponsibleGamingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResponsibleGamingResponse)
+	err := c.cc.Invoke(ctx, UserService_SetGamingLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SelfExclude(ctx context.Context, in *SelfExcludeRequest, opts ...grpc.CallOption) (*ResponsibleGamingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResponsibleGamingResponse)
+	err := c.cc.Invoke(ctx, UserService_SelfExclude_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CheckPlayAllowed(ctx context.Context, in *CheckPlayAllowedRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_CheckPlayAllowed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -135,6 +286,25 @@ type UserServiceServer interface {
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*emptypb.Empty, error)
 	GetRating(context.Context, *UserIDRequest) (*GetRatingResponse, error)
 	UpdateRating(context.Context, *RatingUpdateResponse) (*emptypb.Empty, error)
+	// Escrow of chips bet in a running game
+	PlaceHold(context.Context, *PlaceHoldRequest) (*ChipHold, error)
+	ReleaseHold(context.Context, *HoldRequest) (*emptypb.Empty, error)
+	CaptureHold(context.Context, *CaptureHoldRequest) (*CaptureHoldResponse, error)
+	// Chip ledger history of a user, newest first
+	GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error)
+	// Applies the outcome of a match in one transaction, at most once per game id
+	SettleMatch(context.Context, *SettleMatchRequest) (*SettleMatchResponse, error)
+	// Free chips: once a day with a streak bonus, and a refill for players who ran out
+	ClaimDailyBonus(context.Context, *UserIDRequest) (*RewardClaimResponse, error)
+	ClaimRefill(context.Context, *UserIDRequest) (*RewardClaimResponse, error)
+	// Gift chips to another player, limited by daily caps and account age
+	TransferChips(context.Context, *TransferChipsRequest) (*TransferChipsResponse, error)
+	// Responsible gaming: loss and wager limits, session reminders and self-exclusion
+	GetResponsibleGaming(context.Context, *UserIDRequest) (*ResponsibleGamingResponse, error)
+	SetGamingLimit(context.Context, *SetGamingLimitRequest) (*ResponsibleGamingResponse, error)
+	SelfExclude(context.Context, *SelfExcludeRequest) (*ResponsibleGamingResponse, error)
+	// Fails with PERMISSION_DENIED for self-excluded players and FAILED_PRECONDITION when a limit is reached
+	CheckPlayAllowed(context.Context, *CheckPlayAllowedRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -166,6 +336,42 @@ func (UnimplementedUserServiceServer) GetRating(context.Context, *UserIDRequest)
 func (UnimplementedUserServiceServer) UpdateRating(context.Context, *RatingUpdateResponse) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateRating not implemented")
 }
+func (UnimplementedUserServiceServer) PlaceHold(context.Context, *PlaceHoldRequest) (*ChipHold, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceHold not implemented")
+}
+func (UnimplementedUserServiceServer) ReleaseHold(context.Context, *HoldRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseHold not implemented")
+}
+func (UnimplementedUserServiceServer) CaptureHold(context.Context, *CaptureHoldRequest) (*CaptureHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CaptureHold not implemented")
+}
+func (UnimplementedUserServiceServer) GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactions not implemented")
+}
+func (UnimplementedUserServiceServer) SettleMatch(context.Context, *SettleMatchRequest) (*SettleMatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleMatch not implemented")
+}
+func (UnimplementedUserServiceServer) ClaimDailyBonus(context.Context, *UserIDRequest) (*RewardClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimDailyBonus not implemented")
+}
+func (UnimplementedUserServiceServer) ClaimRefill(context.Context, *UserIDRequest) (*RewardClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRefill not implemented")
+}
+func (UnimplementedUserServiceServer) TransferChips(context.Context, *TransferChipsRequest) (*TransferChipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferChips not implemented")
+}
+func (UnimplementedUserServiceServer) GetResponsibleGaming(context.Context, *UserIDRequest) (*ResponsibleGamingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResponsibleGaming not implemented")
+}
+func (UnimplementedUserServiceServer) SetGamingLimit(context.Context, *SetGamingLimitRequest) (*ResponsibleGamingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGamingLimit not implemented")
+}
+func (UnimplementedUserServiceServer) SelfExclude(context.Context, *SelfExcludeRequest) (*ResponsibleGamingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelfExclude not implemented")
+}
+func (UnimplementedUserServiceServer) CheckPlayAllowed(context.Context, *CheckPlayAllowedRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPlayAllowed not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -313,6 +519,222 @@ func _UserService_UpdateRating_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_PlaceHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).PlaceHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_PlaceHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).PlaceHold(ctx, req.(*PlaceHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ReleaseHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ReleaseHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ReleaseHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ReleaseHold(ctx, req.(*HoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CaptureHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CaptureHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CaptureHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CaptureHold(ctx, req.(*CaptureHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetTransactions(ctx, req.(*GetTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SettleMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SettleMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SettleMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SettleMatch(ctx, req.(*SettleMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ClaimDailyBonus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ClaimDailyBonus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ClaimDailyBonus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ClaimDailyBonus(ctx, req.(*UserIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ClaimRefill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ClaimRefill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ClaimRefill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ClaimRefill(ctx, req.(*UserIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_TransferChips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferChipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).TransferChips(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_TransferChips_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).TransferChips(ctx, req.(*TransferChipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetResponsibleGaming_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetResponsibleGaming(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetResponsibleGaming_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetResponsibleGaming(ctx, req.(*UserIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetGamingLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGamingLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetGamingLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetGamingLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetGamingLimit(ctx, req.(*SetGamingLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SelfExclude_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelfExcludeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SelfExclude(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SelfExclude_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SelfExclude(ctx, req.(*SelfExcludeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CheckPlayAllowed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPlayAllowedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CheckPlayAllowed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CheckPlayAllowed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CheckPlayAllowed(ctx, req.(*CheckPlayAllowedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -348,6 +770,54 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateRating",
 			Handler:    _UserService_UpdateRating_Handler,
 		},
+		{
+			MethodName: "PlaceHold",
+			Handler:    _UserService_PlaceHold_Handler,
+		},
+		{
+			MethodName: "ReleaseHold",
+			Handler:    _UserService_ReleaseHold_Handler,
+		},
+		{
+			MethodName: "CaptureHold",
+			Handler:    _UserService_CaptureHold_Handler,
+		},
+		{
+			MethodName: "GetTransactions",
+			Handler:    _UserService_GetTransactions_Handler,
+		},
+		{
+			MethodName: "SettleMatch",
+			Handler:    _UserService_SettleMatch_Handler,
+		},
+		{
+			MethodName: "ClaimDailyBonus",
+			Handler:    _UserService_ClaimDailyBonus_Handler,
+		},
+		{
+			MethodName: "ClaimRefill",
+			Handler:    _UserService_ClaimRefill_Handler,
+		},
+		{
+			MethodName: "TransferChips",
+			Handler:    _UserService_TransferChips_Handler,
+		},
+		{
+			MethodName: "GetResponsibleGaming",
+			Handler:    _UserService_GetResponsibleGaming_Handler,
+		},
+		{
+			MethodName: "SetGamingLimit",
+			Handler:    _UserService_SetGamingLimit_Handler,
+		},
+		{
+			MethodName: "SelfExclude",
+			Handler:    _UserService_SelfExclude_Handler,
+		},
+		{
+			MethodName: "CheckPlayAllowed",
+			Handler:    _UserService_CheckPlayAllowed_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
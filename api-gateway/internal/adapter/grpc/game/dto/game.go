@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"encoding/json"
+	"fmt"
+
+	svc "api-gateway/internal/adapter/frontend/proto/game"
+	"api-gateway/internal/model"
+)
+
+func FromGRPCRoom(room *svc.Room) model.GameRoom {
+	players := make([]model.GamePlayer, 0, len(room.Players))
+	for _, p := range room.Players {
+		players = append(players, model.GamePlayer{
+			ID:      p.Id,
+			IsReady: p.IsReady,
+			Stood:   p.Stood,
+		})
+	}
+	return model.GameRoom{
+		ID:                  room.Id,
+		Status:              room.Status,
+		Bet:                 room.Bet,
+		Players:             players,
+		CurrentTurnPlayerID: room.CurrentTurnPlayerId,
+	}
+}
+
+func FromGRPCListRoomsResponse(resp *svc.ListRoomsResponse) []model.GameRoom {
+	rooms := make([]model.GameRoom, 0, len(resp.Rooms))
+	for _, room := range resp.Rooms {
+		rooms = append(rooms, FromGRPCRoom(room))
+	}
+	return rooms
+}
+
+// FromGRPCSendCommandResponse reads the reply type and error code from the envelope and keeps the envelope as is.
+func FromGRPCSendCommandResponse(resp *svc.SendCommandResponse) (model.GameCommandReply, error) {
+	var envelope struct {
+		Type    string `json:"type"`
+		Payload struct {
+			Code string `json:"code"`
+		} `json:"payload"`
+	}
+	if err := json.Unmarshal(resp.Reply, &envelope); err != nil {
+		return model.GameCommandReply{}, fmt.Errorf("invalid command reply: %w", err)
+	}
+	return model.GameCommandReply{
+		Type:      envelope.Type,
+		ErrorCode: envelope.Payload.Code,
+		Envelope:  resp.Reply,
+	}, nil
+}
@@ -0,0 +1,87 @@
+package dto
+
+import (
+	"time"
+
+	svc "api-gateway/internal/adapter/frontend/proto/game"
+	"api-gateway/internal/model"
+)
+
+func FromGRPCTournament(t *svc.Tournament) model.Tournament {
+	tournament := model.Tournament{
+		ID:                   t.Id,
+		Name:                 t.Name,
+		Format:               t.Format,
+		Status:               t.Status,
+		BuyIn:                t.BuyIn,
+		MinPlayers:           int(t.MinPlayers),
+		MaxPlayers:           int(t.MaxPlayers),
+		RegistrationOpensAt:  fromUnix(t.RegistrationOpensAt),
+		RegistrationClosesAt: fromUnix(t.RegistrationClosesAt),
+		Players:              t.Players,
+		PrizePool:            t.PrizePool,
+		Payouts:              make([]int, 0, len(t.Payouts)),
+		Rounds:               make([][]model.TournamentMatch, 0, len(t.Rounds)),
+		Standings:            make([]model.TournamentStanding, 0, len(t.Standings)),
+		StartedAt:            fromUnix(t.StartedAt),
+		FinishedAt:           fromUnix(t.FinishedAt),
+	}
+	for _, p := range t.Payouts {
+		tournament.Payouts = append(tournament.Payouts, int(p))
+	}
+	for _, round := range t.Rounds {
+		matches := make([]model.TournamentMatch, 0, len(round.Matches))
+		for _, m := range round.Matches {
+			matches = append(matches, model.TournamentMatch{
+				PlayerIDs: m.PlayerIds,
+				RoomID:    m.RoomId,
+				WinnerID:  m.WinnerId,
+				Forfeit:   m.Forfeit,
+				Deadline:  fromUnix(m.Deadline),
+			})
+		}
+		tournament.Rounds = append(tournament.Rounds, matches)
+	}
+	for _, s := range t.Standings {
+		tournament.Standings = append(tournament.Standings, model.TournamentStanding{
+			PlayerID: s.PlayerId,
+			Place:    int(s.Place),
+			Prize:    s.Prize,
+		})
+	}
+	return tournament
+}
+
+func FromGRPCListTournamentsResponse(resp *svc.ListTournamentsResponse) []model.Tournament {
+	tournaments := make([]model.Tournament, 0, len(resp.Tournaments))
+	for _, t := range resp.Tournaments {
+		tournaments = append(tournaments, FromGRPCTournament(t))
+	}
+	return tournaments
+}
+
+func ToGRPCCreateTournamentRequest(t model.NewTournament) *svc.CreateTournamentRequest {
+	return &svc.CreateTournamentRequest{
+		Name:                 t.Name,
+		Format:               t.Format,
+		BuyIn:                t.BuyIn,
+		MinPlayers:           int32(t.MinPlayers),
+		MaxPlayers:           int32(t.MaxPlayers),
+		RegistrationOpensAt:  toUnix(t.RegistrationOpensAt),
+		RegistrationClosesAt: toUnix(t.RegistrationClosesAt),
+	}
+}
+
+func toUnix(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+func fromUnix(sec int64) time.Time {
+	if sec == 0 {
+		return time.Time{}
+	}
+	return time.Unix(sec, 0).UTC()
+}
@@ -0,0 +1,143 @@
+package game
+
+import (
+	svc "api-gateway/internal/adapter/frontend/proto/game"
+	"api-gateway/internal/adapter/grpc/game/dto"
+	"api-gateway/internal/model"
+	"context"
+	"errors"
+	"io"
+	"strconv"
+)
+
+type Game struct {
+	game svc.GameServiceClient
+}
+
+func NewGame(game svc.GameServiceClient) *Game {
+	return &Game{
+		game: game,
+	}
+}
+
+func (c *Game) ListRooms(ctx context.Context, status string) ([]model.GameRoom, error) {
+	resp, err := c.game.ListRooms(ctx, &svc.ListRoomsRequest{Status: status})
+	if err != nil {
+		return nil, err
+	}
+	return dto.FromGRPCListRoomsResponse(resp), nil
+}
+
+func (c *Game) GetRoom(ctx context.Context, roomID string) (model.GameRoom, error) {
+	resp, err := c.game.GetRoom(ctx, &svc.RoomIDRequest{RoomId: roomID})
+	if err != nil {
+		return model.GameRoom{}, err
+	}
+	return dto.FromGRPCRoom(resp), nil
+}
+
+func (c *Game) GetOnlineCount(ctx context.Context) (model.OnlineCount, error) {
+	resp, err := c.game.GetOnlineCount(ctx, &svc.GetOnlineCountRequest{})
+	if err != nil {
+		return model.OnlineCount{}, err
+	}
+	return model.OnlineCount{Players: resp.Players, Connections: resp.Connections}, nil
+}
+
+func (c *Game) ForceCloseRoom(ctx context.Context, roomID string, reason string) (model.ClosedGameRoom, error) {
+	resp, err := c.game.ForceCloseRoom(ctx, &svc.ForceCloseRoomRequest{RoomId: roomID, Reason: reason})
+	if err != nil {
+		return model.ClosedGameRoom{}, err
+	}
+	return model.ClosedGameRoom{RoomID: resp.RoomId, PlayerIDs: resp.PlayerIds, Refunded: resp.Refunded}, nil
+}
+
+func (c *Game) KickPlayer(ctx context.Context, userID int64, reason string) (model.KickResult, error) {
+	resp, err := c.game.KickPlayer(ctx, &svc.KickPlayerRequest{UserId: strconv.FormatInt(userID, 10), Reason: reason})
+	if err != nil {
+		return model.KickResult{}, err
+	}
+	return model.KickResult{WasOnline: resp.WasOnline, RoomID: resp.RoomId}, nil
+}
+
+func (c *Game) SendCommand(ctx context.Context, cmd model.GameCommand) (model.GameCommandReply, error) {
+	resp, err := c.game.SendCommand(ctx, &svc.SendCommandRequest{
+		UserId:    strconv.FormatInt(cmd.UserID, 10),
+		Type:      cmd.Type,
+		RequestId: cmd.RequestID,
+		Payload:   cmd.Payload,
+	})
+	if err != nil {
+		return model.GameCommandReply{}, err
+	}
+	return dto.FromGRPCSendCommandResponse(resp)
+}
+
+// StreamEvents passes the player's events to send until ctx is done, the stream ends or send fails.
+func (c *Game) StreamEvents(ctx context.Context, userID int64, remoteIP string, send func(model.GameEvent) error) error {
+	stream, err := c.game.StreamEvents(ctx, &svc.StreamEventsRequest{
+		UserId:   strconv.FormatInt(userID, 10),
+		RemoteIp: remoteIP,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := send(model.GameEvent{Type: event.Type, Data: event.Data}); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *Game) ListTournaments(ctx context.Context, status string) ([]model.Tournament, error) {
+	resp, err := c.game.ListTournaments(ctx, &svc.ListTournamentsRequest{Status: status})
+	if err != nil {
+		return nil, err
+	}
+	return dto.FromGRPCListTournamentsResponse(resp), nil
+}
+
+func (c *Game) GetTournament(ctx context.Context, tournamentID string) (model.Tournament, error) {
+	resp, err := c.game.GetTournament(ctx, &svc.TournamentIDRequest{TournamentId: tournamentID})
+	if err != nil {
+		return model.Tournament{}, err
+	}
+	return dto.FromGRPCTournament(resp), nil
+}
+
+func (c *Game) RegisterTournament(ctx context.Context, tournamentID string, userID int64) (model.Tournament, error) {
+	resp, err := c.game.RegisterTournament(ctx, &svc.TournamentRegistrationRequest{
+		TournamentId: tournamentID,
+		UserId:       strconv.FormatInt(userID, 10),
+	})
+	if err != nil {
+		return model.Tournament{}, err
+	}
+	return dto.FromGRPCTournament(resp), nil
+}
+
+func (c *Game) UnregisterTournament(ctx context.Context, tournamentID string, userID int64) (model.Tournament, error) {
+	resp, err := c.game.UnregisterTournament(ctx, &svc.TournamentRegistrationRequest{
+		TournamentId: tournamentID,
+		UserId:       strconv.FormatInt(userID, 10),
+	})
+	if err != nil {
+		return model.Tournament{}, err
+	}
+	return dto.FromGRPCTournament(resp), nil
+}
+
+func (c *Game) CreateTournament(ctx context.Context, t model.NewTournament) (model.Tournament, error) {
+	resp, err := c.game.CreateTournament(ctx, dto.ToGRPCCreateTournamentRequest(t))
+	if err != nil {
+		return model.Tournament{}, err
+	}
+	return dto.FromGRPCTournament(resp), nil
+}
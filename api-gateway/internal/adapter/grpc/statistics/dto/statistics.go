@@ -12,25 +12,28 @@ func FromGRPCGeneralGameStatsResponse(resp *svc.GetGeneralGameStatsResponse) *mo
 		TotalUsers:       resp.Stats.TotalUsers,
 		TotalGamesPlayed: resp.Stats.TotalGamesPlayed,
 		TotalBetAmount:   resp.Stats.TotalBetAmount,
+		TotalRake:        resp.Stats.TotalRake,
+		TotalJackpotPaid: resp.Stats.TotalJackpotPaid,
 		LastUpdatedAt:    *ProtoTimestampToTimePtr(resp.Stats.LastUpdatedAt),
 	}
 }
 
 func FromGRPCUserGameStatsResponse(resp *svc.GetUserGameStatsResponse) *model.UserGameStats {
 	return &model.UserGameStats{
-		UserID:           resp.Stats.UserId,
-		GamesPlayed:      resp.Stats.GamesPlayed,
-		GamesWon:         resp.Stats.GamesWon,
-		GamesLost:        resp.Stats.GamesLost,
-		GamesDrawn:       resp.Stats.GamesDrawn,
-		TotalBet:         resp.Stats.TotalBet,
-		TotalWinnings:    resp.Stats.TotalWinnings,
-		TotalLosses:      resp.Stats.TotalLosses,
-		WinStreak:        resp.Stats.WinStreak,
-		LossStreak:       resp.Stats.LossStreak,
-		WinRate:          resp.Stats.WinRate,
-		LossRate:         resp.Stats.LossRate,
-		LastGamePlayedAt: *ProtoTimestampToTimePtr(resp.Stats.LastGamePlayedAt),
+		UserID:            resp.Stats.UserId,
+		GamesPlayed:       resp.Stats.GamesPlayed,
+		GamesWon:          resp.Stats.GamesWon,
+		GamesLost:         resp.Stats.GamesLost,
+		GamesDrawn:        resp.Stats.GamesDrawn,
+		TotalBet:          resp.Stats.TotalBet,
+		TotalWinnings:     resp.Stats.TotalWinnings,
+		TotalLosses:       resp.Stats.TotalLosses,
+		WinStreak:         resp.Stats.WinStreak,
+		LossStreak:        resp.Stats.LossStreak,
+		WinRate:           resp.Stats.WinRate,
+		LossRate:          resp.Stats.LossRate,
+		RankedGamesPlayed: resp.Stats.RankedGamesPlayed,
+		LastGamePlayedAt:  *ProtoTimestampToTimePtr(resp.Stats.LastGamePlayedAt),
 	}
 }
 func FromGRPCLeaderboardResponse(resp *svc.GetLeaderboardResponse) *model.Leaderboard {
@@ -62,3 +65,72 @@ func ProtoTimestampToTimePtr(ts *timestamppb.Timestamp) *time.Time {
 	t := ts.AsTime()
 	return &t
 }
+
+func FromGRPCUserLeagueResponse(resp *svc.GetUserLeagueResponse) model.LeagueGroup {
+	league := resp.GetLeague()
+	standings := make([]model.LeagueStanding, 0, len(league.GetStandings()))
+	for _, s := range league.GetStandings() {
+		standings = append(standings, model.LeagueStanding{
+			UserID: s.UserId,
+			Score:  s.Score,
+			Rank:   s.Rank,
+			Zone:   s.Zone,
+		})
+	}
+	return model.LeagueGroup{
+		Week:          league.GetWeek(),
+		Division:      league.GetDivision(),
+		DivisionName:  league.GetDivisionName(),
+		Group:         league.GetGroup(),
+		Joined:        league.GetJoined(),
+		Standings:     standings,
+		EndsAt:        league.GetEndsAt().AsTime(),
+		PromoteCount:  league.GetPromoteCount(),
+		RelegateCount: league.GetRelegateCount(),
+	}
+}
+
+func FromGRPCUserAchievementsResponse(resp *svc.GetUserAchievementsResponse) []model.Achievement {
+	achievements := make([]model.Achievement, 0, len(resp.GetAchievements()))
+	for _, a := range resp.GetAchievements() {
+		achievements = append(achievements, model.Achievement{
+			ID:          a.Id,
+			Name:        a.Name,
+			Description: a.Description,
+			Unlocked:    a.Unlocked,
+			UnlockedAt:  ProtoTimestampToTimePtr(a.UnlockedAt),
+		})
+	}
+	return achievements
+}
+
+func FromGRPCMission(m *svc.Mission) model.Mission {
+	return model.Mission{
+		ID:          m.GetId(),
+		Name:        m.GetName(),
+		Description: m.GetDescription(),
+		Period:      m.GetPeriod(),
+		Progress:    m.GetProgress(),
+		Target:      m.GetTarget(),
+		Reward:      m.GetReward(),
+		Completed:   m.GetCompleted(),
+		Claimed:     m.GetClaimed(),
+		EndsAt:      m.GetEndsAt().AsTime(),
+	}
+}
+
+func FromGRPCUserMissionsResponse(resp *svc.GetUserMissionsResponse) []model.Mission {
+	missions := make([]model.Mission, 0, len(resp.GetMissions()))
+	for _, m := range resp.GetMissions() {
+		missions = append(missions, FromGRPCMission(m))
+	}
+	return missions
+}
+
+func FromGRPCClaimMissionRewardResponse(resp *svc.ClaimMissionRewardResponse) model.MissionClaim {
+	return model.MissionClaim{
+		Mission: FromGRPCMission(resp.GetMission()),
+		Amount:  resp.GetAmount(),
+		Balance: resp.GetBalance(),
+	}
+}
@@ -46,3 +46,35 @@ func (c *Statistics) GetLeaderboard(ctx context.Context, request model.Leaderboa
 	return dto.FromGRPCLeaderboardResponse(resp), nil
 
 }
+
+func (c *Statistics) GetUserLeague(ctx context.Context, userID int64) (model.LeagueGroup, error) {
+	resp, err := c.statistics.GetUserLeague(ctx, &svc.GetUserLeagueRequest{UserId: userID})
+	if err != nil {
+		return model.LeagueGroup{}, err
+	}
+	return dto.FromGRPCUserLeagueResponse(resp), nil
+}
+
+func (c *Statistics) GetUserAchievements(ctx context.Context, userID int64) ([]model.Achievement, error) {
+	resp, err := c.statistics.GetUserAchievements(ctx, &svc.GetUserAchievementsRequest{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	return dto.FromGRPCUserAchievementsResponse(resp), nil
+}
+
+func (c *Statistics) GetUserMissions(ctx context.Context, userID int64) ([]model.Mission, error) {
+	resp, err := c.statistics.GetUserMissions(ctx, &svc.GetUserMissionsRequest{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	return dto.FromGRPCUserMissionsResponse(resp), nil
+}
+
+func (c *Statistics) ClaimMissionReward(ctx context.Context, userID int64, missionID string) (model.MissionClaim, error) {
+	resp, err := c.statistics.ClaimMissionReward(ctx, &svc.ClaimMissionRewardRequest{UserId: userID, MissionId: missionID})
+	if err != nil {
+		return model.MissionClaim{}, err
+	}
+	return dto.FromGRPCClaimMissionRewardResponse(resp), nil
+}
@@ -41,3 +41,56 @@ func ProtoTimestampToTimePtr(ts *timestamppb.Timestamp) *time.Time {
 	t := ts.AsTime()
 	return &t
 }
+
+func FromGRPCGetTransactionsResponse(resp *svc.GetTransactionsResponse) model.TransactionPage {
+	transactions := make([]model.Transaction, 0, len(resp.Entries))
+	for _, e := range resp.Entries {
+		transactions = append(transactions, model.Transaction{
+			ID:            e.Id,
+			TransactionID: e.TransactionId,
+			Kind:          e.Kind,
+			Amount:        e.Amount,
+			BalanceAfter:  e.BalanceAfter,
+			Reference:     e.Reference,
+			CreatedAt:     e.CreatedAt.AsTime(),
+		})
+	}
+	return model.TransactionPage{
+		Transactions: transactions,
+		Total:        resp.Total,
+	}
+}
+
+func FromGRPCRewardClaimResponse(resp *svc.RewardClaimResponse) model.RewardClaim {
+	return model.RewardClaim{
+		Amount:      resp.Amount,
+		Balance:     resp.Balance,
+		DailyStreak: resp.DailyStreak,
+	}
+}
+
+func FromGRPCTransferChipsResponse(resp *svc.TransferChipsResponse) model.ChipTransferResult {
+	return model.ChipTransferResult{
+		Balance:        resp.Balance,
+		AlreadyApplied: resp.AlreadyApplied,
+	}
+}
+
+func FromGRPCResponsibleGamingResponse(resp *svc.ResponsibleGamingResponse) model.ResponsibleGaming {
+	settings := model.ResponsibleGaming{
+		Limits:            make([]model.GamingLimit, 0, len(resp.Limits)),
+		SelfExcludedUntil: ProtoTimestampToTimePtr(resp.SelfExcludedUntil),
+	}
+	for _, l := range resp.Limits {
+		limit := model.GamingLimit{
+			Kind:  l.Kind,
+			Value: l.Value,
+		}
+		if l.HasPending {
+			limit.PendingValue = &l.PendingValue
+			limit.PendingFrom = ProtoTimestampToTimePtr(l.PendingFrom)
+		}
+		settings.Limits = append(settings.Limits, limit)
+	}
+	return settings
+}
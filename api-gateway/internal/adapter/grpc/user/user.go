@@ -7,6 +7,7 @@ import (
 	"context"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"log"
+	"time"
 )
 
 type User struct {
@@ -106,3 +107,75 @@ func (s *User) UpdateRating(ctx context.Context, request model.UserProfile) (*em
 	}
 	return nil, nil
 }
+
+func (s *User) GetTransactions(ctx context.Context, filter model.TransactionFilter) (model.TransactionPage, error) {
+	resp, err := s.user.GetTransactions(ctx, &svc.GetTransactionsRequest{
+		UserId: filter.UserID,
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+	})
+	if err != nil {
+		return model.TransactionPage{}, err
+	}
+	return dto.FromGRPCGetTransactionsResponse(resp), nil
+}
+
+func (s *User) ClaimDailyBonus(ctx context.Context, userID int64) (model.RewardClaim, error) {
+	resp, err := s.user.ClaimDailyBonus(ctx, &svc.UserIDRequest{Id: userID})
+	if err != nil {
+		return model.RewardClaim{}, err
+	}
+	return dto.FromGRPCRewardClaimResponse(resp), nil
+}
+
+func (s *User) ClaimRefill(ctx context.Context, userID int64) (model.RewardClaim, error) {
+	resp, err := s.user.ClaimRefill(ctx, &svc.UserIDRequest{Id: userID})
+	if err != nil {
+		return model.RewardClaim{}, err
+	}
+	return dto.FromGRPCRewardClaimResponse(resp), nil
+}
+
+func (s *User) TransferChips(ctx context.Context, transfer model.ChipTransfer) (model.ChipTransferResult, error) {
+	resp, err := s.user.TransferChips(ctx, &svc.TransferChipsRequest{
+		FromUserId:     transfer.FromUserID,
+		ToUserId:       transfer.ToUserID,
+		Amount:         transfer.Amount,
+		IdempotencyKey: transfer.IdempotencyKey,
+	})
+	if err != nil {
+		return model.ChipTransferResult{}, err
+	}
+	return dto.FromGRPCTransferChipsResponse(resp), nil
+}
+
+func (s *User) GetResponsibleGaming(ctx context.Context, userID int64) (model.ResponsibleGaming, error) {
+	resp, err := s.user.GetResponsibleGaming(ctx, &svc.UserIDRequest{Id: userID})
+	if err != nil {
+		return model.ResponsibleGaming{}, err
+	}
+	return dto.FromGRPCResponsibleGamingResponse(resp), nil
+}
+
+func (s *User) SetGamingLimit(ctx context.Context, userID int64, limit model.GamingLimit) (model.ResponsibleGaming, error) {
+	resp, err := s.user.SetGamingLimit(ctx, &svc.SetGamingLimitRequest{
+		UserId: userID,
+		Kind:   limit.Kind,
+		Value:  limit.Value,
+	})
+	if err != nil {
+		return model.ResponsibleGaming{}, err
+	}
+	return dto.FromGRPCResponsibleGamingResponse(resp), nil
+}
+
+func (s *User) SelfExclude(ctx context.Context, userID int64, duration time.Duration) (model.ResponsibleGaming, error) {
+	resp, err := s.user.SelfExclude(ctx, &svc.SelfExcludeRequest{
+		UserId:          userID,
+		DurationSeconds: int64(duration / time.Second),
+	})
+	if err != nil {
+		return model.ResponsibleGaming{}, err
+	}
+	return dto.FromGRPCResponsibleGamingResponse(resp), nil
+}
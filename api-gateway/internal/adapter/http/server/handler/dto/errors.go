@@ -4,6 +4,9 @@ import (
 	"api-gateway/internal/model"
 	"errors"
 	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type HTTPError struct {
@@ -43,3 +46,31 @@ func FromError(err error) *HTTPError {
 		}
 	}
 }
+
+// FromGRPCError turns an error returned by a backend service into an HTTP error,
+// keeping the message of expected failures such as a rejected claim.
+func FromGRPCError(err error) *HTTPError {
+	st, ok := status.FromError(err)
+	if !ok {
+		return &HTTPError{Code: http.StatusInternalServerError, Message: "something went wrong"}
+	}
+
+	switch st.Code() {
+	case codes.InvalidArgument:
+		return &HTTPError{Code: http.StatusBadRequest, Message: st.Message()}
+	case codes.NotFound:
+		return &HTTPError{Code: http.StatusNotFound, Message: st.Message()}
+	case codes.AlreadyExists, codes.FailedPrecondition, codes.Aborted:
+		return &HTTPError{Code: http.StatusConflict, Message: st.Message()}
+	case codes.PermissionDenied:
+		return &HTTPError{Code: http.StatusForbidden, Message: st.Message()}
+	case codes.ResourceExhausted:
+		return &HTTPError{Code: http.StatusTooManyRequests, Message: st.Message()}
+	case codes.Unauthenticated:
+		return &HTTPError{Code: http.StatusUnauthorized, Message: st.Message()}
+	case codes.Unavailable:
+		return &HTTPError{Code: http.StatusServiceUnavailable, Message: "service is unavailable, retry later"}
+	default:
+		return &HTTPError{Code: http.StatusInternalServerError, Message: "something went wrong"}
+	}
+}
@@ -0,0 +1,156 @@
+package dto
+
+import (
+	"api-gateway/internal/model"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GamePlayerResponse struct {
+	ID      string `json:"id"`
+	IsReady bool   `json:"is_ready"`
+	Stood   bool   `json:"stood"`
+}
+
+type GameRoomResponse struct {
+	ID                  string               `json:"id"`
+	Status              string               `json:"status"`
+	Bet                 int64                `json:"bet"`
+	Players             []GamePlayerResponse `json:"players"`
+	CurrentTurnPlayerID string               `json:"current_turn_player_id,omitempty"`
+}
+
+type ListRoomsResponse struct {
+	Rooms []GameRoomResponse `json:"rooms"`
+}
+
+type OnlineCountResponse struct {
+	Players     int64 `json:"players"`
+	Connections int64 `json:"connections"`
+}
+
+// AdminActionRequest carries the reason shown to the affected players.
+type AdminActionRequest struct {
+	Reason string `json:"reason"`
+}
+
+type ForceCloseRoomResponse struct {
+	RoomID    string   `json:"room_id"`
+	PlayerIDs []string `json:"player_ids"`
+	Refunded  bool     `json:"refunded"`
+}
+
+type KickPlayerResponse struct {
+	WasOnline bool   `json:"was_online"`
+	RoomID    string `json:"room_id,omitempty"`
+}
+
+// ToListRoomsStatus reads the optional ?status= filter, only "waiting" and "in_progress" are accepted.
+func ToListRoomsStatus(ctx *gin.Context) (string, error) {
+	status := ctx.Query("status")
+	switch status {
+	case "", "waiting", "in_progress":
+		return status, nil
+	default:
+		return "", model.ErrInvalidStatus
+	}
+}
+
+func ToKickUserID(ctx *gin.Context) (int64, error) {
+	userID, err := strconv.ParseInt(ctx.Param("userID"), 10, 64)
+	if err != nil || userID <= 0 {
+		return 0, model.ErrInvalidID
+	}
+	return userID, nil
+}
+
+func FromModelToGameRoomResponse(room model.GameRoom) GameRoomResponse {
+	players := make([]GamePlayerResponse, 0, len(room.Players))
+	for _, p := range room.Players {
+		players = append(players, GamePlayerResponse{ID: p.ID, IsReady: p.IsReady, Stood: p.Stood})
+	}
+	return GameRoomResponse{
+		ID:                  room.ID,
+		Status:              room.Status,
+		Bet:                 room.Bet,
+		Players:             players,
+		CurrentTurnPlayerID: room.CurrentTurnPlayerID,
+	}
+}
+
+func FromModelToListRoomsResponse(rooms []model.GameRoom) ListRoomsResponse {
+	resp := ListRoomsResponse{Rooms: make([]GameRoomResponse, 0, len(rooms))}
+	for _, room := range rooms {
+		resp.Rooms = append(resp.Rooms, FromModelToGameRoomResponse(room))
+	}
+	return resp
+}
+
+func FromModelToOnlineCountResponse(count model.OnlineCount) OnlineCountResponse {
+	return OnlineCountResponse{Players: count.Players, Connections: count.Connections}
+}
+
+func FromModelToForceCloseRoomResponse(closed model.ClosedGameRoom) ForceCloseRoomResponse {
+	return ForceCloseRoomResponse{RoomID: closed.RoomID, PlayerIDs: closed.PlayerIDs, Refunded: closed.Refunded}
+}
+
+func FromModelToKickPlayerResponse(result model.KickResult) KickPlayerResponse {
+	return KickPlayerResponse{WasOnline: result.WasOnline, RoomID: result.RoomID}
+}
+
+type CreateRoomCommandRequest struct {
+	Bet int `json:"bet"`
+}
+
+type JoinRoomCommandRequest struct {
+	Bet int `json:"bet"`
+}
+
+type ReadyCommandRequest struct {
+	IsReady bool `json:"is_ready"`
+}
+
+// ToGameCommand builds a command of the authenticated player; payload is marshalled into the
+// command's WebSocket payload. The optional X-Request-ID header is echoed in the reply.
+func ToGameCommand(ctx *gin.Context, userID int64, commandType string, payload interface{}) (model.GameCommand, error) {
+	cmd := model.GameCommand{
+		UserID:    userID,
+		Type:      commandType,
+		RequestID: ctx.GetHeader("X-Request-ID"),
+	}
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return model.GameCommand{}, err
+		}
+		cmd.Payload = data
+	}
+	return cmd, nil
+}
+
+// CommandReplyStatus picks the HTTP status for a command reply by its protocol error code.
+func CommandReplyStatus(reply model.GameCommandReply) int {
+	if reply.Type != "error" {
+		return http.StatusOK
+	}
+	switch reply.ErrorCode {
+	case "invalid_message_format", "unsupported_version", "unknown_message_type", "invalid_payload", "invalid_bet":
+		return http.StatusBadRequest
+	case "authentication_required":
+		return http.StatusUnauthorized
+	case "play_restricted", "read_only_session":
+		return http.StatusForbidden
+	case "rate_limited", "too_many_in_flight":
+		return http.StatusTooManyRequests
+	case "server_draining":
+		return http.StatusServiceUnavailable
+	case "internal_error":
+		return http.StatusInternalServerError
+	default:
+		// not_in_room, not_your_turn, room_state_conflict and the *_failed codes: the game state doesn't allow it
+		return http.StatusConflict
+	}
+}
@@ -13,23 +13,26 @@ type GeneralGameStatsResponse struct {
 	TotalUsers       int64     `json:"total_users"`
 	TotalGamesPlayed int64     `json:"total_games_played"`
 	TotalBetAmount   int64     `json:"total_bet_amount"`
+	TotalRake        int64     `json:"total_rake"`
+	TotalJackpotPaid int64     `json:"total_jackpot_paid"`
 	LastUpdatedAt    time.Time `json:"last_updated_at"`
 }
 
 type UserGameStatsResponse struct {
-	UserID           int64     `json:"user_id"`
-	GamesPlayed      int64     `json:"games_played"`
-	GamesWon         int64     `json:"games_won"`
-	GamesLost        int64     `json:"games_lost"`
-	GamesDrawn       int64     `json:"games_drawn"`
-	TotalBet         int64     `json:"total_bet"`
-	TotalWinnings    int64     `json:"total_winnings"`
-	TotalLosses      int64     `json:"total_losses"`
-	WinRate          float64   `json:"win_rate"`
-	LossRate         float64   `json:"loss_rate"`
-	WinStreak        int64     `json:"win_streak"`
-	LossStreak       int64     `json:"loss_streak"`
-	LastGamePlayedAt time.Time `json:"last_game_played_at"`
+	UserID            int64     `json:"user_id"`
+	GamesPlayed       int64     `json:"games_played"`
+	GamesWon          int64     `json:"games_won"`
+	GamesLost         int64     `json:"games_lost"`
+	GamesDrawn        int64     `json:"games_drawn"`
+	TotalBet          int64     `json:"total_bet"`
+	TotalWinnings     int64     `json:"total_winnings"`
+	TotalLosses       int64     `json:"total_losses"`
+	WinRate           float64   `json:"win_rate"`
+	LossRate          float64   `json:"loss_rate"`
+	WinStreak         int64     `json:"win_streak"`
+	LossStreak        int64     `json:"loss_streak"`
+	RankedGamesPlayed int64     `json:"ranked_games_played"`
+	LastGamePlayedAt  time.Time `json:"last_game_played_at"`
 }
 
 type LeaderboardEntryResponse struct {
@@ -49,6 +52,8 @@ func FromModelToGeneralGameStatsResponse(stats model.GeneralGameStats) GeneralGa
 		TotalUsers:       stats.TotalUsers,
 		TotalGamesPlayed: stats.TotalGamesPlayed,
 		TotalBetAmount:   stats.TotalBetAmount,
+		TotalRake:        stats.TotalRake,
+		TotalJackpotPaid: stats.TotalJackpotPaid,
 		LastUpdatedAt:    stats.LastUpdatedAt,
 	}
 }
@@ -67,19 +72,20 @@ func ToUserGameStatsRequest(ctx *gin.Context) (int64, error) {
 
 func FromModelToUserGameStatsResponse(stats model.UserGameStats) UserGameStatsResponse {
 	return UserGameStatsResponse{
-		UserID:           stats.UserID,
-		GamesPlayed:      stats.GamesPlayed,
-		GamesWon:         stats.GamesWon,
-		GamesLost:        stats.GamesLost,
-		GamesDrawn:       stats.GamesDrawn,
-		TotalBet:         stats.TotalBet,
-		TotalWinnings:    stats.TotalWinnings,
-		TotalLosses:      stats.TotalLosses,
-		WinRate:          stats.WinRate,
-		LossRate:         stats.LossRate,
-		WinStreak:        stats.WinStreak,
-		LossStreak:       stats.LossStreak,
-		LastGamePlayedAt: stats.LastGamePlayedAt,
+		UserID:            stats.UserID,
+		GamesPlayed:       stats.GamesPlayed,
+		GamesWon:          stats.GamesWon,
+		GamesLost:         stats.GamesLost,
+		GamesDrawn:        stats.GamesDrawn,
+		TotalBet:          stats.TotalBet,
+		TotalWinnings:     stats.TotalWinnings,
+		TotalLosses:       stats.TotalLosses,
+		WinRate:           stats.WinRate,
+		LossRate:          stats.LossRate,
+		WinStreak:         stats.WinStreak,
+		LossStreak:        stats.LossStreak,
+		RankedGamesPlayed: stats.RankedGamesPlayed,
+		LastGamePlayedAt:  stats.LastGamePlayedAt,
 	}
 }
 
@@ -120,3 +126,120 @@ func FromModelToLeaderboardResponse(leaderboard model.Leaderboard) LeaderboardRe
 		Entries: entries,
 	}
 }
+
+type LeagueStandingResponse struct {
+	UserID int64  `json:"user_id"`
+	Score  int64  `json:"score"`
+	Rank   int32  `json:"rank"`
+	Zone   string `json:"zone,omitempty"`
+}
+
+type LeagueGroupResponse struct {
+	Week          string                   `json:"week"`
+	Division      int32                    `json:"division"`
+	DivisionName  string                   `json:"division_name"`
+	Group         int32                    `json:"group,omitempty"`
+	Joined        bool                     `json:"joined"`
+	Standings     []LeagueStandingResponse `json:"standings"`
+	EndsAt        time.Time                `json:"ends_at"`
+	PromoteCount  int32                    `json:"promote_count"`
+	RelegateCount int32                    `json:"relegate_count"`
+}
+
+func FromModelToLeagueGroupResponse(league model.LeagueGroup) LeagueGroupResponse {
+	standings := make([]LeagueStandingResponse, 0, len(league.Standings))
+	for _, s := range league.Standings {
+		standings = append(standings, LeagueStandingResponse{UserID: s.UserID, Score: s.Score, Rank: s.Rank, Zone: s.Zone})
+	}
+	return LeagueGroupResponse{
+		Week:          league.Week,
+		Division:      league.Division,
+		DivisionName:  league.DivisionName,
+		Group:         league.Group,
+		Joined:        league.Joined,
+		Standings:     standings,
+		EndsAt:        league.EndsAt,
+		PromoteCount:  league.PromoteCount,
+		RelegateCount: league.RelegateCount,
+	}
+}
+
+type AchievementResponse struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Unlocked    bool       `json:"unlocked"`
+	UnlockedAt  *time.Time `json:"unlocked_at,omitempty"`
+}
+
+type AchievementsResponse struct {
+	Achievements []AchievementResponse `json:"achievements"`
+}
+
+func FromModelToAchievementsResponse(achievements []model.Achievement) AchievementsResponse {
+	resp := AchievementsResponse{Achievements: make([]AchievementResponse, 0, len(achievements))}
+	for _, a := range achievements {
+		resp.Achievements = append(resp.Achievements, AchievementResponse{
+			ID:          a.ID,
+			Name:        a.Name,
+			Description: a.Description,
+			Unlocked:    a.Unlocked,
+			UnlockedAt:  a.UnlockedAt,
+		})
+	}
+	return resp
+}
+
+type MissionResponse struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Period      string    `json:"period"`
+	Progress    int64     `json:"progress"`
+	Target      int64     `json:"target"`
+	Reward      int64     `json:"reward"`
+	Completed   bool      `json:"completed"`
+	Claimed     bool      `json:"claimed"`
+	EndsAt      time.Time `json:"ends_at"`
+}
+
+type MissionsResponse struct {
+	Missions []MissionResponse `json:"missions"`
+}
+
+type MissionClaimResponse struct {
+	Mission MissionResponse `json:"mission"`
+	Amount  int64           `json:"amount"`
+	Balance int64           `json:"balance"`
+}
+
+func FromModelToMissionResponse(m model.Mission) MissionResponse {
+	return MissionResponse{
+		ID:          m.ID,
+		Name:        m.Name,
+		Description: m.Description,
+		Period:      m.Period,
+		Progress:    m.Progress,
+		Target:      m.Target,
+		Reward:      m.Reward,
+		Completed:   m.Completed,
+		Claimed:     m.Claimed,
+		EndsAt:      m.EndsAt,
+	}
+}
+
+func FromModelToMissionsResponse(missions []model.Mission) MissionsResponse {
+	resp := MissionsResponse{Missions: make([]MissionResponse, 0, len(missions))}
+	for _, m := range missions {
+		resp.Missions = append(resp.Missions, FromModelToMissionResponse(m))
+	}
+	return resp
+}
+
+func FromModelToMissionClaimResponse(claim model.MissionClaim) MissionClaimResponse {
+	return MissionClaimResponse{
+		Mission: FromModelToMissionResponse(claim.Mission),
+		Amount:  claim.Amount,
+		Balance: claim.Balance,
+	}
+}
@@ -0,0 +1,137 @@
+package dto
+
+import (
+	"time"
+
+	"api-gateway/internal/model"
+	"github.com/gin-gonic/gin"
+)
+
+type TournamentMatchResponse struct {
+	PlayerIDs []string   `json:"player_ids"`
+	RoomID    string     `json:"room_id,omitempty"`
+	WinnerID  string     `json:"winner_id,omitempty"`
+	Forfeit   bool       `json:"forfeit,omitempty"`
+	Deadline  *time.Time `json:"deadline,omitempty"`
+}
+
+type TournamentStandingResponse struct {
+	PlayerID string `json:"player_id"`
+	Place    int    `json:"place"`
+	Prize    int64  `json:"prize"`
+}
+
+type TournamentResponse struct {
+	ID                   string                       `json:"id"`
+	Name                 string                       `json:"name"`
+	Format               string                       `json:"format"`
+	Status               string                       `json:"status"`
+	BuyIn                int64                        `json:"buy_in"`
+	MinPlayers           int                          `json:"min_players"`
+	MaxPlayers           int                          `json:"max_players"`
+	RegistrationOpensAt  *time.Time                   `json:"registration_opens_at,omitempty"`
+	RegistrationClosesAt *time.Time                   `json:"registration_closes_at,omitempty"`
+	Players              []string                     `json:"players"`
+	PrizePool            int64                        `json:"prize_pool"`
+	Payouts              []int                        `json:"payouts,omitempty"`
+	Rounds               [][]TournamentMatchResponse  `json:"rounds"`
+	Standings            []TournamentStandingResponse `json:"standings,omitempty"`
+	StartedAt            *time.Time                   `json:"started_at,omitempty"`
+	FinishedAt           *time.Time                   `json:"finished_at,omitempty"`
+}
+
+type ListTournamentsResponse struct {
+	Tournaments []TournamentResponse `json:"tournaments"`
+}
+
+// CreateTournamentRequest schedules a tournament. Times are RFC 3339; a missing registration_opens_at
+// opens registration at once, registration_closes_at is left out for sit-and-go.
+type CreateTournamentRequest struct {
+	Name                 string    `json:"name" binding:"required"`
+	Format               string    `json:"format" binding:"required"`
+	BuyIn                int64     `json:"buy_in"`
+	MinPlayers           int       `json:"min_players"`
+	MaxPlayers           int       `json:"max_players"`
+	RegistrationOpensAt  time.Time `json:"registration_opens_at"`
+	RegistrationClosesAt time.Time `json:"registration_closes_at"`
+}
+
+// ToListTournamentsStatus reads the optional ?status= filter.
+func ToListTournamentsStatus(ctx *gin.Context) (string, error) {
+	status := ctx.Query("status")
+	switch status {
+	case "", "registering", "running", "finished", "cancelled":
+		return status, nil
+	default:
+		return "", model.ErrInvalidStatus
+	}
+}
+
+func ToNewTournament(req CreateTournamentRequest) model.NewTournament {
+	return model.NewTournament{
+		Name:                 req.Name,
+		Format:               req.Format,
+		BuyIn:                req.BuyIn,
+		MinPlayers:           req.MinPlayers,
+		MaxPlayers:           req.MaxPlayers,
+		RegistrationOpensAt:  req.RegistrationOpensAt,
+		RegistrationClosesAt: req.RegistrationClosesAt,
+	}
+}
+
+func FromModelToTournamentResponse(t model.Tournament) TournamentResponse {
+	resp := TournamentResponse{
+		ID:                   t.ID,
+		Name:                 t.Name,
+		Format:               t.Format,
+		Status:               t.Status,
+		BuyIn:                t.BuyIn,
+		MinPlayers:           t.MinPlayers,
+		MaxPlayers:           t.MaxPlayers,
+		RegistrationOpensAt:  timePtr(t.RegistrationOpensAt),
+		RegistrationClosesAt: timePtr(t.RegistrationClosesAt),
+		Players:              t.Players,
+		PrizePool:            t.PrizePool,
+		Payouts:              t.Payouts,
+		Rounds:               make([][]TournamentMatchResponse, 0, len(t.Rounds)),
+		Standings:            make([]TournamentStandingResponse, 0, len(t.Standings)),
+		StartedAt:            timePtr(t.StartedAt),
+		FinishedAt:           timePtr(t.FinishedAt),
+	}
+	if resp.Players == nil {
+		resp.Players = []string{}
+	}
+	for _, round := range t.Rounds {
+		matches := make([]TournamentMatchResponse, 0, len(round))
+		for _, m := range round {
+			matches = append(matches, TournamentMatchResponse{
+				PlayerIDs: m.PlayerIDs,
+				RoomID:    m.RoomID,
+				WinnerID:  m.WinnerID,
+				Forfeit:   m.Forfeit,
+				Deadline:  timePtr(m.Deadline),
+			})
+		}
+		resp.Rounds = append(resp.Rounds, matches)
+	}
+	for _, s := range t.Standings {
+		resp.Standings = append(resp.Standings, TournamentStandingResponse{PlayerID: s.PlayerID, Place: s.Place, Prize: s.Prize})
+	}
+	return resp
+}
+
+func FromModelToListTournamentsResponse(tournaments []model.Tournament) ListTournamentsResponse {
+	resp := ListTournamentsResponse{Tournaments: make([]TournamentResponse, 0, len(tournaments))}
+	for _, t := range tournaments {
+		resp.Tournaments = append(resp.Tournaments, FromModelToTournamentResponse(t))
+	}
+	return resp
+}
+
+// timePtr leaves times that are not set yet out of the response.
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
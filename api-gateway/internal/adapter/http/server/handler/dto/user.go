@@ -1,8 +1,13 @@
 package dto
 
 import (
+	"api-gateway/internal/adapter/http/server/middleware"
 	"api-gateway/internal/model"
+	"errors"
+	"strconv"
 	"time"
+
+	"github.com/gin-gonic/gin"
 )
 
 type GetBalanceResponse struct {
@@ -126,3 +131,182 @@ func ToRatingUpdateRequest(req RatingUpdateRequest) (model.UserProfile, error) {
 		Rating: &req.Rating,
 	}, nil
 }
+
+type TransactionResponse struct {
+	ID            int64     `json:"id"`
+	TransactionID int64     `json:"transaction_id"`
+	Kind          string    `json:"kind"`
+	Amount        int64     `json:"amount"`
+	BalanceAfter  int64     `json:"balance_after"`
+	Reference     string    `json:"reference"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type TransactionsResponse struct {
+	Transactions []TransactionResponse `json:"transactions"`
+	Total        int64                 `json:"total"`
+}
+
+// UserIDFromContext returns the ID of the authenticated user set by the auth middleware.
+func UserIDFromContext(ctx *gin.Context) (int64, error) {
+	value, ok := ctx.Get(middleware.UserIDKey)
+	if !ok {
+		return 0, errors.New("unauthenticated")
+	}
+	switch userID := value.(type) {
+	case int64:
+		return userID, nil
+	case string:
+		id, err := strconv.ParseInt(userID, 10, 64)
+		if err != nil {
+			return 0, errors.New("invalid user id format")
+		}
+		return id, nil
+	default:
+		return 0, errors.New("invalid user id format")
+	}
+}
+
+func ToTransactionFilterFromRequest(ctx *gin.Context) (model.TransactionFilter, error) {
+	userID, err := UserIDFromContext(ctx)
+	if err != nil {
+		return model.TransactionFilter{}, err
+	}
+	filter := model.TransactionFilter{UserID: userID}
+
+	if limitStr := ctx.Query("limit"); limitStr != "" {
+		filter.Limit, err = strconv.ParseInt(limitStr, 10, 64)
+		if err != nil || filter.Limit < 0 {
+			return model.TransactionFilter{}, errors.New("invalid limit format")
+		}
+	}
+	if offsetStr := ctx.Query("offset"); offsetStr != "" {
+		filter.Offset, err = strconv.ParseInt(offsetStr, 10, 64)
+		if err != nil || filter.Offset < 0 {
+			return model.TransactionFilter{}, errors.New("invalid offset format")
+		}
+	}
+
+	return filter, nil
+}
+
+func FromModelToTransactionsResponse(page model.TransactionPage) TransactionsResponse {
+	transactions := make([]TransactionResponse, 0, len(page.Transactions))
+	for _, t := range page.Transactions {
+		transactions = append(transactions, TransactionResponse{
+			ID:            t.ID,
+			TransactionID: t.TransactionID,
+			Kind:          t.Kind,
+			Amount:        t.Amount,
+			BalanceAfter:  t.BalanceAfter,
+			Reference:     t.Reference,
+			CreatedAt:     t.CreatedAt,
+		})
+	}
+	return TransactionsResponse{
+		Transactions: transactions,
+		Total:        page.Total,
+	}
+}
+
+type RewardClaimResponse struct {
+	Amount      int64 `json:"amount"`
+	Balance     int64 `json:"balance"`
+	DailyStreak int64 `json:"daily_streak"`
+}
+
+func FromModelToRewardClaimResponse(claim model.RewardClaim) RewardClaimResponse {
+	return RewardClaimResponse{
+		Amount:      claim.Amount,
+		Balance:     claim.Balance,
+		DailyStreak: claim.DailyStreak,
+	}
+}
+
+type TransferChipsRequest struct {
+	ToUserID int64 `json:"to_user_id"`
+	Amount   int64 `json:"amount"`
+}
+
+type TransferChipsResponse struct {
+	Balance        int64 `json:"balance"`
+	AlreadyApplied bool  `json:"already_applied"`
+}
+
+// ToChipTransferFromRequest builds a transfer from the authenticated sender, retries are deduplicated by the Idempotency-Key header.
+func ToChipTransferFromRequest(ctx *gin.Context, userID int64, req TransferChipsRequest) (model.ChipTransfer, error) {
+	if req.ToUserID <= 0 || req.ToUserID == userID {
+		return model.ChipTransfer{}, model.ErrInvalidID
+	}
+	if req.Amount <= 0 {
+		return model.ChipTransfer{}, model.ErrInvalidAmount
+	}
+
+	return model.ChipTransfer{
+		FromUserID:     userID,
+		ToUserID:       req.ToUserID,
+		Amount:         req.Amount,
+		IdempotencyKey: ctx.GetHeader("Idempotency-Key"),
+	}, nil
+}
+
+func FromModelToTransferChipsResponse(result model.ChipTransferResult) TransferChipsResponse {
+	return TransferChipsResponse{
+		Balance:        result.Balance,
+		AlreadyApplied: result.AlreadyApplied,
+	}
+}
+
+type GamingLimitResponse struct {
+	Kind         string     `json:"kind"`
+	Value        int64      `json:"value"`
+	PendingValue *int64     `json:"pending_value,omitempty"`
+	PendingFrom  *time.Time `json:"pending_from,omitempty"`
+}
+
+type ResponsibleGamingResponse struct {
+	Limits            []GamingLimitResponse `json:"limits"`
+	SelfExcludedUntil *time.Time            `json:"self_excluded_until,omitempty"`
+}
+
+type SetGamingLimitRequest struct {
+	Kind  string `json:"kind"`
+	Value int64  `json:"value"`
+}
+
+type SelfExcludeRequest struct {
+	Days int64 `json:"days"`
+}
+
+func ToGamingLimitFromRequest(req SetGamingLimitRequest) (model.GamingLimit, error) {
+	if req.Kind == "" {
+		return model.GamingLimit{}, errors.New("kind is required")
+	}
+	if req.Value < 0 {
+		return model.GamingLimit{}, model.ErrInvalidAmount
+	}
+	return model.GamingLimit{Kind: req.Kind, Value: req.Value}, nil
+}
+
+func ToSelfExclusionDuration(req SelfExcludeRequest) (time.Duration, error) {
+	if req.Days <= 0 {
+		return 0, errors.New("days must be positive")
+	}
+	return time.Duration(req.Days) * 24 * time.Hour, nil
+}
+
+func FromModelToResponsibleGamingResponse(settings model.ResponsibleGaming) ResponsibleGamingResponse {
+	limits := make([]GamingLimitResponse, 0, len(settings.Limits))
+	for _, l := range settings.Limits {
+		limits = append(limits, GamingLimitResponse{
+			Kind:         l.Kind,
+			Value:        l.Value,
+			PendingValue: l.PendingValue,
+			PendingFrom:  l.PendingFrom,
+		})
+	}
+	return ResponsibleGamingResponse{
+		Limits:            limits,
+		SelfExcludedUntil: settings.SelfExcludedUntil,
+	}
+}
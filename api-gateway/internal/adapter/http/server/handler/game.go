@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"net/http"
+
+	"api-gateway/internal/adapter/http/server/handler/dto"
+	"github.com/gin-gonic/gin"
+)
+
+type Game struct {
+	uc GameUsecase
+}
+
+func NewGame(uc GameUsecase) *Game {
+	return &Game{uc: uc}
+}
+
+func (h *Game) ListRooms(ctx *gin.Context) {
+	status, err := dto.ToListRoomsStatus(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	rooms, err := h.uc.ListRooms(ctx.Request.Context(), status)
+	if err != nil {
+		httpErr := dto.FromGRPCError(err)
+		ctx.JSON(httpErr.Code, gin.H{"error": httpErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.FromModelToListRoomsResponse(rooms))
+}
+
+func (h *Game) GetRoom(ctx *gin.Context) {
+	room, err := h.uc.GetRoom(ctx.Request.Context(), ctx.Param("roomID"))
+	if err != nil {
+		httpErr := dto.FromGRPCError(err)
+		ctx.JSON(httpErr.Code, gin.H{"error": httpErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.FromModelToGameRoomResponse(room))
+}
+
+func (h *Game) GetOnlineCount(ctx *gin.Context) {
+	count, err := h.uc.GetOnlineCount(ctx.Request.Context())
+	if err != nil {
+		httpErr := dto.FromGRPCError(err)
+		ctx.JSON(httpErr.Code, gin.H{"error": httpErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.FromModelToOnlineCountResponse(count))
+}
+
+// ForceCloseRoom closes a room; stakes of a running game are refunded by game-service.
+func (h *Game) ForceCloseRoom(ctx *gin.Context) {
+	var req dto.AdminActionRequest
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request data"})
+			return
+		}
+	}
+	closed, err := h.uc.ForceCloseRoom(ctx.Request.Context(), ctx.Param("roomID"), req.Reason)
+	if err != nil {
+		httpErr := dto.FromGRPCError(err)
+		ctx.JSON(httpErr.Code, gin.H{"error": httpErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.FromModelToForceCloseRoomResponse(closed))
+}
+
+// KickPlayer disconnects a player and takes them out of their room.
+func (h *Game) KickPlayer(ctx *gin.Context) {
+	userID, err := dto.ToKickUserID(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	var req dto.AdminActionRequest
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request data"})
+			return
+		}
+	}
+	result, err := h.uc.KickPlayer(ctx.Request.Context(), userID, req.Reason)
+	if err != nil {
+		httpErr := dto.FromGRPCError(err)
+		ctx.JSON(httpErr.Code, gin.H{"error": httpErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.FromModelToKickPlayerResponse(result))
+}
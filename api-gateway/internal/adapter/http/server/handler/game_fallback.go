@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"api-gateway/internal/adapter/http/server/handler/dto"
+	"api-gateway/internal/model"
+	"github.com/gin-gonic/gin"
+)
+
+// sseKeepAlive is how often an idle event stream gets a comment, so proxies don't close it.
+const sseKeepAlive = 15 * time.Second
+
+// The handlers below are the fallback transport for networks that block WebSockets: commands are
+// REST calls and events come over Server-Sent Events. game-service runs them through the same
+// handlers as WebSocket messages, so both kinds of players can sit at one table.
+
+func (h *Game) CreateRoom(ctx *gin.Context) {
+	var req dto.CreateRoomCommandRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request data"})
+		return
+	}
+	h.sendCommand(ctx, "create_room", req)
+}
+
+func (h *Game) JoinRoom(ctx *gin.Context) {
+	var req dto.JoinRoomCommandRequest
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request data"})
+			return
+		}
+	}
+	h.sendCommand(ctx, "join_room", gin.H{"room_id": ctx.Param("roomID"), "bet": req.Bet})
+}
+
+func (h *Game) LeaveRoom(ctx *gin.Context) {
+	h.sendCommand(ctx, "leave_room", nil)
+}
+
+func (h *Game) Ready(ctx *gin.Context) {
+	var req dto.ReadyCommandRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request data"})
+		return
+	}
+	h.sendCommand(ctx, "ready", req)
+}
+
+func (h *Game) Hit(ctx *gin.Context) {
+	h.sendCommand(ctx, "hit", nil)
+}
+
+func (h *Game) Stand(ctx *gin.Context) {
+	h.sendCommand(ctx, "stand", nil)
+}
+
+// sendCommand forwards the command and answers with the protocol v1 ack or error envelope.
+func (h *Game) sendCommand(ctx *gin.Context, commandType string, payload interface{}) {
+	userID, err := dto.UserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	cmd, err := dto.ToGameCommand(ctx, userID, commandType, payload)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	reply, err := h.uc.SendCommand(ctx.Request.Context(), cmd)
+	if err != nil {
+		httpErr := dto.FromGRPCError(err)
+		ctx.JSON(httpErr.Code, gin.H{"error": httpErr.Message})
+		return
+	}
+	ctx.Data(dto.CommandReplyStatus(reply), "application/json", reply.Envelope)
+}
+
+// StreamEvents streams the player's game events as Server-Sent Events. The event name is the
+// message type and the data is the protocol v1 envelope. The stream counts as the player's
+// connection: closing it during a game is a disconnect.
+func (h *Game) StreamEvents(ctx *gin.Context) {
+	userID, err := dto.UserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx.Request.Context())
+	defer cancel()
+	events := make(chan model.GameEvent, 16)
+	done := make(chan error, 1)
+	go func() {
+		done <- h.uc.StreamEvents(streamCtx, userID, ctx.ClientIP(), func(event model.GameEvent) error {
+			select {
+			case events <- event:
+				return nil
+			case <-streamCtx.Done():
+				return streamCtx.Err()
+			}
+		})
+	}()
+
+	// Nothing is written before the first event (the greeting), so a refused stream still gets a proper status
+	select {
+	case event := <-events:
+		ctx.Header("Cache-Control", "no-cache")
+		ctx.Header("X-Accel-Buffering", "no")
+		ctx.SSEvent(event.Type, string(event.Data))
+		ctx.Writer.Flush()
+	case err := <-done:
+		if err == nil {
+			return
+		}
+		httpErr := dto.FromGRPCError(err)
+		ctx.JSON(httpErr.Code, gin.H{"error": httpErr.Message})
+		return
+	case <-streamCtx.Done():
+		return
+	}
+
+	keepAlive := time.NewTicker(sseKeepAlive)
+	defer keepAlive.Stop()
+	ctx.Stream(func(w io.Writer) bool {
+		select {
+		case event := <-events:
+			ctx.SSEvent(event.Type, string(event.Data))
+			return true
+		case <-keepAlive.C:
+			_, _ = io.WriteString(w, ": ping\n\n")
+			return true
+		case <-done:
+			return false
+		case <-streamCtx.Done():
+			return false
+		}
+	})
+}
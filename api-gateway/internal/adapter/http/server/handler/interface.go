@@ -4,6 +4,7 @@ import (
 	"api-gateway/internal/model"
 	"context"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"time"
 )
 
 type UserUsecase interface {
@@ -27,10 +28,36 @@ type UserProfileUsecase interface {
 	UpdateProfile(ctx context.Context, user model.UserProfile) (*emptypb.Empty, error)
 	GetRating(ctx context.Context, user model.UserProfile) (model.UserProfile, error)
 	UpdateRating(ctx context.Context, user model.UserProfile) (*emptypb.Empty, error)
+	GetTransactions(ctx context.Context, filter model.TransactionFilter) (model.TransactionPage, error)
+	ClaimDailyBonus(ctx context.Context, userID int64) (model.RewardClaim, error)
+	ClaimRefill(ctx context.Context, userID int64) (model.RewardClaim, error)
+	TransferChips(ctx context.Context, transfer model.ChipTransfer) (model.ChipTransferResult, error)
+	GetResponsibleGaming(ctx context.Context, userID int64) (model.ResponsibleGaming, error)
+	SetGamingLimit(ctx context.Context, userID int64, limit model.GamingLimit) (model.ResponsibleGaming, error)
+	SelfExclude(ctx context.Context, userID int64, duration time.Duration) (model.ResponsibleGaming, error)
 }
 
 type StatisticsUsecase interface {
 	GetGeneralGameStats(ctx context.Context) (*model.GeneralGameStats, error)
 	GetUserGameStats(ctx context.Context, userID int64) (*model.UserGameStats, error)
 	GetLeaderboard(ctx context.Context, req model.Leaderboard) (*model.Leaderboard, error)
+	GetUserLeague(ctx context.Context, userID int64) (model.LeagueGroup, error)
+	GetUserAchievements(ctx context.Context, userID int64) ([]model.Achievement, error)
+	GetUserMissions(ctx context.Context, userID int64) ([]model.Mission, error)
+	ClaimMissionReward(ctx context.Context, userID int64, missionID string) (model.MissionClaim, error)
+}
+
+type GameUsecase interface {
+	ListRooms(ctx context.Context, status string) ([]model.GameRoom, error)
+	GetRoom(ctx context.Context, roomID string) (model.GameRoom, error)
+	GetOnlineCount(ctx context.Context) (model.OnlineCount, error)
+	ForceCloseRoom(ctx context.Context, roomID string, reason string) (model.ClosedGameRoom, error)
+	KickPlayer(ctx context.Context, userID int64, reason string) (model.KickResult, error)
+	SendCommand(ctx context.Context, cmd model.GameCommand) (model.GameCommandReply, error)
+	StreamEvents(ctx context.Context, userID int64, remoteIP string, send func(model.GameEvent) error) error
+	ListTournaments(ctx context.Context, status string) ([]model.Tournament, error)
+	GetTournament(ctx context.Context, tournamentID string) (model.Tournament, error)
+	RegisterTournament(ctx context.Context, tournamentID string, userID int64) (model.Tournament, error)
+	UnregisterTournament(ctx context.Context, tournamentID string, userID int64) (model.Tournament, error)
+	CreateTournament(ctx context.Context, t model.NewTournament) (model.Tournament, error)
 }
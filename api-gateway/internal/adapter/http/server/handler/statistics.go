@@ -56,3 +56,95 @@ func (h *Statistics) GetLeaderboard(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, dto.FromModelToLeaderboardResponse(*leaderboard))
 }
+
+// GetMyLeague returns the weekly league group of the authenticated player.
+func (h *Statistics) GetMyLeague(ctx *gin.Context) {
+	userID, err := dto.UserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	h.getUserLeague(ctx, userID)
+}
+
+// GetUserLeague returns the weekly league group of the player in the path.
+func (h *Statistics) GetUserLeague(ctx *gin.Context) {
+	userID, err := dto.ToUserGameStatsRequest(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	h.getUserLeague(ctx, userID)
+}
+
+func (h *Statistics) getUserLeague(ctx *gin.Context, userID int64) {
+	league, err := h.uc.GetUserLeague(ctx.Request.Context(), userID)
+	if err != nil {
+		httpErr := dto.FromGRPCError(err)
+		ctx.JSON(httpErr.Code, gin.H{"error": httpErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.FromModelToLeagueGroupResponse(league))
+}
+
+// GetMyAchievements returns every achievement with the authenticated player's progress.
+func (h *Statistics) GetMyAchievements(ctx *gin.Context) {
+	userID, err := dto.UserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	h.getUserAchievements(ctx, userID)
+}
+
+// GetUserAchievements returns every achievement with the progress of the player in the path.
+func (h *Statistics) GetUserAchievements(ctx *gin.Context) {
+	userID, err := dto.ToUserGameStatsRequest(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	h.getUserAchievements(ctx, userID)
+}
+
+func (h *Statistics) getUserAchievements(ctx *gin.Context, userID int64) {
+	achievements, err := h.uc.GetUserAchievements(ctx.Request.Context(), userID)
+	if err != nil {
+		httpErr := dto.FromGRPCError(err)
+		ctx.JSON(httpErr.Code, gin.H{"error": httpErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.FromModelToAchievementsResponse(achievements))
+}
+
+// GetMyMissions returns the active daily and weekly missions with the authenticated player's progress.
+func (h *Statistics) GetMyMissions(ctx *gin.Context) {
+	userID, err := dto.UserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	missions, err := h.uc.GetUserMissions(ctx.Request.Context(), userID)
+	if err != nil {
+		httpErr := dto.FromGRPCError(err)
+		ctx.JSON(httpErr.Code, gin.H{"error": httpErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.FromModelToMissionsResponse(missions))
+}
+
+// ClaimMissionReward credits the reward of a completed mission to the authenticated player.
+func (h *Statistics) ClaimMissionReward(ctx *gin.Context) {
+	userID, err := dto.UserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	claim, err := h.uc.ClaimMissionReward(ctx.Request.Context(), userID, ctx.Param("missionID"))
+	if err != nil {
+		httpErr := dto.FromGRPCError(err)
+		ctx.JSON(httpErr.Code, gin.H{"error": httpErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.FromModelToMissionClaimResponse(claim))
+}
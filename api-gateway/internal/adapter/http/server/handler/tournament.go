@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"net/http"
+
+	"api-gateway/internal/adapter/http/server/handler/dto"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Game) ListTournaments(ctx *gin.Context) {
+	status, err := dto.ToListTournamentsStatus(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	tournaments, err := h.uc.ListTournaments(ctx.Request.Context(), status)
+	if err != nil {
+		httpErr := dto.FromGRPCError(err)
+		ctx.JSON(httpErr.Code, gin.H{"error": httpErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.FromModelToListTournamentsResponse(tournaments))
+}
+
+// GetTournament returns a tournament with its bracket and, once finished, the standings.
+func (h *Game) GetTournament(ctx *gin.Context) {
+	tournament, err := h.uc.GetTournament(ctx.Request.Context(), ctx.Param("tournamentID"))
+	if err != nil {
+		httpErr := dto.FromGRPCError(err)
+		ctx.JSON(httpErr.Code, gin.H{"error": httpErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.FromModelToTournamentResponse(tournament))
+}
+
+// RegisterTournament registers the player; the buy-in is held on their balance until the tournament is settled.
+func (h *Game) RegisterTournament(ctx *gin.Context) {
+	userID, err := dto.UserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	tournament, err := h.uc.RegisterTournament(ctx.Request.Context(), ctx.Param("tournamentID"), userID)
+	if err != nil {
+		httpErr := dto.FromGRPCError(err)
+		ctx.JSON(httpErr.Code, gin.H{"error": httpErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.FromModelToTournamentResponse(tournament))
+}
+
+// UnregisterTournament withdraws the player before the start and releases the buy-in.
+func (h *Game) UnregisterTournament(ctx *gin.Context) {
+	userID, err := dto.UserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	tournament, err := h.uc.UnregisterTournament(ctx.Request.Context(), ctx.Param("tournamentID"), userID)
+	if err != nil {
+		httpErr := dto.FromGRPCError(err)
+		ctx.JSON(httpErr.Code, gin.H{"error": httpErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.FromModelToTournamentResponse(tournament))
+}
+
+// CreateTournament schedules a tournament; game-service also keeps one sit-and-go of each size open on its own.
+func (h *Game) CreateTournament(ctx *gin.Context) {
+	var req dto.CreateTournamentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request data"})
+		return
+	}
+	tournament, err := h.uc.CreateTournament(ctx.Request.Context(), dto.ToNewTournament(req))
+	if err != nil {
+		httpErr := dto.FromGRPCError(err)
+		ctx.JSON(httpErr.Code, gin.H{"error": httpErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusCreated, dto.FromModelToTournamentResponse(tournament))
+}
@@ -137,3 +137,138 @@ func (h *UserProfile) UpdateRating(ctx *gin.Context) {
 	}
 	ctx.JSON(http.StatusOK, gin.H{})
 }
+
+func (h *UserProfile) GetTransactions(ctx *gin.Context) {
+	filter, err := dto.ToTransactionFilterFromRequest(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	page, err := h.uc.GetTransactions(ctx.Request.Context(), filter)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.FromModelToTransactionsResponse(page))
+}
+
+func (h *UserProfile) ClaimDailyBonus(ctx *gin.Context) {
+	userID, err := dto.UserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	claim, err := h.uc.ClaimDailyBonus(ctx.Request.Context(), userID)
+	if err != nil {
+		httpErr := dto.FromGRPCError(err)
+		ctx.JSON(httpErr.Code, gin.H{"error": httpErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.FromModelToRewardClaimResponse(claim))
+}
+
+func (h *UserProfile) ClaimRefill(ctx *gin.Context) {
+	userID, err := dto.UserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	claim, err := h.uc.ClaimRefill(ctx.Request.Context(), userID)
+	if err != nil {
+		httpErr := dto.FromGRPCError(err)
+		ctx.JSON(httpErr.Code, gin.H{"error": httpErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.FromModelToRewardClaimResponse(claim))
+}
+
+func (h *UserProfile) TransferChips(ctx *gin.Context) {
+	userID, err := dto.UserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	var req dto.TransferChipsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request data"})
+		return
+	}
+	transfer, err := dto.ToChipTransferFromRequest(ctx, userID, req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	result, err := h.uc.TransferChips(ctx.Request.Context(), transfer)
+	if err != nil {
+		httpErr := dto.FromGRPCError(err)
+		ctx.JSON(httpErr.Code, gin.H{"error": httpErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.FromModelToTransferChipsResponse(result))
+}
+
+func (h *UserProfile) GetResponsibleGaming(ctx *gin.Context) {
+	userID, err := dto.UserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	settings, err := h.uc.GetResponsibleGaming(ctx.Request.Context(), userID)
+	if err != nil {
+		httpErr := dto.FromGRPCError(err)
+		ctx.JSON(httpErr.Code, gin.H{"error": httpErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.FromModelToResponsibleGamingResponse(settings))
+}
+
+// SetGamingLimit applies a stricter limit at once, a looser one is returned as pending.
+func (h *UserProfile) SetGamingLimit(ctx *gin.Context) {
+	userID, err := dto.UserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	var req dto.SetGamingLimitRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request data"})
+		return
+	}
+	limit, err := dto.ToGamingLimitFromRequest(req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	settings, err := h.uc.SetGamingLimit(ctx.Request.Context(), userID, limit)
+	if err != nil {
+		httpErr := dto.FromGRPCError(err)
+		ctx.JSON(httpErr.Code, gin.H{"error": httpErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.FromModelToResponsibleGamingResponse(settings))
+}
+
+func (h *UserProfile) SelfExclude(ctx *gin.Context) {
+	userID, err := dto.UserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	var req dto.SelfExcludeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request data"})
+		return
+	}
+	duration, err := dto.ToSelfExclusionDuration(req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	settings, err := h.uc.SelfExclude(ctx.Request.Context(), userID, duration)
+	if err != nil {
+		httpErr := dto.FromGRPCError(err)
+		ctx.JSON(httpErr.Code, gin.H{"error": httpErr.Message})
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.FromModelToResponsibleGamingResponse(settings))
+}
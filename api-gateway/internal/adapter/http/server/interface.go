@@ -15,3 +15,7 @@ type UserProfileUsecase interface {
 type StatisticsUsecase interface {
 	handler.StatisticsUsecase
 }
+
+type GameUsecase interface {
+	handler.GameUsecase
+}
@@ -8,6 +8,10 @@ import (
 
 const (
 	UserIDKey = "userID"
+	RoleKey   = "role"
+
+	// adminRole is the access token role allowed to use the admin endpoints.
+	adminRole = "admin"
 )
 
 func AuthMiddleware(secretKey string) gin.HandlerFunc {
@@ -40,8 +44,25 @@ func AuthMiddleware(secretKey string) gin.HandlerFunc {
 		}
 
 		// Set auth claims in context
-		if userID, ok := claims["user_id"].(string); ok {
+		switch userID := claims["user_id"].(type) {
+		case string:
 			c.Set(UserIDKey, userID)
+		case float64: // JSON numbers are decoded as float64
+			c.Set(UserIDKey, int64(userID))
+		}
+		if role, ok := claims["role"].(string); ok {
+			c.Set(RoleKey, role)
+		}
+		c.Next()
+	}
+}
+
+// AdminMiddleware lets through only tokens with the admin role. It must run after AuthMiddleware.
+func AdminMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString(RoleKey) != adminRole {
+			c.AbortWithStatusJSON(403, gin.H{"error": "admin role required"})
+			return
 		}
 		c.Next()
 	}
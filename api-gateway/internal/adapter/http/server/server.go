@@ -27,9 +27,10 @@ type API struct {
 	authHandler      *handler.User
 	userHandler      *handler.UserProfile
 	statisticHandler *handler.Statistics
+	gameHandler      *handler.Game
 }
 
-func New(cfg config.Server, auth UserUsecase, statistic StatisticsUsecase, user UserProfileUsecase) *API {
+func New(cfg config.Server, auth UserUsecase, statistic StatisticsUsecase, user UserProfileUsecase, game GameUsecase) *API {
 	// Setting the Gin mode
 	gin.SetMode(cfg.HTTPServer.Mode)
 	// Creating a new Gin Engine
@@ -42,6 +43,7 @@ func New(cfg config.Server, auth UserUsecase, statistic StatisticsUsecase, user
 	authHandler := handler.NewUser(auth)
 	userHandler := handler.NewUserProfile(user)
 	statisticHandler := handler.NewStatistics(statistic)
+	gameHandler := handler.NewGame(game)
 
 	api := &API{
 		server:           server,
@@ -51,6 +53,7 @@ func New(cfg config.Server, auth UserUsecase, statistic StatisticsUsecase, user
 		authHandler:      authHandler,
 		userHandler:      userHandler,
 		statisticHandler: statisticHandler,
+		gameHandler:      gameHandler,
 	}
 
 	api.setupRoutes()
@@ -92,6 +95,13 @@ func (a *API) setupRoutes() {
 			usersGroup.PATCH("/profile", a.userHandler.UpdateProfile)
 			usersGroup.GET("/balance", a.userHandler.GetBalance)
 			usersGroup.GET("/rating", a.userHandler.GetRating)
+			usersGroup.GET("/transactions", a.userHandler.GetTransactions)
+			usersGroup.POST("/bonus/daily", a.userHandler.ClaimDailyBonus)
+			usersGroup.POST("/bonus/refill", a.userHandler.ClaimRefill)
+			usersGroup.POST("/transfer", a.userHandler.TransferChips)
+			usersGroup.GET("/limits", a.userHandler.GetResponsibleGaming)
+			usersGroup.PUT("/limits", a.userHandler.SetGamingLimit)
+			usersGroup.POST("/self-exclusion", a.userHandler.SelfExclude)
 		}
 
 		// Routes for game statistics
@@ -101,6 +111,44 @@ func (a *API) setupRoutes() {
 			statisticsGroup.GET("/general", a.statisticHandler.GetGeneralGameStats)
 			statisticsGroup.GET("/user/:userID", a.statisticHandler.GetUserGameStats)
 			statisticsGroup.GET("/leaderboard", a.statisticHandler.GetLeaderboard)
+			statisticsGroup.GET("/league", a.statisticHandler.GetMyLeague)
+			statisticsGroup.GET("/league/user/:userID", a.statisticHandler.GetUserLeague)
+			statisticsGroup.GET("/achievements", a.statisticHandler.GetMyAchievements)
+			statisticsGroup.GET("/achievements/user/:userID", a.statisticHandler.GetUserAchievements)
+			statisticsGroup.GET("/missions", a.statisticHandler.GetMyMissions)
+			statisticsGroup.POST("/missions/:missionID/claim", a.statisticHandler.ClaimMissionReward)
+		}
+
+		// Lobby routes, handled by gameHandler (*handler.Game).
+		gameGroup := v1.Group("/game")
+		{
+			gameGroup.GET("/rooms", a.gameHandler.ListRooms)
+			gameGroup.GET("/rooms/:roomID", a.gameHandler.GetRoom)
+			gameGroup.GET("/online", a.gameHandler.GetOnlineCount)
+
+			// Tournaments: registration holds the buy-in, the bracket is played in rooms game-service creates.
+			gameGroup.GET("/tournaments", a.gameHandler.ListTournaments)
+			gameGroup.GET("/tournaments/:tournamentID", a.gameHandler.GetTournament)
+			gameGroup.POST("/tournaments/:tournamentID/register", a.gameHandler.RegisterTournament)
+			gameGroup.DELETE("/tournaments/:tournamentID/register", a.gameHandler.UnregisterTournament)
+
+			// Fallback transport for networks that block WebSockets: commands over REST, events over SSE.
+			gameGroup.GET("/events", a.gameHandler.StreamEvents)
+			gameGroup.POST("/play/rooms", a.gameHandler.CreateRoom)
+			gameGroup.POST("/play/rooms/:roomID/join", a.gameHandler.JoinRoom)
+			gameGroup.POST("/play/leave", a.gameHandler.LeaveRoom)
+			gameGroup.POST("/play/ready", a.gameHandler.Ready)
+			gameGroup.POST("/play/hit", a.gameHandler.Hit)
+			gameGroup.POST("/play/stand", a.gameHandler.Stand)
+		}
+
+		// Admin routes, only for tokens with the admin role.
+		adminGroup := v1.Group("/admin")
+		adminGroup.Use(middleware.AdminMiddleware())
+		{
+			adminGroup.POST("/game/rooms/:roomID/close", a.gameHandler.ForceCloseRoom)
+			adminGroup.POST("/game/players/:userID/kick", a.gameHandler.KickPlayer)
+			adminGroup.POST("/game/tournaments", a.gameHandler.CreateTournament)
 		}
 	}
 }
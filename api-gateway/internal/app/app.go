@@ -11,9 +11,11 @@ import (
 
 	"api-gateway/config"
 	authsvc "api-gateway/internal/adapter/frontend/proto/auth"
+	gamesvc "api-gateway/internal/adapter/frontend/proto/game"
 	statisticsvc "api-gateway/internal/adapter/frontend/proto/statistics"
 	usersvc "api-gateway/internal/adapter/frontend/proto/user"
 	grpcauthsvcclient "api-gateway/internal/adapter/grpc/auth"
+	grpcgamesvcclient "api-gateway/internal/adapter/grpc/game"
 	grpcstatisticssvcclient "api-gateway/internal/adapter/grpc/statistics"
 	grpcusersvcclient "api-gateway/internal/adapter/grpc/user"
 	httpserver "api-gateway/internal/adapter/http/server"
@@ -44,18 +46,26 @@ func New(ctx context.Context, cfg *config.Config) (*App, error) {
 		return nil, err
 	}
 
+	gameServiceGRPCConn, err := grpcconn.New(cfg.GRPC.GRPCClient.GameServiceURL)
+	if err != nil {
+		return nil, err
+	}
+
 	authServiceClient := grpcauthsvcclient.NewAuth(authsvc.NewUserServiceClient(authServiceGRPCConn))
 	userServiceClient := grpcusersvcclient.NewUser(usersvc.NewUserServiceClient(userServiceGRPCConn))
 	statisticServiceClient := grpcstatisticssvcclient.NewStatistics(
 		statisticsvc.NewStatisticsServiceClient(statsServiceGRPCConn),
 	)
 
+	gameServiceClient := grpcgamesvcclient.NewGame(gamesvc.NewGameServiceClient(gameServiceGRPCConn))
+
 	authUsecase := usecase.NewUser(authServiceClient)
 	inventoryUsecase := usecase.NewUserProfile(userServiceClient)
 	statisticUsecase := usecase.NewStatistics(statisticServiceClient)
+	gameUsecase := usecase.NewGame(gameServiceClient)
 
 	// http service
-	httpServer := httpserver.New(cfg.Server, authUsecase, statisticUsecase, inventoryUsecase)
+	httpServer := httpserver.New(cfg.Server, authUsecase, statisticUsecase, inventoryUsecase, gameUsecase)
 
 	app := &App{
 		httpServer: httpServer,
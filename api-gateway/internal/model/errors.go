@@ -7,4 +7,6 @@ var (
 	ErrInvalidPassword = errors.New("invalid password")
 	ErrInvalidID       = errors.New("invalid id")
 	ErrNotFound        = errors.New("not found")
+	ErrInvalidAmount   = errors.New("invalid amount")
+	ErrInvalidStatus   = errors.New("invalid status")
 )
@@ -0,0 +1,105 @@
+package model
+
+import "time"
+
+// GameRoom is the public state of a game-service room shown in the lobby.
+type GameRoom struct {
+	ID                  string
+	Status              string // "waiting", "in_progress"
+	Bet                 int64
+	Players             []GamePlayer
+	CurrentTurnPlayerID string
+}
+
+type GamePlayer struct {
+	ID      string
+	IsReady bool
+	Stood   bool
+}
+
+// OnlineCount holds the players online across game-service and the connections of the instance that answered.
+type OnlineCount struct {
+	Players     int64
+	Connections int64
+}
+
+// ClosedGameRoom is a room an admin closed; Refunded is set when a running game's stakes were returned.
+type ClosedGameRoom struct {
+	RoomID    string
+	PlayerIDs []string
+	Refunded  bool
+}
+
+// KickResult tells whether the kicked player was online and which room they were taken out of.
+type KickResult struct {
+	WasOnline bool
+	RoomID    string
+}
+
+// GameCommand is a game action sent over REST by a player who can't use WebSockets.
+type GameCommand struct {
+	UserID    int64
+	Type      string // "create_room", "join_room", "leave_room", "ready", "hit", "stand"
+	RequestID string
+	Payload   []byte // JSON payload of the command, as over WebSocket
+}
+
+// GameCommandReply is the answer of game-service to a command: an "ack" or an "error" with a code.
+// Envelope is the protocol v1 JSON envelope, the same a WebSocket client gets.
+type GameCommandReply struct {
+	Type      string
+	ErrorCode string
+	Envelope  []byte
+}
+
+// GameEvent is one message of a player's event stream; Data is the protocol v1 JSON envelope.
+type GameEvent struct {
+	Type string
+	Data []byte
+}
+
+// Tournament is a game-service tournament with its bracket. Zero times are not set yet.
+type Tournament struct {
+	ID                   string
+	Name                 string
+	Format               string // "single_elimination", "sit_and_go"
+	Status               string // "registering", "running", "finished", "cancelled"
+	BuyIn                int64
+	MinPlayers           int
+	MaxPlayers           int
+	RegistrationOpensAt  time.Time
+	RegistrationClosesAt time.Time // not set for sit-and-go, which starts once full
+	Players              []string
+	PrizePool            int64
+	Payouts              []int // percent of the prize pool per place, known once started
+	Rounds               [][]TournamentMatch
+	Standings            []TournamentStanding
+	StartedAt            time.Time
+	FinishedAt           time.Time
+}
+
+// TournamentMatch is a bracket match; an empty player ID is a bye.
+type TournamentMatch struct {
+	PlayerIDs []string
+	RoomID    string
+	WinnerID  string
+	Forfeit   bool
+	Deadline  time.Time
+}
+
+type TournamentStanding struct {
+	PlayerID string
+	Place    int
+	Prize    int64
+}
+
+// NewTournament is a tournament an admin schedules.
+type NewTournament struct {
+	Name                 string
+	Format               string
+	BuyIn                int64
+	MinPlayers           int
+	MaxPlayers           int
+	RegistrationOpensAt  time.Time
+	RegistrationClosesAt time.Time
+}
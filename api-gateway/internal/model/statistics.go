@@ -9,24 +9,27 @@ type GeneralGameStats struct {
 	TotalUsers       int64
 	TotalGamesPlayed int64
 	TotalBetAmount   int64 // Sum of all bets
+	TotalRake        int64 // Sum of the house rake taken from pots
+	TotalJackpotPaid int64 // Sum of progressive jackpot payouts
 	LastUpdatedAt    time.Time
 }
 
 // UserGameStats holds auth for a specific auth.
 type UserGameStats struct {
-	UserID           int64
-	GamesPlayed      int64
-	GamesWon         int64
-	GamesLost        int64
-	GamesDrawn       int64
-	TotalBet         int64
-	TotalWinnings    int64 // Sum of bets won
-	TotalLosses      int64 // Sum of bets lost
-	WinRate          float64
-	LossRate         float64
-	WinStreak        int64
-	LossStreak       int64
-	LastGamePlayedAt time.Time
+	UserID            int64
+	GamesPlayed       int64
+	GamesWon          int64
+	GamesLost         int64
+	GamesDrawn        int64
+	TotalBet          int64
+	TotalWinnings     int64 // Sum of bets won
+	TotalLosses       int64 // Sum of bets lost
+	WinRate           float64
+	LossRate          float64
+	WinStreak         int64
+	LossStreak        int64
+	RankedGamesPlayed int64
+	LastGamePlayedAt  time.Time
 }
 
 // LeaderboardEntry represents an entry in a leaderboard.
@@ -44,6 +47,58 @@ type Leaderboard struct {
 	Entries []LeaderboardEntry
 }
 
+// LeagueGroup is the weekly league group a player competes in, with its standings.
+// Joined is false until the player finishes a game this week; then only the division is known.
+type LeagueGroup struct {
+	Week          string
+	Division      int32
+	DivisionName  string
+	Group         int32
+	Joined        bool
+	Standings     []LeagueStanding
+	EndsAt        time.Time
+	PromoteCount  int32
+	RelegateCount int32
+}
+
+// LeagueStanding is a player's place in their league group; Zone is "promotion", "relegation" or empty.
+type LeagueStanding struct {
+	UserID int64
+	Score  int64
+	Rank   int32
+	Zone   string
+}
+
+// Achievement is an achievement with the player's progress; UnlockedAt is nil while locked.
+type Achievement struct {
+	ID          string
+	Name        string
+	Description string
+	Unlocked    bool
+	UnlockedAt  *time.Time
+}
+
+// Mission is an active daily or weekly mission with the player's progress.
+type Mission struct {
+	ID          string
+	Name        string
+	Description string
+	Period      string // daily or weekly
+	Progress    int64
+	Target      int64
+	Reward      int64
+	Completed   bool
+	Claimed     bool
+	EndsAt      time.Time
+}
+
+// MissionClaim is the result of claiming a mission reward.
+type MissionClaim struct {
+	Mission Mission
+	Amount  int64
+	Balance int64
+}
+
 // GameHistory represents a single recorded game event.
 type GameHistory struct {
 	ID           string
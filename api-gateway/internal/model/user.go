@@ -15,3 +15,54 @@ type UserProfile struct {
 	Balance   *int64
 	Rating    *int64
 }
+
+type Transaction struct {
+	ID            int64
+	TransactionID int64
+	Kind          string
+	Amount        int64
+	BalanceAfter  int64
+	Reference     string
+	CreatedAt     time.Time
+}
+
+type TransactionFilter struct {
+	UserID int64
+	Limit  int64
+	Offset int64
+}
+
+type TransactionPage struct {
+	Transactions []Transaction
+	Total        int64
+}
+
+type RewardClaim struct {
+	Amount      int64
+	Balance     int64
+	DailyStreak int64
+}
+
+type ChipTransfer struct {
+	FromUserID     int64
+	ToUserID       int64
+	Amount         int64
+	IdempotencyKey string
+}
+
+type ChipTransferResult struct {
+	Balance        int64
+	AlreadyApplied bool
+}
+
+type GamingLimit struct {
+	Kind         string
+	Value        int64
+	PendingValue *int64
+	PendingFrom  *time.Time
+}
+
+type ResponsibleGaming struct {
+	Limits            []GamingLimit
+	SelfExcludedUntil *time.Time
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+
+	"api-gateway/internal/model"
+)
+
+type Game struct {
+	presenter GamePresenter
+}
+
+func NewGame(p GamePresenter) *Game {
+	return &Game{presenter: p}
+}
+
+func (g *Game) ListRooms(ctx context.Context, status string) ([]model.GameRoom, error) {
+	return g.presenter.ListRooms(ctx, status)
+}
+
+func (g *Game) GetRoom(ctx context.Context, roomID string) (model.GameRoom, error) {
+	return g.presenter.GetRoom(ctx, roomID)
+}
+
+func (g *Game) GetOnlineCount(ctx context.Context) (model.OnlineCount, error) {
+	return g.presenter.GetOnlineCount(ctx)
+}
+
+func (g *Game) ForceCloseRoom(ctx context.Context, roomID string, reason string) (model.ClosedGameRoom, error) {
+	return g.presenter.ForceCloseRoom(ctx, roomID, reason)
+}
+
+func (g *Game) KickPlayer(ctx context.Context, userID int64, reason string) (model.KickResult, error) {
+	return g.presenter.KickPlayer(ctx, userID, reason)
+}
+
+func (g *Game) SendCommand(ctx context.Context, cmd model.GameCommand) (model.GameCommandReply, error) {
+	return g.presenter.SendCommand(ctx, cmd)
+}
+
+func (g *Game) StreamEvents(ctx context.Context, userID int64, remoteIP string, send func(model.GameEvent) error) error {
+	return g.presenter.StreamEvents(ctx, userID, remoteIP, send)
+}
+
+func (g *Game) ListTournaments(ctx context.Context, status string) ([]model.Tournament, error) {
+	return g.presenter.ListTournaments(ctx, status)
+}
+
+func (g *Game) GetTournament(ctx context.Context, tournamentID string) (model.Tournament, error) {
+	return g.presenter.GetTournament(ctx, tournamentID)
+}
+
+func (g *Game) RegisterTournament(ctx context.Context, tournamentID string, userID int64) (model.Tournament, error) {
+	return g.presenter.RegisterTournament(ctx, tournamentID, userID)
+}
+
+func (g *Game) UnregisterTournament(ctx context.Context, tournamentID string, userID int64) (model.Tournament, error) {
+	return g.presenter.UnregisterTournament(ctx, tournamentID, userID)
+}
+
+func (g *Game) CreateTournament(ctx context.Context, t model.NewTournament) (model.Tournament, error) {
+	return g.presenter.CreateTournament(ctx, t)
+}
@@ -25,3 +25,19 @@ func (s *Statistics) GetUserGameStats(ctx context.Context, userID int64) (*model
 func (s *Statistics) GetLeaderboard(ctx context.Context, req model.Leaderboard) (*model.Leaderboard, error) {
 	return s.presenter.GetLeaderboard(ctx, req)
 }
+
+func (s *Statistics) GetUserLeague(ctx context.Context, userID int64) (model.LeagueGroup, error) {
+	return s.presenter.GetUserLeague(ctx, userID)
+}
+
+func (s *Statistics) GetUserAchievements(ctx context.Context, userID int64) ([]model.Achievement, error) {
+	return s.presenter.GetUserAchievements(ctx, userID)
+}
+
+func (s *Statistics) GetUserMissions(ctx context.Context, userID int64) ([]model.Mission, error) {
+	return s.presenter.GetUserMissions(ctx, userID)
+}
+
+func (s *Statistics) ClaimMissionReward(ctx context.Context, userID int64, missionID string) (model.MissionClaim, error) {
+	return s.presenter.ClaimMissionReward(ctx, userID, missionID)
+}
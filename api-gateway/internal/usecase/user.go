@@ -4,6 +4,7 @@ import (
 	"api-gateway/internal/model"
 	"context"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"time"
 )
 
 type UserProfile struct {
@@ -41,3 +42,31 @@ func (u *UserProfile) GetRating(ctx context.Context, request model.UserProfile)
 func (u *UserProfile) UpdateRating(ctx context.Context, request model.UserProfile) (*emptypb.Empty, error) {
 	return u.presenter.UpdateRating(ctx, request)
 }
+
+func (u *UserProfile) GetTransactions(ctx context.Context, filter model.TransactionFilter) (model.TransactionPage, error) {
+	return u.presenter.GetTransactions(ctx, filter)
+}
+
+func (u *UserProfile) ClaimDailyBonus(ctx context.Context, userID int64) (model.RewardClaim, error) {
+	return u.presenter.ClaimDailyBonus(ctx, userID)
+}
+
+func (u *UserProfile) ClaimRefill(ctx context.Context, userID int64) (model.RewardClaim, error) {
+	return u.presenter.ClaimRefill(ctx, userID)
+}
+
+func (u *UserProfile) TransferChips(ctx context.Context, transfer model.ChipTransfer) (model.ChipTransferResult, error) {
+	return u.presenter.TransferChips(ctx, transfer)
+}
+
+func (u *UserProfile) GetResponsibleGaming(ctx context.Context, userID int64) (model.ResponsibleGaming, error) {
+	return u.presenter.GetResponsibleGaming(ctx, userID)
+}
+
+func (u *UserProfile) SetGamingLimit(ctx context.Context, userID int64, limit model.GamingLimit) (model.ResponsibleGaming, error) {
+	return u.presenter.SetGamingLimit(ctx, userID, limit)
+}
+
+func (u *UserProfile) SelfExclude(ctx context.Context, userID int64, duration time.Duration) (model.ResponsibleGaming, error) {
+	return u.presenter.SelfExclude(ctx, userID, duration)
+}
@@ -0,0 +1,139 @@
+// Command wsschema generates the JSON Schema of the WebSocket protocol v1 from the message DTOs.
+//
+//	go generate ./internal/adapter/ws/server/dto
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"game_svc/internal/adapter/ws/server/dto"
+	gameservicews "game_svc/pkg/ws"
+)
+
+type schema map[string]interface{}
+
+func main() {
+	out := flag.String("out", "api/ws/protocol.schema.json", "file to write the schema to")
+	flag.Parse()
+
+	doc := schema{
+		"$schema":     "https://json-schema.org/draft/2020-12/schema",
+		"$id":         gameservicews.SubprotocolV1JSON,
+		"title":       "Dueljack WebSocket protocol v1",
+		"description": "Negotiate with Sec-WebSocket-Protocol: " + gameservicews.SubprotocolV1JSON + ". Every command gets exactly one ack or error with the same request_id; events carry no request_id.",
+		"$defs": schema{
+			"ClientMessage": schema{"oneOf": messages(dto.Commands, true)},
+			"ServerMessage": schema{"oneOf": append(messages(dto.Replies, false), messages(dto.Events, false)...)},
+			"ErrorCode":     schema{"type": "string", "enum": dto.ErrorCodes},
+		},
+		"oneOf": []schema{
+			{"$ref": "#/$defs/ClientMessage"},
+			{"$ref": "#/$defs/ServerMessage"},
+		},
+	}
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		log.Fatalf("marshal schema: %v", err)
+	}
+	if err := os.WriteFile(*out, append(data, '\n'), 0o644); err != nil {
+		log.Fatalf("write %s: %v", *out, err)
+	}
+}
+
+// messages describes every message type as an envelope with its payload.
+func messages(payloads map[string]interface{}, isCommand bool) []schema {
+	names := make([]string, 0, len(payloads))
+	for name := range payloads {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make([]schema, 0, len(names))
+	for _, name := range names {
+		payload := schema{}
+		if p := payloads[name]; p != nil {
+			payload = typeSchema(reflect.TypeOf(p))
+		}
+		if name == "error" {
+			payload["properties"].(schema)["code"] = schema{"$ref": "#/$defs/ErrorCode"}
+		}
+		required := []string{"v", "type"}
+		if isCommand {
+			required = append(required, "request_id")
+		}
+		result = append(result, schema{
+			"title": name,
+			"type":  "object",
+			"properties": schema{
+				"v":          schema{"const": gameservicews.ProtocolV1},
+				"type":       schema{"const": name},
+				"request_id": schema{"type": "string"},
+				"payload":    payload,
+			},
+			"required": required,
+		})
+	}
+	return result
+}
+
+func typeSchema(t reflect.Type) schema {
+	if t == reflect.TypeOf(json.RawMessage{}) {
+		return schema{}
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		return typeSchema(t.Elem())
+	case reflect.String:
+		return schema{"type": "string"}
+	case reflect.Bool:
+		return schema{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return schema{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return schema{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return schema{"type": "array", "items": typeSchema(t.Elem())}
+	case reflect.Map:
+		return schema{"type": "object", "additionalProperties": typeSchema(t.Elem())}
+	case reflect.Struct:
+		return structSchema(t)
+	default:
+		// interface{}: the payload shape is not fixed
+		return schema{}
+	}
+}
+
+func structSchema(t reflect.Type) schema {
+	properties := schema{}
+	required := []string{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		properties[name] = typeSchema(field.Type)
+		if !strings.Contains(opts, "omitempty") && field.Type.Kind() != reflect.Pointer {
+			required = append(required, name)
+		}
+	}
+	s := schema{"type": "object", "properties": properties, "additionalProperties": false}
+	if len(required) > 0 {
+		s["required"] = required
+	}
+	return s
+}
@@ -13,6 +13,11 @@ type (
 		Nats       Nats
 		JWTManager JWTManager
 		GRPC       GRPC
+		Game       Game
+		Rooms      Rooms
+		WSLimits   WSLimits
+		Drain      Drain
+		Tournament Tournament
 		Version    string `env:"VERSION"`
 	}
 
@@ -23,6 +28,16 @@ type (
 		WriteTimeoutSec    time.Duration `env:"WEBSOCKET_WRITE_TIMEOUT_SEC" envDefault:"10s"` // e.g. for upgrader or http server
 		IdleTimeoutSec     time.Duration `env:"WEBSOCKET_IDLE_TIMEOUT_SEC" envDefault:"120s"` // e.g. for http server
 		ShutdownTimeoutSec time.Duration `env:"SERVER_SHUTDOWN_TIMEOUT_SEC" envDefault:"15s"`
+		// NodeID identifies this replica in the cluster; a random one is generated when empty
+		NodeID string `env:"NODE_ID"`
+		// PresenceTTL is how long a user counts as online on this node after its last heartbeat;
+		// PresenceHeartbeat is how often the node refreshes its users and must be shorter
+		PresenceTTL       time.Duration `env:"WEBSOCKET_PRESENCE_TTL" envDefault:"90s"`
+		PresenceHeartbeat time.Duration `env:"WEBSOCKET_PRESENCE_HEARTBEAT" envDefault:"30s"`
+		// SlowConsumerMaxDrops is how many messages in a row a client may miss before it is disconnected
+		SlowConsumerMaxDrops int `env:"WEBSOCKET_SLOW_CONSUMER_MAX_DROPS" envDefault:"16"`
+		// SessionPolicy is "takeover" (a new connection closes the old one) or "multi_view" (extra connections are read-only)
+		SessionPolicy string `env:"WEBSOCKET_SESSION_POLICY" envDefault:"takeover"`
 		// AllowedOrigins  []string `env:"WEBSOCKET_ALLOWED_ORIGINS" envSeparator:"," envDefault:"*"` // For CheckOrigin
 	}
 
@@ -37,6 +52,10 @@ type (
 	// NatsSubjects for main application
 	NatsSubjects struct {
 		GameResultSubject string `env:"NATS_GAME_RESULT_SUBJECT,notEmpty"`
+		// WSFanoutSubject prefixes the subjects replicas use to relay room and user messages to each other
+		WSFanoutSubject string `env:"NATS_WS_FANOUT_SUBJECT" envDefault:"game.ws"`
+		// TournamentResultSubject receives a TournamentResult when a tournament is paid out
+		TournamentResultSubject string `env:"NATS_TOURNAMENT_RESULT_SUBJECT" envDefault:"game.events.tournament_result"`
 	}
 
 	// Redis configuration for main application
@@ -49,13 +68,91 @@ type (
 		ReadTimeout  time.Duration `env:"REDIS_READ_TIMEOUT" envDefault:"30s"`
 	}
 
+	// Game configures rules of a match
+	Game struct {
+		// HoldTTL is how long bets stay reserved in user-service if a game never settles
+		HoldTTL time.Duration `env:"GAME_HOLD_TTL" envDefault:"30m"`
+		// RakePercent is the house share of the pot, capped by RakeCap chips
+		RakePercent float64 `env:"GAME_RAKE_PERCENT" envDefault:"5"`
+		RakeCap     int64   `env:"GAME_RAKE_CAP" envDefault:"500"`
+		// JackpotSharePercent is the part of the rake that feeds the progressive jackpot
+		JackpotSharePercent float64 `env:"GAME_JACKPOT_SHARE_PERCENT" envDefault:"10"`
+	}
+
+	// WSLimits bounds what one WebSocket client may do; 0 disables a limit
+	WSLimits struct {
+		// MessageRate is the default "<per second>/<burst>" for each command type of one connection
+		MessageRate string `env:"WS_MESSAGE_RATE" envDefault:"5/10"`
+		// TypeRates overrides MessageRate per command type, e.g. "create_room:0.2/2,find_ranked_match:0.2/2"
+		TypeRates map[string]string `env:"WS_MESSAGE_TYPE_RATES" envDefault:"create_room:0.2/2,join_room:0.5/3,find_ranked_match:0.2/2"`
+		// MaxInFlight is how many commands of one connection may be processed at once
+		MaxInFlight     int `env:"WS_MAX_IN_FLIGHT" envDefault:"4"`
+		MaxConnsPerUser int `env:"WS_MAX_CONNS_PER_USER" envDefault:"5"`
+		MaxConnsPerIP   int `env:"WS_MAX_CONNS_PER_IP" envDefault:"50"`
+		// A connection with more than MaxViolations rejected commands within ViolationWindow is closed
+		MaxViolations   int           `env:"WS_MAX_VIOLATIONS" envDefault:"20"`
+		ViolationWindow time.Duration `env:"WS_VIOLATION_WINDOW" envDefault:"1m"`
+	}
+
+	// Drain controls how the instance winds down on SIGTERM or POST /admin/drain
+	Drain struct {
+		// Timeout is how long running games get to finish before they are handed over
+		Timeout      time.Duration `env:"DRAIN_TIMEOUT" envDefault:"5m"`
+		PollInterval time.Duration `env:"DRAIN_POLL_INTERVAL" envDefault:"2s"`
+		// LeftoverPolicy is "resume" (the next instance resumes the games) or "refund" (cancel and refund them)
+		LeftoverPolicy string `env:"DRAIN_LEFTOVER_POLICY" envDefault:"resume"`
+	}
+
+	// Rooms configures room expiry and cleanup
+	Rooms struct {
+		// IdleTTL is how long a room stays in Redis after its last action
+		IdleTTL time.Duration `env:"ROOM_IDLE_TTL" envDefault:"30m"`
+		// StuckGameAfter is how long a game may go without actions before the janitor refunds the stakes and closes the room
+		StuckGameAfter time.Duration `env:"ROOM_STUCK_GAME_AFTER" envDefault:"10m"`
+		// JanitorInterval is how often the janitor looks for stuck rooms
+		JanitorInterval time.Duration `env:"ROOM_JANITOR_INTERVAL" envDefault:"1m"`
+		// ResumeGrace is how long players of games restored after a restart have to reconnect
+		ResumeGrace time.Duration `env:"ROOM_RESUME_GRACE" envDefault:"2m"`
+	}
+
+	// Tournament configures scheduled single-elimination tournaments and sit-and-go events
+	Tournament struct {
+		// TickInterval is how often the scheduler opens match rooms, applies no-shows and advances brackets
+		TickInterval time.Duration `env:"TOURNAMENT_TICK_INTERVAL" envDefault:"5s"`
+		// NoShowTimeout is how long players of a match have to start their game before the match is forfeited
+		NoShowTimeout time.Duration `env:"TOURNAMENT_NO_SHOW_TIMEOUT" envDefault:"2m"`
+		// HoldTTL is how long a buy-in stays reserved in user-service; a sit-and-go that doesn't fill
+		// within half of it is cancelled and refunded, and a single-elimination registration window may
+		// last at most half of it
+		HoldTTL time.Duration `env:"TOURNAMENT_HOLD_TTL" envDefault:"24h"`
+		// FinishedTTL is how long finished and cancelled tournaments stay visible
+		FinishedTTL time.Duration `env:"TOURNAMENT_FINISHED_TTL" envDefault:"168h"`
+		// SitAndGoSizes lists the sit-and-go field sizes that always have one event open for registration
+		SitAndGoSizes []int `env:"TOURNAMENT_SIT_AND_GO_SIZES" envSeparator:"," envDefault:"4,8"`
+		SitAndGoBuyIn int64 `env:"TOURNAMENT_SIT_AND_GO_BUY_IN" envDefault:"1000"`
+		// Payouts maps a field size to prize pool shares in percent by place; the row of the largest
+		// size not above the number of entrants is used
+		Payouts string `env:"TOURNAMENT_PAYOUTS" envDefault:"2:100;4:70,30;8:50,30,10,10;16:40,25,12,12,3,3,3,2"`
+	}
+
 	JWTManager struct {
 		SecretKey string `env:"JWT_MANAGER_SECRET_KEY,notEmpty"`
 	}
 	GRPC struct {
+		GRPCServer GRPCServer
 		GRPCClient GRPCClient
 	}
 
+	// GRPCServer serves the lobby, admin and fallback transport API used by api-gateway
+	GRPCServer struct {
+		Port              int16 `env:"GRPC_PORT,notEmpty" envDefault:"8084"`
+		MaxRecvMsgSizeMiB int   `env:"GRPC_MAX_MESSAGE_SIZE_MIB" envDefault:"12"`
+		// MaxConnectionAge is 0 (no limit) by default: an event stream lives as long as the player's
+		// session, and a recycled connection would end it like a disconnect
+		MaxConnectionAge      time.Duration `env:"GRPC_MAX_CONNECTION_AGE" envDefault:"0s"`
+		MaxConnectionAgeGrace time.Duration `env:"GRPC_MAX_CONNECTION_AGE_GRACE" envDefault:"10s"`
+	}
+
 	GRPCClient struct {
 		UserServiceURL string `env:"GRPC_USER_SERVICE_URL,required"`
 	}
@@ -0,0 +1,58 @@
+package dto
+
+import (
+	"context"
+	"errors"
+
+	"game_svc/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrRoomNotFound       = status.Error(codes.NotFound, "room not found")
+	ErrRoomStateConflict  = status.Error(codes.Aborted, "room state changed concurrently, try again")
+	ErrInvalidInput       = status.Error(codes.InvalidArgument, "invalid input data")
+	ErrServerDraining     = status.Error(codes.Unavailable, "server is draining, retry")
+	ErrTooManyConns       = status.Error(codes.ResourceExhausted, "too many connections")
+	ErrTournamentNotFound = status.Error(codes.NotFound, "tournament not found")
+	ErrTournamentConflict = status.Error(codes.Aborted, "tournament changed concurrently, try again")
+)
+
+func FromError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrRoomNotFound):
+		return ErrRoomNotFound
+	case errors.Is(err, model.ErrRoomStateConflict):
+		return ErrRoomStateConflict
+	case errors.Is(err, model.ErrServerDraining):
+		return ErrServerDraining
+	case errors.Is(err, model.ErrTooManyConnections):
+		return ErrTooManyConns
+	case errors.Is(err, model.ErrTournamentNotFound):
+		return ErrTournamentNotFound
+	case errors.Is(err, model.ErrTournamentStateConflict):
+		return ErrTournamentConflict
+	case errors.Is(err, model.ErrTournamentRegistration), errors.Is(err, model.ErrPlayRestricted), errors.Is(err, model.ErrGameSettling):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, model.ErrInvalidTournament):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	case status.Code(err) == codes.FailedPrecondition:
+		// user-service отказал в резерве бай-ина, например из-за нехватки фишек
+		return status.Error(codes.FailedPrecondition, upstreamMessage(err))
+
+	default:
+		return status.Error(codes.Internal, "something went wrong")
+	}
+}
+
+// upstreamMessage возвращает текст ошибки, которую вернул другой сервис, без обёрток usecase.
+func upstreamMessage(err error) string {
+	var se interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &se) {
+		return se.GRPCStatus().Message()
+	}
+	return err.Error()
+}
@@ -0,0 +1,17 @@
+package dto
+
+import (
+	"encoding/json"
+
+	gamesvc "game_svc/internal/adapter/grpc/server/frontend/proto/game"
+)
+
+// ToProtoGameEvent оборачивает JSON-конверт версии 1; тип достаётся из него, чтобы шлюз мог
+// отдать его как имя события SSE.
+func ToProtoGameEvent(message []byte) *gamesvc.GameEvent {
+	var envelope struct {
+		Type string `json:"type"`
+	}
+	_ = json.Unmarshal(message, &envelope)
+	return &gamesvc.GameEvent{Type: envelope.Type, Data: message}
+}
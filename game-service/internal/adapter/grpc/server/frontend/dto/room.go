@@ -0,0 +1,37 @@
+package dto
+
+import (
+	gamesvc "game_svc/internal/adapter/grpc/server/frontend/proto/game"
+	"game_svc/internal/model"
+)
+
+// FromModelToProtoRoom отдаёт публичную часть комнаты: колода и карты игроков не раскрываются.
+func FromModelToProtoRoom(room *model.Room) *gamesvc.Room {
+	players := make([]*gamesvc.Player, 0, len(room.Players))
+	for _, p := range room.Players {
+		players = append(players, &gamesvc.Player{
+			Id:      p.ID,
+			IsReady: p.IsReady,
+			Stood:   p.Stood,
+		})
+	}
+	return &gamesvc.Room{
+		Id:                  room.ID,
+		Status:              room.Status,
+		Bet:                 int64(room.Bet),
+		Players:             players,
+		CurrentTurnPlayerId: room.CurrentTurnPlayerID,
+	}
+}
+
+// FromModelToProtoListRooms оставляет комнаты в статусе status; пустой status — все комнаты.
+func FromModelToProtoListRooms(rooms []*model.Room, status string) *gamesvc.ListRoomsResponse {
+	resp := &gamesvc.ListRoomsResponse{Rooms: make([]*gamesvc.Room, 0, len(rooms))}
+	for _, room := range rooms {
+		if status != "" && room.Status != status {
+			continue
+		}
+		resp.Rooms = append(resp.Rooms, FromModelToProtoRoom(room))
+	}
+	return resp
+}
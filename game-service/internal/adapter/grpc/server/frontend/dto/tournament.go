@@ -0,0 +1,87 @@
+package dto
+
+import (
+	"time"
+
+	gamesvc "game_svc/internal/adapter/grpc/server/frontend/proto/game"
+	"game_svc/internal/model"
+)
+
+func FromModelToProtoTournament(t *model.Tournament) *gamesvc.Tournament {
+	resp := &gamesvc.Tournament{
+		Id:                   t.ID,
+		Name:                 t.Name,
+		Format:               t.Format,
+		Status:               t.Status,
+		BuyIn:                t.BuyIn,
+		MinPlayers:           int32(t.MinPlayers),
+		MaxPlayers:           int32(t.MaxPlayers),
+		RegistrationOpensAt:  toUnix(t.RegistrationOpensAt),
+		RegistrationClosesAt: toUnix(t.RegistrationClosesAt),
+		Players:              t.Players,
+		PrizePool:            t.PrizePool(),
+		Payouts:              make([]int32, 0, len(t.Payouts)),
+		Rounds:               make([]*gamesvc.TournamentRound, 0, len(t.Rounds)),
+		Standings:            make([]*gamesvc.TournamentStanding, 0, len(t.Standings)),
+		StartedAt:            toUnix(t.StartedAt),
+		FinishedAt:           toUnix(t.FinishedAt),
+	}
+	for _, pct := range t.Payouts {
+		resp.Payouts = append(resp.Payouts, int32(pct))
+	}
+	for _, round := range t.Rounds {
+		r := &gamesvc.TournamentRound{Matches: make([]*gamesvc.TournamentMatch, 0, len(round))}
+		for _, m := range round {
+			r.Matches = append(r.Matches, &gamesvc.TournamentMatch{
+				PlayerIds: []string{m.PlayerIDs[0], m.PlayerIDs[1]},
+				RoomId:    m.RoomID,
+				WinnerId:  m.WinnerID,
+				Forfeit:   m.Forfeit,
+				Deadline:  toUnix(m.Deadline),
+			})
+		}
+		resp.Rounds = append(resp.Rounds, r)
+	}
+	for _, st := range t.Standings {
+		resp.Standings = append(resp.Standings, &gamesvc.TournamentStanding{
+			PlayerId: st.PlayerID,
+			Place:    int32(st.Place),
+			Prize:    st.Prize,
+		})
+	}
+	return resp
+}
+
+func FromModelToProtoListTournaments(tournaments []*model.Tournament) *gamesvc.ListTournamentsResponse {
+	resp := &gamesvc.ListTournamentsResponse{Tournaments: make([]*gamesvc.Tournament, 0, len(tournaments))}
+	for _, t := range tournaments {
+		resp.Tournaments = append(resp.Tournaments, FromModelToProtoTournament(t))
+	}
+	return resp
+}
+
+func ToCreateTournamentParams(req *gamesvc.CreateTournamentRequest) model.CreateTournamentParams {
+	return model.CreateTournamentParams{
+		Name:                 req.Name,
+		Format:               req.Format,
+		BuyIn:                req.BuyIn,
+		MinPlayers:           int(req.MinPlayers),
+		MaxPlayers:           int(req.MaxPlayers),
+		RegistrationOpensAt:  fromUnix(req.RegistrationOpensAt),
+		RegistrationClosesAt: fromUnix(req.RegistrationClosesAt),
+	}
+}
+
+func toUnix(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+func fromUnix(sec int64) time.Time {
+	if sec <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(sec, 0)
+}
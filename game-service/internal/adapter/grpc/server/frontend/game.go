@@ -0,0 +1,161 @@
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+
+	"game_svc/internal/adapter/grpc/server/frontend/dto"
+	gamesvc "game_svc/internal/adapter/grpc/server/frontend/proto/game"
+)
+
+type Game struct {
+	gamesvc.UnimplementedGameServiceServer
+
+	gameUsecase       GameUsecase
+	tournamentUsecase TournamentUsecase
+	lobby             Lobby
+}
+
+func NewGame(uc GameUsecase, tournamentUC TournamentUsecase, lobby Lobby) *Game {
+	return &Game{
+		gameUsecase:       uc,
+		tournamentUsecase: tournamentUC,
+		lobby:             lobby,
+	}
+}
+
+func (c *Game) ListRooms(ctx context.Context, req *gamesvc.ListRoomsRequest) (*gamesvc.ListRoomsResponse, error) {
+	rooms, err := c.gameUsecase.ListRooms(ctx)
+	if err != nil {
+		return nil, dto.FromError(err)
+	}
+	return dto.FromModelToProtoListRooms(rooms, req.Status), nil
+}
+
+func (c *Game) GetRoom(ctx context.Context, req *gamesvc.RoomIDRequest) (*gamesvc.Room, error) {
+	if req.RoomId == "" {
+		return nil, dto.ErrInvalidInput
+	}
+	room, err := c.gameUsecase.GetRoom(ctx, req.RoomId)
+	if err != nil {
+		return nil, dto.FromError(err)
+	}
+	return dto.FromModelToProtoRoom(room), nil
+}
+
+func (c *Game) GetOnlineCount(ctx context.Context, _ *gamesvc.GetOnlineCountRequest) (*gamesvc.GetOnlineCountResponse, error) {
+	players, connections, err := c.lobby.OnlineCount(ctx)
+	if err != nil {
+		return nil, dto.FromError(err)
+	}
+	return &gamesvc.GetOnlineCountResponse{Players: players, Connections: connections}, nil
+}
+
+func (c *Game) ForceCloseRoom(ctx context.Context, req *gamesvc.ForceCloseRoomRequest) (*gamesvc.ForceCloseRoomResponse, error) {
+	if req.RoomId == "" {
+		return nil, dto.ErrInvalidInput
+	}
+	closed, err := c.lobby.ForceCloseRoom(ctx, req.RoomId, req.Reason)
+	if err != nil {
+		return nil, dto.FromError(err)
+	}
+	return &gamesvc.ForceCloseRoomResponse{
+		RoomId:    closed.RoomID,
+		PlayerIds: closed.PlayerIDs,
+		Refunded:  closed.Refunded,
+	}, nil
+}
+
+func (c *Game) KickPlayer(ctx context.Context, req *gamesvc.KickPlayerRequest) (*gamesvc.KickPlayerResponse, error) {
+	if req.UserId == "" {
+		return nil, dto.ErrInvalidInput
+	}
+	wasOnline, roomID, err := c.lobby.KickPlayer(ctx, req.UserId, req.Reason)
+	if err != nil {
+		return nil, dto.FromError(err)
+	}
+	return &gamesvc.KickPlayerResponse{WasOnline: wasOnline, RoomId: roomID}, nil
+}
+
+func (c *Game) SendCommand(ctx context.Context, req *gamesvc.SendCommandRequest) (*gamesvc.SendCommandResponse, error) {
+	if req.UserId == "" || req.Type == "" {
+		return nil, dto.ErrInvalidInput
+	}
+	var payload json.RawMessage
+	if len(req.Payload) > 0 {
+		if !json.Valid(req.Payload) {
+			return nil, dto.ErrInvalidInput
+		}
+		payload = req.Payload
+	}
+	reply, err := c.lobby.ExecuteCommand(ctx, req.UserId, req.Type, req.RequestId, payload)
+	if err != nil {
+		return nil, dto.FromError(err)
+	}
+	return &gamesvc.SendCommandResponse{Reply: reply}, nil
+}
+
+func (c *Game) StreamEvents(req *gamesvc.StreamEventsRequest, stream gamesvc.GameService_StreamEventsServer) error {
+	if req.UserId == "" {
+		return dto.ErrInvalidInput
+	}
+	err := c.lobby.OpenStream(stream.Context(), req.UserId, req.RemoteIp, func(message []byte) error {
+		return stream.Send(dto.ToProtoGameEvent(message))
+	})
+	if err != nil {
+		return dto.FromError(err)
+	}
+	return nil
+}
+
+func (c *Game) ListTournaments(ctx context.Context, req *gamesvc.ListTournamentsRequest) (*gamesvc.ListTournamentsResponse, error) {
+	tournaments, err := c.tournamentUsecase.ListTournaments(ctx, req.Status)
+	if err != nil {
+		return nil, dto.FromError(err)
+	}
+	return dto.FromModelToProtoListTournaments(tournaments), nil
+}
+
+func (c *Game) GetTournament(ctx context.Context, req *gamesvc.TournamentIDRequest) (*gamesvc.Tournament, error) {
+	if req.TournamentId == "" {
+		return nil, dto.ErrInvalidInput
+	}
+	t, err := c.tournamentUsecase.GetTournament(ctx, req.TournamentId)
+	if err != nil {
+		return nil, dto.FromError(err)
+	}
+	return dto.FromModelToProtoTournament(t), nil
+}
+
+func (c *Game) RegisterTournament(ctx context.Context, req *gamesvc.TournamentRegistrationRequest) (*gamesvc.Tournament, error) {
+	if req.TournamentId == "" || req.UserId == "" {
+		return nil, dto.ErrInvalidInput
+	}
+	t, err := c.tournamentUsecase.Register(ctx, req.TournamentId, req.UserId)
+	if err != nil {
+		return nil, dto.FromError(err)
+	}
+	return dto.FromModelToProtoTournament(t), nil
+}
+
+func (c *Game) UnregisterTournament(ctx context.Context, req *gamesvc.TournamentRegistrationRequest) (*gamesvc.Tournament, error) {
+	if req.TournamentId == "" || req.UserId == "" {
+		return nil, dto.ErrInvalidInput
+	}
+	t, err := c.tournamentUsecase.Unregister(ctx, req.TournamentId, req.UserId)
+	if err != nil {
+		return nil, dto.FromError(err)
+	}
+	return dto.FromModelToProtoTournament(t), nil
+}
+
+func (c *Game) CreateTournament(ctx context.Context, req *gamesvc.CreateTournamentRequest) (*gamesvc.Tournament, error) {
+	if req.Format == "" {
+		return nil, dto.ErrInvalidInput
+	}
+	t, err := c.tournamentUsecase.CreateTournament(ctx, dto.ToCreateTournamentParams(req))
+	if err != nil {
+		return nil, dto.FromError(err)
+	}
+	return dto.FromModelToProtoTournament(t), nil
+}
@@ -0,0 +1,29 @@
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"game_svc/internal/model"
+)
+
+type GameUsecase interface {
+	ListRooms(ctx context.Context) ([]*model.Room, error)
+	GetRoom(ctx context.Context, roomID string) (*model.Room, error)
+}
+
+type TournamentUsecase interface {
+	ListTournaments(ctx context.Context, status string) ([]*model.Tournament, error)
+	GetTournament(ctx context.Context, tournamentID string) (*model.Tournament, error)
+	Register(ctx context.Context, tournamentID, userID string) (*model.Tournament, error)
+	Unregister(ctx context.Context, tournamentID, userID string) (*model.Tournament, error)
+	CreateTournament(ctx context.Context, params model.CreateTournamentParams) (*model.Tournament, error)
+}
+
+// Lobby — операции, которым нужен хаб: они оповещают игроков и закрывают соединения.
+type Lobby interface {
+	OnlineCount(ctx context.Context) (players int64, connections int64, err error)
+	ForceCloseRoom(ctx context.Context, roomID string, reason string) (*model.ClosedRoom, error)
+	KickPlayer(ctx context.Context, userID string, reason string) (wasOnline bool, roomID string, err error)
+	ExecuteCommand(ctx context.Context, userID, msgType, requestID string, payload json.RawMessage) ([]byte, error)
+	OpenStream(ctx context.Context, userID, remoteIP string, send func(message []byte) error) error
+}
@@ -31,6 +31,9 @@ type GameResult struct {
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	Player1       *PlayerGameResult      `protobuf:"bytes,6,opt,name=player1,proto3" json:"player1,omitempty"`
 	Player2       *PlayerGameResult      `protobuf:"bytes,7,opt,name=player2,proto3" json:"player2,omitempty"`
+	Rake          int64                  `protobuf:"varint,8,opt,name=rake,proto3" json:"rake,omitempty"`
+	JackpotPayout int64                  `protobuf:"varint,9,opt,name=jackpot_payout,json=jackpotPayout,proto3" json:"jackpot_payout,omitempty"`
+	Ranked        bool                   `protobuf:"varint,10,opt,name=ranked,proto3" json:"ranked,omitempty"` // партия рейтингового матча
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -114,11 +117,33 @@ func (x *GameResult) GetPlayer2() *PlayerGameResult {
 	return nil
 }
 
+func (x *GameResult) GetRake() int64 {
+	if x != nil {
+		return x.Rake
+	}
+	return 0
+}
+
+func (x *GameResult) GetJackpotPayout() int64 {
+	if x != nil {
+		return x.JackpotPayout
+	}
+	return 0
+}
+
+func (x *GameResult) GetRanked() bool {
+	if x != nil {
+		return x.Ranked
+	}
+	return false
+}
+
 type PlayerGameResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      int64                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	FinalScore    int32                  `protobuf:"varint,2,opt,name=final_score,json=finalScore,proto3" json:"final_score,omitempty"`
 	FinalHand     []string               `protobuf:"bytes,3,rep,name=final_hand,json=finalHand,proto3" json:"final_hand,omitempty"`
+	Stood         bool                   `protobuf:"varint,4,opt,name=stood,proto3" json:"stood,omitempty"` // игрок сказал stand до конца партии
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -174,12 +199,181 @@ func (x *PlayerGameResult) GetFinalHand() []string {
 	return nil
 }
 
+func (x *PlayerGameResult) GetStood() bool {
+	if x != nil {
+		return x.Stood
+	}
+	return false
+}
+
+// TournamentResult публикуется, когда турнир завершён и призовой фонд выплачен.
+type TournamentResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  string                 `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	BuyIn         int64                  `protobuf:"varint,4,opt,name=buy_in,json=buyIn,proto3" json:"buy_in,omitempty"`
+	PrizePool     int64                  `protobuf:"varint,5,opt,name=prize_pool,json=prizePool,proto3" json:"prize_pool,omitempty"`
+	Places        []*TournamentPlace     `protobuf:"bytes,6,rep,name=places,proto3" json:"places,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentResult) Reset() {
+	*x = TournamentResult{}
+	mi := &file_events_game_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentResult) ProtoMessage() {}
+
+func (x *TournamentResult) ProtoReflect() protoreflect.Message {
+	mi := &file_events_game_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentResult.ProtoReflect.Descriptor instead.
+func (*TournamentResult) Descriptor() ([]byte, []int) {
+	return file_events_game_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TournamentResult) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *TournamentResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TournamentResult) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *TournamentResult) GetBuyIn() int64 {
+	if x != nil {
+		return x.BuyIn
+	}
+	return 0
+}
+
+func (x *TournamentResult) GetPrizePool() int64 {
+	if x != nil {
+		return x.PrizePool
+	}
+	return 0
+}
+
+func (x *TournamentResult) GetPlaces() []*TournamentPlace {
+	if x != nil {
+		return x.Places
+	}
+	return nil
+}
+
+func (x *TournamentResult) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *TournamentResult) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+type TournamentPlace struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId int64                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// place — занятое место; выбывшие в одном раунде делят место.
+	Place         int32 `protobuf:"varint,2,opt,name=place,proto3" json:"place,omitempty"`
+	Prize         int64 `protobuf:"varint,3,opt,name=prize,proto3" json:"prize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentPlace) Reset() {
+	*x = TournamentPlace{}
+	mi := &file_events_game_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentPlace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentPlace) ProtoMessage() {}
+
+func (x *TournamentPlace) ProtoReflect() protoreflect.Message {
+	mi := &file_events_game_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentPlace.ProtoReflect.Descriptor instead.
+func (*TournamentPlace) Descriptor() ([]byte, []int) {
+	return file_events_game_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TournamentPlace) GetPlayerId() int64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *TournamentPlace) GetPlace() int32 {
+	if x != nil {
+		return x.Place
+	}
+	return 0
+}
+
+func (x *TournamentPlace) GetPrize() int64 {
+	if x != nil {
+		return x.Prize
+	}
+	return 0
+}
+
 var File_events_game_proto protoreflect.FileDescriptor
 
 const file_events_game_proto_rawDesc = "" +
 	"\n" +
 	"\x11events_game.proto\x12\n" +
-	"events_svc\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9a\x02\n" +
+	"events_svc\x1a\x1fgoogle/protobuf/timestamp.proto\"\xed\x02\n" +
 	"\n" +
 	"GameResult\x12\x17\n" +
 	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1b\n" +
@@ -189,13 +383,34 @@ const file_events_game_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x126\n" +
 	"\aplayer1\x18\x06 \x01(\v2\x1c.events_svc.PlayerGameResultR\aplayer1\x126\n" +
-	"\aplayer2\x18\a \x01(\v2\x1c.events_svc.PlayerGameResultR\aplayer2\"o\n" +
+	"\aplayer2\x18\a \x01(\v2\x1c.events_svc.PlayerGameResultR\aplayer2\x12\x12\n" +
+	"\x04rake\x18\b \x01(\x03R\x04rake\x12%\n" +
+	"\x0ejackpot_payout\x18\t \x01(\x03R\rjackpotPayout\x12\x16\n" +
+	"\x06ranked\x18\n" +
+	" \x01(\bR\x06ranked\"\x85\x01\n" +
 	"\x10PlayerGameResult\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\x03R\bplayerId\x12\x1f\n" +
 	"\vfinal_score\x18\x02 \x01(\x05R\n" +
 	"finalScore\x12\x1d\n" +
 	"\n" +
-	"final_hand\x18\x03 \x03(\tR\tfinalHandB=Z;game_svc/internal/adapter/grpc/server/frontend/proto/eventsb\x06proto3"
+	"final_hand\x18\x03 \x03(\tR\tfinalHand\x12\x14\n" +
+	"\x05stood\x18\x04 \x01(\bR\x05stood\"\xc6\x02\n" +
+	"\x10TournamentResult\x12#\n" +
+	"\rtournament_id\x18\x01 \x01(\tR\ftournamentId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x15\n" +
+	"\x06buy_in\x18\x04 \x01(\x03R\x05buyIn\x12\x1d\n" +
+	"\n" +
+	"prize_pool\x18\x05 \x01(\x03R\tprizePool\x123\n" +
+	"\x06places\x18\x06 \x03(\v2\x1b.events_svc.TournamentPlaceR\x06places\x129\n" +
+	"\n" +
+	"started_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\"Z\n" +
+	"\x0fTournamentPlace\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x03R\bplayerId\x12\x14\n" +
+	"\x05place\x18\x02 \x01(\x05R\x05place\x12\x14\n" +
+	"\x05prize\x18\x03 \x01(\x03R\x05prizeB=Z;game_svc/internal/adapter/grpc/server/frontend/proto/eventsb\x06proto3"
 
 var (
 	file_events_game_proto_rawDescOnce sync.Once
@@ -209,21 +424,26 @@ func file_events_game_proto_rawDescGZIP() []byte {
 	return file_events_game_proto_rawDescData
 }
 
-var file_events_game_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_events_game_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_events_game_proto_goTypes = []any{
 	(*GameResult)(nil),            // 0: events_svc.GameResult
 	(*PlayerGameResult)(nil),      // 1: events_svc.PlayerGameResult
-	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(*TournamentResult)(nil),      // 2: events_svc.TournamentResult
+	(*TournamentPlace)(nil),       // 3: events_svc.TournamentPlace
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
 }
 var file_events_game_proto_depIdxs = []int32{
-	2, // 0: events_svc.GameResult.created_at:type_name -> google.protobuf.Timestamp
+	4, // 0: events_svc.GameResult.created_at:type_name -> google.protobuf.Timestamp
 	1, // 1: events_svc.GameResult.player1:type_name -> events_svc.PlayerGameResult
 	1, // 2: events_svc.GameResult.player2:type_name -> events_svc.PlayerGameResult
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	3, // 3: events_svc.TournamentResult.places:type_name -> events_svc.TournamentPlace
+	4, // 4: events_svc.TournamentResult.started_at:type_name -> google.protobuf.Timestamp
+	4, // 5: events_svc.TournamentResult.finished_at:type_name -> google.protobuf.Timestamp
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_events_game_proto_init() }
@@ -237,7 +457,7 @@ func file_events_game_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_game_proto_rawDesc), len(file_events_game_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -199,8 +199,12 @@ func (x *UserIDRequest) GetId() int64 {
 }
 
 type GetBalanceResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Balance       int64                  `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Balance int64                  `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	// chips reserved by running games
+	Held int64 `protobuf:"varint,2,opt,name=held,proto3" json:"held,omitempty"`
+	// balance - held
+	Available     int64 `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -242,12 +246,28 @@ func (x *GetBalanceResponse) GetBalance() int64 {
 	return 0
 }
 
+func (x *GetBalanceResponse) GetHeld() int64 {
+	if x != nil {
+		return x.Held
+	}
+	return 0
+}
+
+func (x *GetBalanceResponse) GetAvailable() int64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
 type BalanceUpdateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Balance       int64                  `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Balance int64                  `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	// repeated requests with the same key are applied once
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *BalanceUpdateRequest) Reset() {
@@ -294,6 +314,13 @@ func (x *BalanceUpdateRequest) GetBalance() int64 {
 	return 0
 }
 
+func (x *BalanceUpdateRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type UserProfileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -494,46 +521,1392 @@ func (x *RatingUpdateResponse) GetRating() int64 {
 	return 0
 }
 
-var File_user_proto protoreflect.FileDescriptor
+type ChipHold struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// game id the chips are held for
+	Reference     string                 `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_user_proto_rawDesc = "" +
-	"\n" +
-	"\n" +
-	"user.proto\x12\buser_svc\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xd1\x02\n" +
-	"\x04User\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
-	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
-	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1a\n" +
-	"\bnickname\x18\x04 \x01(\tR\bnickname\x12\x12\n" +
-	"\x04role\x18\x05 \x01(\tR\x04role\x12\x18\n" +
-	"\abalance\x18\x06 \x01(\x03R\abalance\x12\x10\n" +
-	"\x03bio\x18\a \x01(\tR\x03bio\x129\n" +
-	"\n" +
-	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
-	"\n" +
-	"is_deleted\x18\n" +
-	" \x01(\bR\tisDeleted\x12\x16\n" +
-	"\x06rating\x18\v \x01(\x03R\x06rating\"\x1f\n" +
-	"\rUserIDRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\".\n" +
-	"\x12GetBalanceResponse\x12\x18\n" +
-	"\abalance\x18\x01 \x01(\x03R\abalance\"@\n" +
-	"\x14BalanceUpdateRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
-	"\abalance\x18\x02 \x01(\x03R\abalance\"9\n" +
-	"\x13UserProfileResponse\x12\"\n" +
-	"\x04user\x18\x01 \x01(\v2\x0e.user_svc.UserR\x04user\"T\n" +
-	"\x14UpdateProfileRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
-	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x10\n" +
-	"\x03bio\x18\x03 \x01(\tR\x03bio\"+\n" +
-	"\x11GetRatingResponse\x12\x16\n" +
-	"\x06rating\x18\x01 \x01(\x03R\x06rating\">\n" +
-	"\x14RatingUpdateResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
-	"\x06rating\x18\x02 \x01(\x03R\x06rating2\xfd\x03\n" +
+func (x *ChipHold) Reset() {
+	*x = ChipHold{}
+	mi := &file_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChipHold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChipHold) ProtoMessage() {}
+
+func (x *ChipHold) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChipHold.ProtoReflect.Descriptor instead.
+func (*ChipHold) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ChipHold) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ChipHold) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ChipHold) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ChipHold) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ChipHold) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ChipHold) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type PlaceHoldRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount    int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reference string                 `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`
+	// 0 means the service default
+	TtlSeconds    int64 `protobuf:"varint,4,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceHoldRequest) Reset() {
+	*x = PlaceHoldRequest{}
+	mi := &file_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceHoldRequest) ProtoMessage() {}
+
+func (x *PlaceHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceHoldRequest.ProtoReflect.Descriptor instead.
+func (*PlaceHoldRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PlaceHoldRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PlaceHoldRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PlaceHoldRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *PlaceHoldRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type HoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reference     string                 `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HoldRequest) Reset() {
+	*x = HoldRequest{}
+	mi := &file_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldRequest) ProtoMessage() {}
+
+func (x *HoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldRequest.ProtoReflect.Descriptor instead.
+func (*HoldRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *HoldRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *HoldRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+type CaptureHoldRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reference string                 `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	// user the captured chips are credited to
+	BeneficiaryId int64 `protobuf:"varint,3,opt,name=beneficiary_id,json=beneficiaryId,proto3" json:"beneficiary_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaptureHoldRequest) Reset() {
+	*x = CaptureHoldRequest{}
+	mi := &file_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureHoldRequest) ProtoMessage() {}
+
+func (x *CaptureHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureHoldRequest.ProtoReflect.Descriptor instead.
+func (*CaptureHoldRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CaptureHoldRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CaptureHoldRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *CaptureHoldRequest) GetBeneficiaryId() int64 {
+	if x != nil {
+		return x.BeneficiaryId
+	}
+	return 0
+}
+
+type CaptureHoldResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserBalance        int64                  `protobuf:"varint,1,opt,name=user_balance,json=userBalance,proto3" json:"user_balance,omitempty"`
+	BeneficiaryBalance int64                  `protobuf:"varint,2,opt,name=beneficiary_balance,json=beneficiaryBalance,proto3" json:"beneficiary_balance,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CaptureHoldResponse) Reset() {
+	*x = CaptureHoldResponse{}
+	mi := &file_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureHoldResponse) ProtoMessage() {}
+
+func (x *CaptureHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureHoldResponse.ProtoReflect.Descriptor instead.
+func (*CaptureHoldResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CaptureHoldResponse) GetUserBalance() int64 {
+	if x != nil {
+		return x.UserBalance
+	}
+	return 0
+}
+
+func (x *CaptureHoldResponse) GetBeneficiaryBalance() int64 {
+	if x != nil {
+		return x.BeneficiaryBalance
+	}
+	return 0
+}
+
+type LedgerEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TransactionId int64                  `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// game_stake, winnings, bonus, admin_adjustment, transfer, opening_balance
+	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	// signed change of the balance
+	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	BalanceAfter  int64                  `protobuf:"varint,5,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	Reference     string                 `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerEntry) Reset() {
+	*x = LedgerEntry{}
+	mi := &file_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntry) ProtoMessage() {}
+
+func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LedgerEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetBalanceAfter() int64 {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetTransactionsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 0 means the default page size
+	Limit         int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionsRequest) Reset() {
+	*x = GetTransactionsRequest{}
+	mi := &file_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionsRequest) ProtoMessage() {}
+
+func (x *GetTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetTransactionsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetTransactionsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetTransactionsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*LedgerEntry         `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionsResponse) Reset() {
+	*x = GetTransactionsResponse{}
+	mi := &file_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionsResponse) ProtoMessage() {}
+
+func (x *GetTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetTransactionsResponse) GetEntries() []*LedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetTransactionsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type PlayerDelta struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// negative for a loss, zero releases the hold only
+	Delta         int64 `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerDelta) Reset() {
+	*x = PlayerDelta{}
+	mi := &file_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerDelta) ProtoMessage() {}
+
+func (x *PlayerDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerDelta.ProtoReflect.Descriptor instead.
+func (*PlayerDelta) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PlayerDelta) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PlayerDelta) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+type SettleMatchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// idempotency key of the settlement
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// deltas sum to -rake
+	Deltas []*PlayerDelta `protobuf:"bytes,2,rep,name=deltas,proto3" json:"deltas,omitempty"`
+	// chips taken from the pot by the house
+	Rake int64 `protobuf:"varint,3,opt,name=rake,proto3" json:"rake,omitempty"`
+	// part of the rake that feeds the jackpot pool
+	JackpotContribution int64 `protobuf:"varint,4,opt,name=jackpot_contribution,json=jackpotContribution,proto3" json:"jackpot_contribution,omitempty"`
+	// player who wins the whole jackpot pool, 0 if none
+	JackpotWinnerId int64 `protobuf:"varint,5,opt,name=jackpot_winner_id,json=jackpotWinnerId,proto3" json:"jackpot_winner_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SettleMatchRequest) Reset() {
+	*x = SettleMatchRequest{}
+	mi := &file_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettleMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleMatchRequest) ProtoMessage() {}
+
+func (x *SettleMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleMatchRequest.ProtoReflect.Descriptor instead.
+func (*SettleMatchRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SettleMatchRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SettleMatchRequest) GetDeltas() []*PlayerDelta {
+	if x != nil {
+		return x.Deltas
+	}
+	return nil
+}
+
+func (x *SettleMatchRequest) GetRake() int64 {
+	if x != nil {
+		return x.Rake
+	}
+	return 0
+}
+
+func (x *SettleMatchRequest) GetJackpotContribution() int64 {
+	if x != nil {
+		return x.JackpotContribution
+	}
+	return 0
+}
+
+func (x *SettleMatchRequest) GetJackpotWinnerId() int64 {
+	if x != nil {
+		return x.JackpotWinnerId
+	}
+	return 0
+}
+
+type PlayerBalance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Balance       int64                  `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerBalance) Reset() {
+	*x = PlayerBalance{}
+	mi := &file_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerBalance) ProtoMessage() {}
+
+func (x *PlayerBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerBalance.ProtoReflect.Descriptor instead.
+func (*PlayerBalance) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PlayerBalance) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PlayerBalance) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+type SettleMatchResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Balances       []*PlayerBalance       `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+	AlreadySettled bool                   `protobuf:"varint,2,opt,name=already_settled,json=alreadySettled,proto3" json:"already_settled,omitempty"`
+	JackpotPayout  int64                  `protobuf:"varint,3,opt,name=jackpot_payout,json=jackpotPayout,proto3" json:"jackpot_payout,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SettleMatchResponse) Reset() {
+	*x = SettleMatchResponse{}
+	mi := &file_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettleMatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleMatchResponse) ProtoMessage() {}
+
+func (x *SettleMatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleMatchResponse.ProtoReflect.Descriptor instead.
+func (*SettleMatchResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SettleMatchResponse) GetBalances() []*PlayerBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *SettleMatchResponse) GetAlreadySettled() bool {
+	if x != nil {
+		return x.AlreadySettled
+	}
+	return false
+}
+
+func (x *SettleMatchResponse) GetJackpotPayout() int64 {
+	if x != nil {
+		return x.JackpotPayout
+	}
+	return 0
+}
+
+type RewardClaimResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// chips credited
+	Amount int64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// balance after the claim
+	Balance       int64 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	DailyStreak   int64 `protobuf:"varint,3,opt,name=daily_streak,json=dailyStreak,proto3" json:"daily_streak,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RewardClaimResponse) Reset() {
+	*x = RewardClaimResponse{}
+	mi := &file_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RewardClaimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardClaimResponse) ProtoMessage() {}
+
+func (x *RewardClaimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardClaimResponse.ProtoReflect.Descriptor instead.
+func (*RewardClaimResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RewardClaimResponse) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RewardClaimResponse) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *RewardClaimResponse) GetDailyStreak() int64 {
+	if x != nil {
+		return x.DailyStreak
+	}
+	return 0
+}
+
+type TransferChipsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	FromUserId int64                  `protobuf:"varint,1,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId   int64                  `protobuf:"varint,2,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	Amount     int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// repeated requests with the same key are applied once
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TransferChipsRequest) Reset() {
+	*x = TransferChipsRequest{}
+	mi := &file_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferChipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferChipsRequest) ProtoMessage() {}
+
+func (x *TransferChipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferChipsRequest.ProtoReflect.Descriptor instead.
+func (*TransferChipsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TransferChipsRequest) GetFromUserId() int64 {
+	if x != nil {
+		return x.FromUserId
+	}
+	return 0
+}
+
+func (x *TransferChipsRequest) GetToUserId() int64 {
+	if x != nil {
+		return x.ToUserId
+	}
+	return 0
+}
+
+func (x *TransferChipsRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransferChipsRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type TransferChipsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// balance of the sender after the transfer
+	Balance        int64 `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	AlreadyApplied bool  `protobuf:"varint,2,opt,name=already_applied,json=alreadyApplied,proto3" json:"already_applied,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TransferChipsResponse) Reset() {
+	*x = TransferChipsResponse{}
+	mi := &file_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferChipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferChipsResponse) ProtoMessage() {}
+
+func (x *TransferChipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferChipsResponse.ProtoReflect.Descriptor instead.
+func (*TransferChipsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TransferChipsResponse) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *TransferChipsResponse) GetAlreadyApplied() bool {
+	if x != nil {
+		return x.AlreadyApplied
+	}
+	return false
+}
+
+type GamingLimit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// daily_loss, weekly_loss, daily_wager, weekly_wager or session_reminder (minutes)
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// 0 means no limit
+	Value int64 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	// a looser value waiting for the cooling-off period to pass
+	HasPending    bool                   `protobuf:"varint,3,opt,name=has_pending,json=hasPending,proto3" json:"has_pending,omitempty"`
+	PendingValue  int64                  `protobuf:"varint,4,opt,name=pending_value,json=pendingValue,proto3" json:"pending_value,omitempty"`
+	PendingFrom   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=pending_from,json=pendingFrom,proto3" json:"pending_from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GamingLimit) Reset() {
+	*x = GamingLimit{}
+	mi := &file_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GamingLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GamingLimit) ProtoMessage() {}
+
+func (x *GamingLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GamingLimit.ProtoReflect.Descriptor instead.
+func (*GamingLimit) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GamingLimit) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GamingLimit) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *GamingLimit) GetHasPending() bool {
+	if x != nil {
+		return x.HasPending
+	}
+	return false
+}
+
+func (x *GamingLimit) GetPendingValue() int64 {
+	if x != nil {
+		return x.PendingValue
+	}
+	return 0
+}
+
+func (x *GamingLimit) GetPendingFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PendingFrom
+	}
+	return nil
+}
+
+type ResponsibleGamingResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Limits []*GamingLimit         `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits,omitempty"`
+	// unset when the player is not self-excluded
+	SelfExcludedUntil *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=self_excluded_until,json=selfExcludedUntil,proto3" json:"self_excluded_until,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ResponsibleGamingResponse) Reset() {
+	*x = ResponsibleGamingResponse{}
+	mi := &file_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResponsibleGamingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponsibleGamingResponse) ProtoMessage() {}
+
+func (x *ResponsibleGamingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponsibleGamingResponse.ProtoReflect.Descriptor instead.
+func (*ResponsibleGamingResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ResponsibleGamingResponse) GetLimits() []*GamingLimit {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *ResponsibleGamingResponse) GetSelfExcludedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SelfExcludedUntil
+	}
+	return nil
+}
+
+type SetGamingLimitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Value         int64                  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGamingLimitRequest) Reset() {
+	*x = SetGamingLimitRequest{}
+	mi := &file_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGamingLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGamingLimitRequest) ProtoMessage() {}
+
+func (x *SetGamingLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGamingLimitRequest.ProtoReflect.Descriptor instead.
+func (*SetGamingLimitRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetGamingLimitRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetGamingLimitRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SetGamingLimitRequest) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type SelfExcludeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,2,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SelfExcludeRequest) Reset() {
+	*x = SelfExcludeRequest{}
+	mi := &file_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelfExcludeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfExcludeRequest) ProtoMessage() {}
+
+func (x *SelfExcludeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfExcludeRequest.ProtoReflect.Descriptor instead.
+func (*SelfExcludeRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SelfExcludeRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SelfExcludeRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type CheckPlayAllowedRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// chips the player is about to risk
+	Amount        int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPlayAllowedRequest) Reset() {
+	*x = CheckPlayAllowedRequest{}
+	mi := &file_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPlayAllowedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPlayAllowedRequest) ProtoMessage() {}
+
+func (x *CheckPlayAllowedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPlayAllowedRequest.ProtoReflect.Descriptor instead.
+func (*CheckPlayAllowedRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CheckPlayAllowedRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CheckPlayAllowedRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+var File_user_proto protoreflect.FileDescriptor
+
+const file_user_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"user.proto\x12\buser_svc\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xd1\x02\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1a\n" +
+	"\bnickname\x18\x04 \x01(\tR\bnickname\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\x12\x18\n" +
+	"\abalance\x18\x06 \x01(\x03R\abalance\x12\x10\n" +
+	"\x03bio\x18\a \x01(\tR\x03bio\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"is_deleted\x18\n" +
+	" \x01(\bR\tisDeleted\x12\x16\n" +
+	"\x06rating\x18\v \x01(\x03R\x06rating\"\x1f\n" +
+	"\rUserIDRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"`\n" +
+	"\x12GetBalanceResponse\x12\x18\n" +
+	"\abalance\x18\x01 \x01(\x03R\abalance\x12\x12\n" +
+	"\x04held\x18\x02 \x01(\x03R\x04held\x12\x1c\n" +
+	"\tavailable\x18\x03 \x01(\x03R\tavailable\"i\n" +
+	"\x14BalanceUpdateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\x03R\abalance\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\"9\n" +
+	"\x13UserProfileResponse\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.user_svc.UserR\x04user\"T\n" +
+	"\x14UpdateProfileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x10\n" +
+	"\x03bio\x18\x03 \x01(\tR\x03bio\"+\n" +
+	"\x11GetRatingResponse\x12\x16\n" +
+	"\x06rating\x18\x01 \x01(\x03R\x06rating\">\n" +
+	"\x14RatingUpdateResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06rating\x18\x02 \x01(\x03R\x06rating\"\xbc\x01\n" +
+	"\bChipHold\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x1c\n" +
+	"\treference\x18\x04 \x01(\tR\treference\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x82\x01\n" +
+	"\x10PlaceHoldRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x1c\n" +
+	"\treference\x18\x03 \x01(\tR\treference\x12\x1f\n" +
+	"\vttl_seconds\x18\x04 \x01(\x03R\n" +
+	"ttlSeconds\"D\n" +
+	"\vHoldRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1c\n" +
+	"\treference\x18\x02 \x01(\tR\treference\"r\n" +
+	"\x12CaptureHoldRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1c\n" +
+	"\treference\x18\x02 \x01(\tR\treference\x12%\n" +
+	"\x0ebeneficiary_id\x18\x03 \x01(\x03R\rbeneficiaryId\"i\n" +
+	"\x13CaptureHoldResponse\x12!\n" +
+	"\fuser_balance\x18\x01 \x01(\x03R\vuserBalance\x12/\n" +
+	"\x13beneficiary_balance\x18\x02 \x01(\x03R\x12beneficiaryBalance\"\xee\x01\n" +
+	"\vLedgerEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x03R\rtransactionId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12#\n" +
+	"\rbalance_after\x18\x05 \x01(\x03R\fbalanceAfter\x12\x1c\n" +
+	"\treference\x18\x06 \x01(\tR\treference\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"_\n" +
+	"\x16GetTransactionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x03R\x06offset\"`\n" +
+	"\x17GetTransactionsResponse\x12/\n" +
+	"\aentries\x18\x01 \x03(\v2\x15.user_svc.LedgerEntryR\aentries\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"<\n" +
+	"\vPlayerDelta\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05delta\x18\x02 \x01(\x03R\x05delta\"\xcf\x01\n" +
+	"\x12SettleMatchRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12-\n" +
+	"\x06deltas\x18\x02 \x03(\v2\x15.user_svc.PlayerDeltaR\x06deltas\x12\x12\n" +
+	"\x04rake\x18\x03 \x01(\x03R\x04rake\x121\n" +
+	"\x14jackpot_contribution\x18\x04 \x01(\x03R\x13jackpotContribution\x12*\n" +
+	"\x11jackpot_winner_id\x18\x05 \x01(\x03R\x0fjackpotWinnerId\"B\n" +
+	"\rPlayerBalance\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\x03R\abalance\"\x9a\x01\n" +
+	"\x13SettleMatchResponse\x123\n" +
+	"\bbalances\x18\x01 \x03(\v2\x17.user_svc.PlayerBalanceR\bbalances\x12'\n" +
+	"\x0falready_settled\x18\x02 \x01(\bR\x0ealreadySettled\x12%\n" +
+	"\x0ejackpot_payout\x18\x03 \x01(\x03R\rjackpotPayout\"j\n" +
+	"\x13RewardClaimResponse\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\x03R\abalance\x12!\n" +
+	"\fdaily_streak\x18\x03 \x01(\x03R\vdailyStreak\"\x97\x01\n" +
+	"\x14TransferChipsRequest\x12 \n" +
+	"\ffrom_user_id\x18\x01 \x01(\x03R\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x02 \x01(\x03R\btoUserId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\"Z\n" +
+	"\x15TransferChipsResponse\x12\x18\n" +
+	"\abalance\x18\x01 \x01(\x03R\abalance\x12'\n" +
+	"\x0falready_applied\x18\x02 \x01(\bR\x0ealreadyApplied\"\xbc\x01\n" +
+	"\vGamingLimit\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value\x12\x1f\n" +
+	"\vhas_pending\x18\x03 \x01(\bR\n" +
+	"hasPending\x12#\n" +
+	"\rpending_value\x18\x04 \x01(\x03R\fpendingValue\x12=\n" +
+	"\fpending_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vpendingFrom\"\x96\x01\n" +
+	"\x19ResponsibleGamingResponse\x12-\n" +
+	"\x06limits\x18\x01 \x03(\v2\x15.user_svc.GamingLimitR\x06limits\x12J\n" +
+	"\x13self_excluded_until\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x11selfExcludedUntil\"Z\n" +
+	"\x15SetGamingLimitRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x03R\x05value\"X\n" +
+	"\x12SelfExcludeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12)\n" +
+	"\x10duration_seconds\x18\x02 \x01(\x03R\x0fdurationSeconds\"J\n" +
+	"\x17CheckPlayAllowedRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount2\x9b\v\n" +
 	"\vuserService\x12C\n" +
 	"\n" +
 	"GetBalance\x12\x17.user_svc.UserIDRequest\x1a\x1c.user_svc.GetBalanceResponse\x12D\n" +
@@ -544,7 +1917,19 @@ const file_user_proto_rawDesc = "" +
 	"GetProfile\x12\x17.user_svc.UserIDRequest\x1a\x1d.user_svc.UserProfileResponse\x12G\n" +
 	"\rUpdateProfile\x12\x1e.user_svc.UpdateProfileRequest\x1a\x16.google.protobuf.Empty\x12A\n" +
 	"\tGetRating\x12\x17.user_svc.UserIDRequest\x1a\x1b.user_svc.GetRatingResponse\x12F\n" +
-	"\fUpdateRating\x12\x1e.user_svc.RatingUpdateResponse\x1a\x16.google.protobuf.EmptyB?Z=auth-service/internal/adapter/grpc/server/frontend/proto/userb\x06proto3"
+	"\fUpdateRating\x12\x1e.user_svc.RatingUpdateResponse\x1a\x16.google.protobuf.Empty\x12;\n" +
+	"\tPlaceHold\x12\x1a.user_svc.PlaceHoldRequest\x1a\x12.user_svc.ChipHold\x12<\n" +
+	"\vReleaseHold\x12\x15.user_svc.HoldRequest\x1a\x16.google.protobuf.Empty\x12J\n" +
+	"\vCaptureHold\x12\x1c.user_svc.CaptureHoldRequest\x1a\x1d.user_svc.CaptureHoldResponse\x12V\n" +
+	"\x0fGetTransactions\x12 .user_svc.GetTransactionsRequest\x1a!.user_svc.GetTransactionsResponse\x12J\n" +
+	"\vSettleMatch\x12\x1c.user_svc.SettleMatchRequest\x1a\x1d.user_svc.SettleMatchResponse\x12I\n" +
+	"\x0fClaimDailyBonus\x12\x17.user_svc.UserIDRequest\x1a\x1d.user_svc.RewardClaimResponse\x12E\n" +
+	"\vClaimRefill\x12\x17.user_svc.UserIDRequest\x1a\x1d.user_svc.RewardClaimResponse\x12P\n" +
+	"\rTransferChips\x12\x1e.user_svc.TransferChipsRequest\x1a\x1f.user_svc.TransferChipsResponse\x12T\n" +
+	"\x14GetResponsibleGaming\x12\x17.user_svc.UserIDRequest\x1a#.user_svc.ResponsibleGamingResponse\x12V\n" +
+	"\x0eSetGamingLimit\x12\x1f.user_svc.SetGamingLimitRequest\x1a#.user_svc.ResponsibleGamingResponse\x12P\n" +
+	"\vSelfExclude\x12\x1c.user_svc.SelfExcludeRequest\x1a#.user_svc.ResponsibleGamingResponse\x12M\n" +
+	"\x10CheckPlayAllowed\x12!.user_svc.CheckPlayAllowedRequest\x1a\x16.google.protobuf.EmptyB?Z=auth-service/internal/adapter/grpc/server/frontend/proto/userb\x06proto3"
 
 var (
 	file_user_proto_rawDescOnce sync.Once
@@ -558,42 +1943,94 @@ func file_user_proto_rawDescGZIP() []byte {
 	return file_user_proto_rawDescData
 }
 
-var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_user_proto_goTypes = []any{
-	(*User)(nil),                  // 0: user_svc.User
-	(*UserIDRequest)(nil),         // 1: user_svc.UserIDRequest
-	(*GetBalanceResponse)(nil),    // 2: user_svc.GetBalanceResponse
-	(*BalanceUpdateRequest)(nil),  // 3: user_svc.BalanceUpdateRequest
-	(*UserProfileResponse)(nil),   // 4: user_svc.UserProfileResponse
-	(*UpdateProfileRequest)(nil),  // 5: user_svc.UpdateProfileRequest
-	(*GetRatingResponse)(nil),     // 6: user_svc.GetRatingResponse
-	(*RatingUpdateResponse)(nil),  // 7: user_svc.RatingUpdateResponse
-	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 9: google.protobuf.Empty
+	(*User)(nil),                      // 0: user_svc.User
+	(*UserIDRequest)(nil),             // 1: user_svc.UserIDRequest
+	(*GetBalanceResponse)(nil),        // 2: user_svc.GetBalanceResponse
+	(*BalanceUpdateRequest)(nil),      // 3: user_svc.BalanceUpdateRequest
+	(*UserProfileResponse)(nil),       // 4: user_svc.UserProfileResponse
+	(*UpdateProfileRequest)(nil),      // 5: user_svc.UpdateProfileRequest
+	(*GetRatingResponse)(nil),         // 6: user_svc.GetRatingResponse
+	(*RatingUpdateResponse)(nil),      // 7: user_svc.RatingUpdateResponse
+	(*ChipHold)(nil),                  // 8: user_svc.ChipHold
+	(*PlaceHoldRequest)(nil),          // 9: user_svc.PlaceHoldRequest
+	(*HoldRequest)(nil),               // 10: user_svc.HoldRequest
+	(*CaptureHoldRequest)(nil),        // 11: user_svc.CaptureHoldRequest
+	(*CaptureHoldResponse)(nil),       // 12: user_svc.CaptureHoldResponse
+	(*LedgerEntry)(nil),               // 13: user_svc.LedgerEntry
+	(*GetTransactionsRequest)(nil),    // 14: user_svc.GetTransactionsRequest
+	(*GetTransactionsResponse)(nil),   // 15: user_svc.GetTransactionsResponse
+	(*PlayerDelta)(nil),               // 16: user_svc.PlayerDelta
+	(*SettleMatchRequest)(nil),        // 17: user_svc.SettleMatchRequest
+	(*PlayerBalance)(nil),             // 18: user_svc.PlayerBalance
+	(*SettleMatchResponse)(nil),       // 19: user_svc.SettleMatchResponse
+	(*RewardClaimResponse)(nil),       // 20: user_svc.RewardClaimResponse
+	(*TransferChipsRequest)(nil),      // 21: user_svc.TransferChipsRequest
+	(*TransferChipsResponse)(nil),     // 22: user_svc.TransferChipsResponse
+	(*GamingLimit)(nil),               // 23: user_svc.GamingLimit
+	(*ResponsibleGamingResponse)(nil), // 24: user_svc.ResponsibleGamingResponse
+	(*SetGamingLimitRequest)(nil),     // 25: user_svc.SetGamingLimitRequest
+	(*SelfExcludeRequest)(nil),        // 26: user_svc.SelfExcludeRequest
+	(*CheckPlayAllowedRequest)(nil),   // 27: user_svc.CheckPlayAllowedRequest
+	(*timestamppb.Timestamp)(nil),     // 28: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),             // 29: google.protobuf.Empty
 }
 var file_user_proto_depIdxs = []int32{
-	8,  // 0: user_svc.User.created_at:type_name -> google.protobuf.Timestamp
-	8,  // 1: user_svc.User.updated_at:type_name -> google.protobuf.Timestamp
+	28, // 0: user_svc.User.created_at:type_name -> google.protobuf.Timestamp
+	28, // 1: user_svc.User.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 2: user_svc.UserProfileResponse.user:type_name -> user_svc.User
-	1,  // 3: user_svc.userService.GetBalance:input_type -> user_svc.UserIDRequest
-	3,  // 4: user_svc.userService.AddBalance:input_type -> user_svc.BalanceUpdateRequest
-	3,  // 5: user_svc.userService.SubtractBalance:input_type -> user_svc.BalanceUpdateRequest
-	1,  // 6: user_svc.userService.GetProfile:input_type -> user_svc.UserIDRequest
-	5,  // 7: user_svc.userService.UpdateProfile:input_type -> user_svc.UpdateProfileRequest
-	1,  // 8: user_svc.userService.GetRating:input_type -> user_svc.UserIDRequest
-	7,  // 9: user_svc.userService.UpdateRating:input_type -> user_svc.RatingUpdateResponse
-	2,  // 10: user_svc.userService.GetBalance:output_type -> user_svc.GetBalanceResponse
-	9,  // 11: user_svc.userService.AddBalance:output_type -> google.protobuf.Empty
-	9,  // 12: user_svc.userService.SubtractBalance:output_type -> google.protobuf.Empty
-	4,  // 13: user_svc.userService.GetProfile:output_type -> user_svc.UserProfileResponse
-	9,  // 14: user_svc.userService.UpdateProfile:output_type -> google.protobuf.Empty
-	6,  // 15: user_svc.userService.GetRating:output_type -> user_svc.GetRatingResponse
-	9,  // 16: user_svc.userService.UpdateRating:output_type -> google.protobuf.Empty
-	10, // [10:17] is the sub-list for method output_type
-	3,  // [3:10] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	28, // 3: user_svc.ChipHold.expires_at:type_name -> google.protobuf.Timestamp
+	28, // 4: user_svc.LedgerEntry.created_at:type_name -> google.protobuf.Timestamp
+	13, // 5: user_svc.GetTransactionsResponse.entries:type_name -> user_svc.LedgerEntry
+	16, // 6: user_svc.SettleMatchRequest.deltas:type_name -> user_svc.PlayerDelta
+	18, // 7: user_svc.SettleMatchResponse.balances:type_name -> user_svc.PlayerBalance
+	28, // 8: user_svc.GamingLimit.pending_from:type_name -> google.protobuf.Timestamp
+	23, // 9: user_svc.ResponsibleGamingResponse.limits:type_name -> user_svc.GamingLimit
+	28, // 10: user_svc.ResponsibleGamingResponse.self_excluded_until:type_name -> google.protobuf.Timestamp
+	1,  // 11: user_svc.userService.GetBalance:input_type -> user_svc.UserIDRequest
+	3,  // 12: user_svc.userService.AddBalance:input_type -> user_svc.BalanceUpdateRequest
+	3,  // 13: user_svc.userService.SubtractBalance:input_type -> user_svc.BalanceUpdateRequest
+	1,  // 14: user_svc.userService.GetProfile:input_type -> user_svc.UserIDRequest
+	5,  // 15: user_svc.userService.UpdateProfile:input_type -> user_svc.UpdateProfileRequest
+	1,  // 16: user_svc.userService.GetRating:input_type -> user_svc.UserIDRequest
+	7,  // 17: user_svc.userService.UpdateRating:input_type -> user_svc.RatingUpdateResponse
+	9,  // 18: user_svc.userService.PlaceHold:input_type -> user_svc.PlaceHoldRequest
+	10, // 19: user_svc.userService.ReleaseHold:input_type -> user_svc.HoldRequest
+	11, // 20: user_svc.userService.CaptureHold:input_type -> user_svc.CaptureHoldRequest
+	14, // 21: user_svc.userService.GetTransactions:input_type -> user_svc.GetTransactionsRequest
+	17, // 22: user_svc.userService.SettleMatch:input_type -> user_svc.SettleMatchRequest
+	1,  // 23: user_svc.userService.ClaimDailyBonus:input_type -> user_svc.UserIDRequest
+	1,  // 24: user_svc.userService.ClaimRefill:input_type -> user_svc.UserIDRequest
+	21, // 25: user_svc.userService.TransferChips:input_type -> user_svc.TransferChipsRequest
+	1,  // 26: user_svc.userService.GetResponsibleGaming:input_type -> user_svc.UserIDRequest
+	25, // 27: user_svc.userService.SetGamingLimit:input_type -> user_svc.SetGamingLimitRequest
+	26, // 28: user_svc.userService.SelfExclude:input_type -> user_svc.SelfExcludeRequest
+	27, // 29: user_svc.userService.CheckPlayAllowed:input_type -> user_svc.CheckPlayAllowedRequest
+	2,  // 30: user_svc.userService.GetBalance:output_type -> user_svc.GetBalanceResponse
+	29, // 31: user_svc.userService.AddBalance:output_type -> google.protobuf.Empty
+	29, // 32: user_svc.userService.SubtractBalance:output_type -> google.protobuf.Empty
+	4,  // 33: user_svc.userService.GetProfile:output_type -> user_svc.UserProfileResponse
+	29, // 34: user_svc.userService.UpdateProfile:output_type -> google.protobuf.Empty
+	6,  // 35: user_svc.userService.GetRating:output_type -> user_svc.GetRatingResponse
+	29, // 36: user_svc.userService.UpdateRating:output_type -> google.protobuf.Empty
+	8,  // 37: user_svc.userService.PlaceHold:output_type -> user_svc.ChipHold
+	29, // 38: user_svc.userService.ReleaseHold:output_type -> google.protobuf.Empty
+	12, // 39: user_svc.userService.CaptureHold:output_type -> user_svc.CaptureHoldResponse
+	15, // 40: user_svc.userService.GetTransactions:output_type -> user_svc.GetTransactionsResponse
+	19, // 41: user_svc.userService.SettleMatch:output_type -> user_svc.SettleMatchResponse
+	20, // 42: user_svc.userService.ClaimDailyBonus:output_type -> user_svc.RewardClaimResponse
+	20, // 43: user_svc.userService.ClaimRefill:output_type -> user_svc.RewardClaimResponse
+	22, // 44: user_svc.userService.TransferChips:output_type -> user_svc.TransferChipsResponse
+	24, // 45: user_svc.userService.GetResponsibleGaming:output_type -> user_svc.ResponsibleGamingResponse
+	24, // 46: user_svc.userService.SetGamingLimit:output_type -> user_svc.ResponsibleGamingResponse
+	24, // 47: user_svc.userService.SelfExclude:output_type -> user_svc.ResponsibleGamingResponse
+	29, // 48: user_svc.userService.CheckPlayAllowed:output_type -> google.protobuf.Empty
+	30, // [30:49] is the sub-list for method output_type
+	11, // [11:30] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_user_proto_init() }
@@ -607,7 +2044,7 @@ func file_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
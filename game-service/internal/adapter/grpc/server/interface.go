@@ -0,0 +1,15 @@
+package server
+
+import "game_svc/internal/adapter/grpc/server/frontend"
+
+type GameUsecase interface {
+	frontend.GameUsecase
+}
+
+type TournamentUsecase interface {
+	frontend.TournamentUsecase
+}
+
+type Lobby interface {
+	frontend.Lobby
+}
@@ -0,0 +1,26 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+func (a *API) setOptions(ctx context.Context) []grpc.ServerOption {
+	opts := []grpc.ServerOption{
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			MaxConnectionAge:      a.cfg.MaxConnectionAge,
+			MaxConnectionAgeGrace: a.cfg.MaxConnectionAgeGrace,
+		}),
+		// api-gateway pings every 10s; the default policy would answer with GOAWAY and cut event streams
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             5 * time.Second,
+			PermitWithoutStream: true,
+		}),
+		grpc.MaxRecvMsgSize(a.cfg.MaxRecvMsgSizeMiB * (1024 * 1024)), // MaxRecvSize * 1 MB
+	}
+
+	return opts
+}
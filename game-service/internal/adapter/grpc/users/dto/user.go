@@ -3,10 +3,43 @@ package dto
 import (
 	svc "game_svc/internal/adapter/grpc/server/frontend/proto/user"
 	"game_svc/internal/model"
+	"time"
 )
 
+// FromGRPCClientGetResponse возвращает баланс игрока вместе с удержанными и доступными фишками.
 func FromGRPCClientGetResponse(resp *svc.GetBalanceResponse) *model.User {
 	return &model.User{
-		Balance: &resp.Balance,
+		Balance:   &resp.Balance,
+		Held:      &resp.Held,
+		Available: &resp.Available,
 	}
 }
+
+func ToGRPCSettleMatchRequest(settlement model.Settlement) *svc.SettleMatchRequest {
+	req := &svc.SettleMatchRequest{
+		GameId:              settlement.GameID,
+		Deltas:              make([]*svc.PlayerDelta, 0, len(settlement.Deltas)),
+		Rake:                settlement.Rake,
+		JackpotContribution: settlement.JackpotContribution,
+		JackpotWinnerId:     settlement.JackpotWinnerID,
+	}
+	for _, d := range settlement.Deltas {
+		req.Deltas = append(req.Deltas, &svc.PlayerDelta{
+			UserId: d.UserID,
+			Delta:  d.Delta,
+		})
+	}
+	return req
+}
+
+const sessionReminderLimit = "session_reminder"
+
+// SessionReminderFromGRPC достаёт интервал напоминаний из лимитов игрока, значение хранится в минутах.
+func SessionReminderFromGRPC(resp *svc.ResponsibleGamingResponse) time.Duration {
+	for _, l := range resp.GetLimits() {
+		if l.GetKind() == sessionReminderLimit {
+			return time.Duration(l.GetValue()) * time.Minute
+		}
+	}
+	return 0
+}
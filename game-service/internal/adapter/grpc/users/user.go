@@ -2,9 +2,14 @@ package users
 
 import (
 	"context"
+	"fmt"
 	svc "game_svc/internal/adapter/grpc/server/frontend/proto/user"
 	"game_svc/internal/adapter/grpc/users/dto"
 	"game_svc/internal/model"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Client struct {
@@ -59,3 +64,51 @@ func (c *Client) GetRating(ctx context.Context, id int64) (*model.User, error) {
 	}
 	return &model.User{Rating: &resp.Rating}, nil
 }
+
+func (c *Client) PlaceHold(ctx context.Context, hold model.ChipHold) error {
+	_, err := c.client.PlaceHold(ctx, &svc.PlaceHoldRequest{
+		UserId:     hold.UserID,
+		Amount:     hold.Amount,
+		Reference:  hold.Reference,
+		TtlSeconds: int64(hold.TTL.Seconds()),
+	})
+	return err
+}
+
+func (c *Client) ReleaseHold(ctx context.Context, userID int64, reference string) error {
+	_, err := c.client.ReleaseHold(ctx, &svc.HoldRequest{
+		UserId:    userID,
+		Reference: reference,
+	})
+	return err
+}
+
+// SettleMatch возвращает сумму выплаченного джекпота.
+func (c *Client) SettleMatch(ctx context.Context, settlement model.Settlement) (int64, error) {
+	resp, err := c.client.SettleMatch(ctx, dto.ToGRPCSettleMatchRequest(settlement))
+	if err != nil {
+		return 0, err
+	}
+	return resp.GetJackpotPayout(), nil
+}
+
+// CheckPlayAllowed возвращает model.ErrPlayRestricted, если игроку сейчас нельзя начинать игру.
+func (c *Client) CheckPlayAllowed(ctx context.Context, userID int64, amount int64) error {
+	_, err := c.client.CheckPlayAllowed(ctx, &svc.CheckPlayAllowedRequest{
+		UserId: userID,
+		Amount: amount,
+	})
+	if st, ok := status.FromError(err); ok && (st.Code() == codes.PermissionDenied || st.Code() == codes.FailedPrecondition) {
+		return fmt.Errorf("%w: %s", model.ErrPlayRestricted, st.Message())
+	}
+	return err
+}
+
+// GetSessionReminder возвращает, как часто напоминать игроку о длительности сессии. 0 — не напоминать.
+func (c *Client) GetSessionReminder(ctx context.Context, userID int64) (time.Duration, error) {
+	resp, err := c.client.GetResponsibleGaming(ctx, &svc.UserIDRequest{Id: userID})
+	if err != nil {
+		return 0, err
+	}
+	return dto.SessionReminderFromGRPC(resp), nil
+}
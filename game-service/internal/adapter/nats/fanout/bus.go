@@ -0,0 +1,36 @@
+package fanout
+
+import (
+	"fmt"
+
+	natsconn "game_svc/pkg/nats"
+
+	"github.com/nats-io/nats.go"
+)
+
+// Bus передаёт сообщения WebSocket-хаба между узлами game-service через NATS core pub/sub.
+// Доставка best-effort: сообщения живым клиентам не нужно хранить.
+type Bus struct {
+	client *natsconn.Client
+}
+
+func NewBus(client *natsconn.Client) *Bus {
+	return &Bus{client: client}
+}
+
+func (b *Bus) Publish(subject string, data []byte) error {
+	if err := b.client.Conn.Publish(subject, data); err != nil {
+		return fmt.Errorf("nats publish to %s: %w", subject, err)
+	}
+	return nil
+}
+
+func (b *Bus) Subscribe(subject string, handler func(data []byte)) error {
+	_, err := b.client.Conn.Subscribe(subject, func(msg *nats.Msg) {
+		handler(msg.Data)
+	})
+	if err != nil {
+		return fmt.Errorf("nats subscribe to %s: %w", subject, err)
+	}
+	return nil
+}
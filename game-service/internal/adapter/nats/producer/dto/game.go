@@ -44,6 +44,7 @@ func FromResult(standResult *model.Result, roomBet int64) *eventsproto.GameResul
 				PlayerId:   toInt64(playerID1Str),
 				FinalScore: int32(score1),
 				FinalHand:  convertHandModelToStringSlice(hand1),
+				Stood:      standResult.Stood[playerID1Str],
 			}
 		} else {
 			log.Printf("FromResult: Missing score or hand for player1 ID %s in room %s", playerID1Str, standResult.RoomID)
@@ -62,6 +63,7 @@ func FromResult(standResult *model.Result, roomBet int64) *eventsproto.GameResul
 				PlayerId:   toInt64(playerID2Str),
 				FinalScore: int32(score2),
 				FinalHand:  convertHandModelToStringSlice(hand2),
+				Stood:      standResult.Stood[playerID2Str],
 			}
 		} else {
 			log.Printf("FromResult: Missing score or hand for player2 ID %s in room %s", playerID2Str, standResult.RoomID)
@@ -71,13 +73,16 @@ func FromResult(standResult *model.Result, roomBet int64) *eventsproto.GameResul
 
 	// Construct the main event message
 	event := &eventsproto.GameResult{
-		RoomId:    standResult.RoomID,
-		WinnerId:  toInt64(standResult.Winner),
-		LoserId:   toInt64(standResult.Loser),
-		Bet:       roomBet,
-		CreatedAt: timestamppb.New(time.Now()),
-		Player1:   p1Data,
-		Player2:   p2Data,
+		RoomId:        standResult.RoomID,
+		WinnerId:      toInt64(standResult.Winner),
+		LoserId:       toInt64(standResult.Loser),
+		Bet:           roomBet,
+		CreatedAt:     timestamppb.New(time.Now()),
+		Player1:       p1Data,
+		Player2:       p2Data,
+		Rake:          standResult.Rake,
+		JackpotPayout: standResult.JackpotPayout,
+		Ranked:        standResult.Ranked,
 	}
 
 	return event
@@ -0,0 +1,28 @@
+package dto
+
+import (
+	eventsproto "game_svc/internal/adapter/grpc/server/frontend/proto/events"
+	"game_svc/internal/model"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func FromTournament(t *model.Tournament) *eventsproto.TournamentResult {
+	places := make([]*eventsproto.TournamentPlace, 0, len(t.Standings))
+	for _, st := range t.Standings {
+		places = append(places, &eventsproto.TournamentPlace{
+			PlayerId: toInt64(st.PlayerID),
+			Place:    int32(st.Place),
+			Prize:    st.Prize,
+		})
+	}
+	return &eventsproto.TournamentResult{
+		TournamentId: t.ID,
+		Name:         t.Name,
+		Format:       t.Format,
+		BuyIn:        t.BuyIn,
+		PrizePool:    t.PrizePool(),
+		Places:       places,
+		StartedAt:    timestamppb.New(t.StartedAt),
+		FinishedAt:   timestamppb.New(t.FinishedAt),
+	}
+}
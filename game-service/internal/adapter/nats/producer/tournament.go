@@ -0,0 +1,45 @@
+package producer
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"google.golang.org/protobuf/proto"
+
+	"game_svc/internal/adapter/nats/producer/dto"
+	"game_svc/internal/model"
+	"game_svc/pkg/nats"
+)
+
+type TournamentEvent struct {
+	natsClient              *nats.Client
+	tournamentResultSubject string
+}
+
+func NewTournamentEvent(
+	natsClient *nats.Client,
+	tournamentResultSubject string,
+) *TournamentEvent {
+	return &TournamentEvent{
+		natsClient:              natsClient,
+		tournamentResultSubject: tournamentResultSubject,
+	}
+}
+
+func (c *TournamentEvent) PushTournamentResult(ctx context.Context, tournament *model.Tournament) error {
+	ctx, cancel := context.WithTimeout(ctx, PushTimeout)
+	defer cancel()
+
+	data, err := proto.Marshal(dto.FromTournament(tournament))
+	if err != nil {
+		return fmt.Errorf("proto.Marshal: %w", err)
+	}
+
+	err = c.natsClient.Conn.Publish(c.tournamentResultSubject, data)
+	if err != nil {
+		return fmt.Errorf("publish TournamentResult: %w", err)
+	}
+	log.Printf("TournamentResult event for tournament %s pushed", tournament.ID)
+	return nil
+}
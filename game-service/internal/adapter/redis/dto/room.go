@@ -0,0 +1,160 @@
+package dto
+
+import "game_svc/internal/model"
+
+// Room — компактное JSON-представление комнаты в Redis. Карты хранятся строками вида "10H".
+// Версия хранится отдельным полем хеша, чтобы Lua-скрипт сравнивал её без разбора документа.
+type Room struct {
+	ID      string   `json:"id"`
+	Status  string   `json:"st"`
+	Bet     int      `json:"b"`
+	Turn    string   `json:"t,omitempty"`
+	GameID  string   `json:"g,omitempty"`
+	Tourney string   `json:"tn,omitempty"`
+	Ranked  bool     `json:"rk,omitempty"`
+	Deck    []string `json:"d,omitempty"`
+	Players []Player `json:"p"`
+	Pending *Pending `json:"pe,omitempty"`
+}
+
+type Player struct {
+	ID         string   `json:"id"`
+	IsReady    bool     `json:"r,omitempty"`
+	Score      int      `json:"s,omitempty"`
+	LastAction string   `json:"a,omitempty"`
+	Hand       []string `json:"h,omitempty"`
+	Stood      bool     `json:"sd,omitempty"`
+}
+
+// Pending — итог партии, ожидающий расчёта. ID партии берётся из комнаты.
+type Pending struct {
+	Deltas        []Delta             `json:"dl"`
+	Rake          int64               `json:"rk,omitempty"`
+	Jackpot       int64               `json:"jc,omitempty"`
+	JackpotWinner int64               `json:"jw,omitempty"`
+	Winner        string              `json:"w,omitempty"`
+	Loser         string              `json:"l,omitempty"`
+	Scores        map[string]int      `json:"s,omitempty"`
+	Hands         map[string][]string `json:"h,omitempty"`
+	Stood         map[string]bool     `json:"sd,omitempty"`
+}
+
+type Delta struct {
+	UserID int64 `json:"u"`
+	Delta  int64 `json:"d"`
+}
+
+func FromRoomModel(room *model.Room) Room {
+	doc := Room{
+		ID:      room.ID,
+		Status:  room.Status,
+		Bet:     room.Bet,
+		Turn:    room.CurrentTurnPlayerID,
+		GameID:  room.GameID,
+		Tourney: room.TournamentID,
+		Ranked:  room.Ranked,
+		Deck:    fromCards(room.Deck),
+		Players: make([]Player, 0, len(room.Players)),
+	}
+	for _, p := range room.Players {
+		doc.Players = append(doc.Players, Player{
+			ID:         p.ID,
+			IsReady:    p.IsReady,
+			Score:      p.Score,
+			LastAction: p.LastAction,
+			Hand:       fromCards(p.Hand),
+			Stood:      p.Stood,
+		})
+	}
+	if pe := room.PendingGameEnd; pe != nil {
+		doc.Pending = &Pending{
+			Deltas:        make([]Delta, 0, len(pe.Settlement.Deltas)),
+			Rake:          pe.Settlement.Rake,
+			Jackpot:       pe.Settlement.JackpotContribution,
+			JackpotWinner: pe.Settlement.JackpotWinnerID,
+			Winner:        pe.Winner,
+			Loser:         pe.Loser,
+			Scores:        pe.FinalScores,
+			Hands:         make(map[string][]string, len(pe.FinalHands)),
+			Stood:         pe.Stood,
+		}
+		for _, d := range pe.Settlement.Deltas {
+			doc.Pending.Deltas = append(doc.Pending.Deltas, Delta{UserID: d.UserID, Delta: d.Delta})
+		}
+		for pID, hand := range pe.FinalHands {
+			doc.Pending.Hands[pID] = fromCards(hand)
+		}
+	}
+	return doc
+}
+
+func ToRoomModel(doc Room, version int64) *model.Room {
+	room := &model.Room{
+		ID:                  doc.ID,
+		Status:              doc.Status,
+		Bet:                 doc.Bet,
+		CurrentTurnPlayerID: doc.Turn,
+		GameID:              doc.GameID,
+		TournamentID:        doc.Tourney,
+		Ranked:              doc.Ranked,
+		Deck:                toCards(doc.Deck),
+		Players:             make([]*model.Player, 0, len(doc.Players)),
+		Version:             version,
+	}
+	for _, p := range doc.Players {
+		room.Players = append(room.Players, &model.Player{
+			ID:         p.ID,
+			IsReady:    p.IsReady,
+			Score:      p.Score,
+			LastAction: p.LastAction,
+			Hand:       toCards(p.Hand),
+			Stood:      p.Stood,
+		})
+	}
+	if pe := doc.Pending; pe != nil {
+		room.PendingGameEnd = &model.PendingGameEnd{
+			Settlement: model.Settlement{
+				GameID:              doc.GameID,
+				Deltas:              make([]model.PlayerDelta, 0, len(pe.Deltas)),
+				Rake:                pe.Rake,
+				JackpotContribution: pe.Jackpot,
+				JackpotWinnerID:     pe.JackpotWinner,
+			},
+			Winner:      pe.Winner,
+			Loser:       pe.Loser,
+			FinalScores: pe.Scores,
+			FinalHands:  make(map[string][]model.Card, len(pe.Hands)),
+			Stood:       pe.Stood,
+		}
+		for _, d := range pe.Deltas {
+			room.PendingGameEnd.Settlement.Deltas = append(room.PendingGameEnd.Settlement.Deltas, model.PlayerDelta{UserID: d.UserID, Delta: d.Delta})
+		}
+		for pID, hand := range pe.Hands {
+			room.PendingGameEnd.FinalHands[pID] = toCards(hand)
+		}
+	}
+	return room
+}
+
+func fromCards(cards []model.Card) []string {
+	if len(cards) == 0 {
+		return nil
+	}
+	res := make([]string, len(cards))
+	for i, card := range cards {
+		res[i] = card.Value + card.Suit
+	}
+	return res
+}
+
+// toCards разбирает "10H" как значение "10" и масть "H": масть — всегда последний символ.
+func toCards(cards []string) []model.Card {
+	res := make([]model.Card, 0, len(cards))
+	for _, c := range cards {
+		if len(c) < 2 {
+			continue
+		}
+		res = append(res, model.Card{Value: c[:len(c)-1], Suit: c[len(c)-1:]})
+	}
+	return res
+}
@@ -0,0 +1,135 @@
+package dto
+
+import (
+	"time"
+
+	"game_svc/internal/model"
+)
+
+// Tournament — JSON-представление турнира в Redis. Время хранится в unix-секундах.
+type Tournament struct {
+	ID         string              `json:"id"`
+	Name       string              `json:"n"`
+	Format     string              `json:"f"`
+	Status     string              `json:"st"`
+	BuyIn      int64               `json:"bi"`
+	MinPlayers int                 `json:"min"`
+	MaxPlayers int                 `json:"max"`
+	OpensAt    int64               `json:"ro,omitempty"`
+	ClosesAt   int64               `json:"rc,omitempty"`
+	Players    []string            `json:"p"`
+	Payouts    []int               `json:"po,omitempty"`
+	Rounds     [][]TournamentMatch `json:"r,omitempty"`
+	Standings  []Standing          `json:"sd,omitempty"`
+	CreatedAt  int64               `json:"c"`
+	StartedAt  int64               `json:"s,omitempty"`
+	FinishedAt int64               `json:"e,omitempty"`
+}
+
+type TournamentMatch struct {
+	PlayerIDs [2]string `json:"p"`
+	RoomID    string    `json:"rm,omitempty"`
+	WinnerID  string    `json:"w,omitempty"`
+	Forfeit   bool      `json:"ff,omitempty"`
+	Deadline  int64     `json:"d,omitempty"`
+}
+
+type Standing struct {
+	PlayerID string `json:"p"`
+	Place    int    `json:"pl"`
+	Prize    int64  `json:"pr,omitempty"`
+}
+
+func FromTournamentModel(t *model.Tournament) Tournament {
+	doc := Tournament{
+		ID:         t.ID,
+		Name:       t.Name,
+		Format:     t.Format,
+		Status:     t.Status,
+		BuyIn:      t.BuyIn,
+		MinPlayers: t.MinPlayers,
+		MaxPlayers: t.MaxPlayers,
+		OpensAt:    toUnix(t.RegistrationOpensAt),
+		ClosesAt:   toUnix(t.RegistrationClosesAt),
+		Players:    t.Players,
+		Payouts:    t.Payouts,
+		Rounds:     make([][]TournamentMatch, 0, len(t.Rounds)),
+		Standings:  make([]Standing, 0, len(t.Standings)),
+		CreatedAt:  toUnix(t.CreatedAt),
+		StartedAt:  toUnix(t.StartedAt),
+		FinishedAt: toUnix(t.FinishedAt),
+	}
+	for _, round := range t.Rounds {
+		matches := make([]TournamentMatch, 0, len(round))
+		for _, m := range round {
+			matches = append(matches, TournamentMatch{
+				PlayerIDs: m.PlayerIDs,
+				RoomID:    m.RoomID,
+				WinnerID:  m.WinnerID,
+				Forfeit:   m.Forfeit,
+				Deadline:  toUnix(m.Deadline),
+			})
+		}
+		doc.Rounds = append(doc.Rounds, matches)
+	}
+	for _, s := range t.Standings {
+		doc.Standings = append(doc.Standings, Standing{PlayerID: s.PlayerID, Place: s.Place, Prize: s.Prize})
+	}
+	return doc
+}
+
+func ToTournamentModel(doc Tournament, version int64) *model.Tournament {
+	t := &model.Tournament{
+		ID:                   doc.ID,
+		Name:                 doc.Name,
+		Format:               doc.Format,
+		Status:               doc.Status,
+		BuyIn:                doc.BuyIn,
+		MinPlayers:           doc.MinPlayers,
+		MaxPlayers:           doc.MaxPlayers,
+		RegistrationOpensAt:  fromUnix(doc.OpensAt),
+		RegistrationClosesAt: fromUnix(doc.ClosesAt),
+		Players:              doc.Players,
+		Payouts:              doc.Payouts,
+		Rounds:               make([][]*model.TournamentMatch, 0, len(doc.Rounds)),
+		Standings:            make([]model.TournamentStanding, 0, len(doc.Standings)),
+		CreatedAt:            fromUnix(doc.CreatedAt),
+		StartedAt:            fromUnix(doc.StartedAt),
+		FinishedAt:           fromUnix(doc.FinishedAt),
+		Version:              version,
+	}
+	if t.Players == nil {
+		t.Players = []string{}
+	}
+	for _, round := range doc.Rounds {
+		matches := make([]*model.TournamentMatch, 0, len(round))
+		for _, m := range round {
+			matches = append(matches, &model.TournamentMatch{
+				PlayerIDs: m.PlayerIDs,
+				RoomID:    m.RoomID,
+				WinnerID:  m.WinnerID,
+				Forfeit:   m.Forfeit,
+				Deadline:  fromUnix(m.Deadline),
+			})
+		}
+		t.Rounds = append(t.Rounds, matches)
+	}
+	for _, s := range doc.Standings {
+		t.Standings = append(t.Standings, model.TournamentStanding{PlayerID: s.PlayerID, Place: s.Place, Prize: s.Prize})
+	}
+	return t
+}
+
+func toUnix(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+func fromUnix(sec int64) time.Time {
+	if sec == 0 {
+		return time.Time{}
+	}
+	return time.Unix(sec, 0)
+}
@@ -0,0 +1,123 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"game_svc/pkg/redis"
+	go_redis "github.com/redis/go-redis/v9"
+)
+
+// PresenceRepoImpl хранит, к какому узлу game-service подключен пользователь: presence:<userID> -> nodeID.
+// Сортированное множество presence:online:expiry содержит всех пользователей в сети со временем
+// истечения записи, чтобы считать их без SCAN. Записи живут ttl: узел продлевает их heartbeat-ом, поэтому
+// пользователи упавшего узла перестают считаться в сети сами.
+type PresenceRepoImpl struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+func NewPresenceRepoImpl(client *redis.Client, ttl time.Duration) *PresenceRepoImpl {
+	return &PresenceRepoImpl{client: client, ttl: ttl}
+}
+
+const (
+	onlineUsersKey = "presence:online:expiry"
+	// refreshBatchSize ограничивает число пользователей в одном вызове refreshNodeScript.
+	refreshBatchSize = 500
+)
+
+func presenceKey(userID string) string {
+	return fmt.Sprintf("presence:%s", userID)
+}
+
+func (r *PresenceRepoImpl) expiresAt() string {
+	return strconv.FormatInt(time.Now().Add(r.ttl).UnixMilli(), 10)
+}
+
+func (r *PresenceRepoImpl) SetNode(ctx context.Context, userID, nodeID string) error {
+	pipe := r.client.Unwrap().TxPipeline()
+	pipe.Set(ctx, presenceKey(userID), nodeID, r.ttl)
+	pipe.ZAdd(ctx, onlineUsersKey, go_redis.Z{Score: float64(time.Now().Add(r.ttl).UnixMilli()), Member: userID})
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("redis SET presence of user %s failed: %w", userID, err)
+	}
+	return nil
+}
+
+// refreshNodeScript продлевает записи пользователей узла ARGV[1]. Запись, которая успела истечь,
+// создаётся заново; запись, которую уже занял другой узел, не трогается.
+// KEYS — presence:<userID> по порядку пользователей из ARGV[4..], последний ключ — presence:online:expiry.
+var refreshNodeScript = go_redis.NewScript(`
+local online = KEYS[#KEYS]
+for i = 1, #KEYS - 1 do
+	local current = redis.call('GET', KEYS[i])
+	if not current or current == ARGV[1] then
+		redis.call('SET', KEYS[i], ARGV[1], 'PX', ARGV[2])
+		redis.call('ZADD', online, ARGV[3], ARGV[i + 3])
+	end
+end
+return 0
+`)
+
+// Refresh продлевает записи о присутствии пользователей, подключенных к узлу nodeID.
+func (r *PresenceRepoImpl) Refresh(ctx context.Context, nodeID string, userIDs []string) error {
+	for start := 0; start < len(userIDs); start += refreshBatchSize {
+		batch := userIDs[start:min(start+refreshBatchSize, len(userIDs))]
+		keys := make([]string, 0, len(batch)+1)
+		args := make([]interface{}, 0, len(batch)+3)
+		args = append(args, nodeID, r.ttl.Milliseconds(), r.expiresAt())
+		for _, userID := range batch {
+			keys = append(keys, presenceKey(userID))
+			args = append(args, userID)
+		}
+		keys = append(keys, onlineUsersKey)
+		if err := refreshNodeScript.Run(ctx, r.client.Unwrap(), keys, args...).Err(); err != nil {
+			return fmt.Errorf("redis refresh presence of node %s failed: %w", nodeID, err)
+		}
+	}
+	return nil
+}
+
+// removeNodeScript удаляет запись, только если пользователь всё ещё числится за этим узлом.
+var removeNodeScript = go_redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	redis.call('ZREM', KEYS[2], ARGV[2])
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+func (r *PresenceRepoImpl) RemoveNode(ctx context.Context, userID, nodeID string) error {
+	keys := []string{presenceKey(userID), onlineUsersKey}
+	if err := removeNodeScript.Run(ctx, r.client.Unwrap(), keys, nodeID, userID).Err(); err != nil {
+		return fmt.Errorf("redis remove presence of user %s failed: %w", userID, err)
+	}
+	return nil
+}
+
+func (r *PresenceRepoImpl) NodeOf(ctx context.Context, userID string) (string, error) {
+	nodeID, err := r.client.Unwrap().Get(ctx, presenceKey(userID)).Result()
+	if errors.Is(err, go_redis.Nil) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("redis GET presence of user %s failed: %w", userID, err)
+	}
+	return nodeID, nil
+}
+
+// CountOnline считает пользователей с непросроченной записью и заодно убирает из presence:online:expiry истёкшие.
+func (r *PresenceRepoImpl) CountOnline(ctx context.Context) (int64, error) {
+	now := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	pipe := r.client.Unwrap().TxPipeline()
+	pipe.ZRemRangeByScore(ctx, onlineUsersKey, "-inf", now)
+	count := pipe.ZCount(ctx, onlineUsersKey, "("+now, "+inf")
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("redis ZCOUNT %s failed: %w", onlineUsersKey, err)
+	}
+	return count.Val(), nil
+}
@@ -2,177 +2,192 @@ package redis
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"game_svc/internal/model"
 	"log"
 	"strconv"
-	"strings"
+	"time"
 
+	"game_svc/internal/adapter/redis/dto"
+	"game_svc/internal/model"
 	"game_svc/pkg/redis"
+	go_redis "github.com/redis/go-redis/v9"
+)
+
+// Комната хранится хешем room:<id> с двумя полями: state — JSON-документ комнаты
+// и version — номер версии, по которому делается compare-and-swap.
+// Сортированное множество rooms:activity хранит время последней записи каждой комнаты,
+// по нему уборщик находит зависшие комнаты. Ключ player:<userID>:room хранит комнату,
+// в которой игрок был при последней её записи: по нему комната игрока находится без обхода всех комнат.
+const (
+	roomStateField   = "state"
+	roomVersionField = "version"
+	roomActivityKey  = "rooms:activity"
 )
 
 type RoomStateRepoImpl struct {
-	client *redis.Client
+	client  *redis.Client
+	idleTTL time.Duration
 }
 
-func NewRoomStateRepoImpl(client *redis.Client) *RoomStateRepoImpl {
-	return &RoomStateRepoImpl{client: client}
+// NewRoomStateRepoImpl создаёт репозиторий комнат. Каждая запись комнаты продлевает её
+// TTL на idleTTL, так что брошенные комнаты исчезают из Redis сами.
+func NewRoomStateRepoImpl(client *redis.Client, idleTTL time.Duration) *RoomStateRepoImpl {
+	return &RoomStateRepoImpl{client: client, idleTTL: idleTTL}
 }
 
 func roomKey(roomID string) string {
 	return fmt.Sprintf("room:%s", roomID)
 }
 
-func (r *RoomStateRepoImpl) GetAllRoomFields(ctx context.Context, roomID string) (map[string]string, error) {
-	key := roomKey(roomID)
-	fields, err := r.client.Unwrap().HGetAll(ctx, key).Result()
-	if err != nil {
-		return nil, fmt.Errorf("redis HGetAll for room %s failed: %w", roomID, err)
-	}
-	return fields, nil
+func playerRoomKey(userID string) string {
+	return fmt.Sprintf("player:%s:room", userID)
 }
 
-func (r *RoomStateRepoImpl) UpdatePlayerList(ctx context.Context, roomID string, updatedPlayersStr string) error {
-	key := roomKey(roomID)
-	pipe := r.client.Unwrap().Pipeline()
-	pipe.HSet(ctx, key, "players", updatedPlayersStr)
-	_, err := pipe.Exec(ctx)
-	if err != nil {
-		return fmt.Errorf("redis HSet players for room %s failed: %w", roomID, err)
+// roomKeys возвращает ключи, которые меняют скрипты записи и удаления комнаты: саму комнату,
+// rooms:activity и player:<userID>:room каждого игрока в ней.
+func roomKeys(room *model.Room) []string {
+	keys := []string{roomKey(room.ID), roomActivityKey}
+	for _, p := range room.Players {
+		keys = append(keys, playerRoomKey(p.ID))
 	}
-	return nil
+	return keys
 }
 
-func (r *RoomStateRepoImpl) DeletePlayerSpecificFields(ctx context.Context, roomID string, playerID string, baseFields []string) error {
-	key := roomKey(roomID)
-	if len(baseFields) == 0 {
-		return nil
+// GetRoom загружает комнату одним HMGET.
+func (r *RoomStateRepoImpl) GetRoom(ctx context.Context, roomID string) (*model.Room, error) {
+	values, err := r.client.Unwrap().HMGet(ctx, roomKey(roomID), roomStateField, roomVersionField).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis HMGET for room %s failed: %w", roomID, err)
 	}
-
-	fieldsToDelete := make([]string, len(baseFields))
-	for i, baseField := range baseFields {
-		fieldsToDelete[i] = fmt.Sprintf("%s.%s", baseField, playerID)
+	state, ok := values[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("room %s: %w", roomID, model.ErrRoomNotFound)
+	}
+	var version int64
+	if v, ok := values[1].(string); ok {
+		if version, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return nil, fmt.Errorf("room %s has invalid version %q: %w", roomID, v, err)
+		}
 	}
 
-	pipe := r.client.Unwrap().Pipeline()
-	pipe.HDel(ctx, key, fieldsToDelete...)
-	_, err := pipe.Exec(ctx)
-	if err != nil {
-		return fmt.Errorf("redis HDel player specific fields for player %s in room %s failed: %w", playerID, roomID, err)
+	var doc dto.Room
+	if err := json.Unmarshal([]byte(state), &doc); err != nil {
+		return nil, fmt.Errorf("failed to decode room %s: %w", roomID, err)
 	}
-	log.Printf("Redis: Deleted fields %v for player %s in room %s", fieldsToDelete, playerID, roomID)
-	return nil
+	return dto.ToRoomModel(doc, version), nil
 }
 
-func (r *RoomStateRepoImpl) ResetPlayerState(ctx context.Context, roomID string, playerID string) error {
-	key := roomKey(roomID)
-	pipe := r.client.Unwrap().Pipeline()
-
-	// Сбрасываем поля на дефолтные значения
-	pipe.HSet(ctx, key, fmt.Sprintf("scores.%s", playerID), 0)
-	pipe.HSet(ctx, key, fmt.Sprintf("readyStatus.%s", playerID), 0)
-	pipe.HSet(ctx, key, fmt.Sprintf("hands.%s", playerID), "nil")
-	pipe.HSet(ctx, key, fmt.Sprintf("lastAction.%s", playerID), "nil")
-	pipe.HSet(ctx, key, fmt.Sprintf("stood.%s", playerID), false)
+// saveRoomScript записывает документ, только если версия в Redis совпадает с ожидаемой
+// (0 — комнаты ещё нет), продлевает TTL комнаты, отмечает время записи в rooms:activity
+// и записывает комнату игрокам из KEYS[3..] с тем же TTL. Возвращает новую версию или -1 при конфликте.
+var saveRoomScript = go_redis.NewScript(`
+local current = tonumber(redis.call('HGET', KEYS[1], 'version') or '0')
+if current ~= tonumber(ARGV[1]) then
+	return -1
+end
+redis.call('HSET', KEYS[1], 'state', ARGV[2], 'version', current + 1)
+if tonumber(ARGV[3]) > 0 then
+	redis.call('EXPIRE', KEYS[1], ARGV[3])
+end
+redis.call('ZADD', KEYS[2], ARGV[4], ARGV[5])
+for i = 3, #KEYS do
+	if tonumber(ARGV[3]) > 0 then
+		redis.call('SET', KEYS[i], ARGV[5], 'EX', ARGV[3])
+	else
+		redis.call('SET', KEYS[i], ARGV[5])
+	end
+end
+return current + 1
+`)
+
+// deleteRoomScript удаляет комнату, только если её версия совпадает с ожидаемой, и стирает
+// её у игроков из KEYS[3..], если они с тех пор не перешли в другую комнату.
+var deleteRoomScript = go_redis.NewScript(`
+local current = tonumber(redis.call('HGET', KEYS[1], 'version') or '0')
+if current ~= tonumber(ARGV[1]) then
+	return -1
+end
+redis.call('DEL', KEYS[1])
+redis.call('ZREM', KEYS[2], ARGV[2])
+for i = 3, #KEYS do
+	if redis.call('GET', KEYS[i]) == ARGV[2] then
+		redis.call('DEL', KEYS[i])
+	end
+end
+return 0
+`)
+
+// SaveRoom сохраняет комнату целиком, если её не изменили с момента чтения, и увеличивает room.Version.
+func (r *RoomStateRepoImpl) SaveRoom(ctx context.Context, room *model.Room) error {
+	state, err := json.Marshal(dto.FromRoomModel(room))
+	if err != nil {
+		return fmt.Errorf("failed to encode room %s: %w", room.ID, err)
+	}
 
-	_, err := pipe.Exec(ctx)
+	version, err := saveRoomScript.Run(ctx, r.client.Unwrap(), roomKeys(room), room.Version, state, int64(r.idleTTL.Seconds()), time.Now().Unix(), room.ID).Int64()
 	if err != nil {
-		return fmt.Errorf("redis ResetPlayerState for player %s in room %s failed: %w", playerID, roomID, err)
+		return fmt.Errorf("redis save of room %s failed: %w", room.ID, err)
 	}
-	log.Printf("Redis: Reset state for player %s in room %s", playerID, roomID)
+	if version < 0 {
+		return fmt.Errorf("room %s at version %d: %w", room.ID, room.Version, model.ErrRoomStateConflict)
+	}
+	room.Version = version
 	return nil
 }
 
-func (r *RoomStateRepoImpl) DeleteRoom(ctx context.Context, roomID string) error {
-	key := roomKey(roomID)
-	pipe := r.client.Unwrap().Pipeline()
-	pipe.Del(ctx, key)
-	_, err := pipe.Exec(ctx)
+// DeleteRoom удаляет комнату, если её не изменили с момента чтения.
+func (r *RoomStateRepoImpl) DeleteRoom(ctx context.Context, room *model.Room) error {
+	res, err := deleteRoomScript.Run(ctx, r.client.Unwrap(), roomKeys(room), room.Version, room.ID).Int64()
 	if err != nil {
-		return fmt.Errorf("redis Del room %s failed: %w", roomID, err)
+		return fmt.Errorf("redis delete of room %s failed: %w", room.ID, err)
+	}
+	if res < 0 {
+		return fmt.Errorf("room %s at version %d: %w", room.ID, room.Version, model.ErrRoomStateConflict)
 	}
-	log.Printf("Redis: Deleted room %s", roomID)
+	log.Printf("Redis: Deleted room %s", room.ID)
 	return nil
 }
 
-func (r *RoomStateRepoImpl) SetRoomField(ctx context.Context, roomID string, field string, value interface{}) error {
-	key := roomKey(roomID)
-	pipe := r.client.Unwrap().Pipeline()
-	pipe.HSet(ctx, key, field, value)
-	_, err := pipe.Exec(ctx)
+// PlayerRoomID возвращает комнату, в которой игрок был при последней её записи, или пустую строку.
+func (r *RoomStateRepoImpl) PlayerRoomID(ctx context.Context, userID string) (string, error) {
+	roomID, err := r.client.Unwrap().Get(ctx, playerRoomKey(userID)).Result()
+	if errors.Is(err, go_redis.Nil) {
+		return "", nil
+	}
 	if err != nil {
-		return fmt.Errorf("redis HSet field %s for room %s failed: %w", field, roomID, err)
+		return "", fmt.Errorf("redis GET room of player %s failed: %w", userID, err)
 	}
-	return nil
+	return roomID, nil
 }
 
-func (r *RoomStateRepoImpl) SaveRoom(ctx context.Context, room *model.Room) error {
-	key := roomKey(room.ID)
-	pipe := r.client.Unwrap().Pipeline()
-
-	// Основные поля комнаты
-	pipe.HSet(ctx, key, "roomID", room.ID)
-	pipe.HSet(ctx, key, "status", room.Status)
-	pipe.HSet(ctx, key, "bet", strconv.Itoa(room.Bet))
-	pipe.HSet(ctx, key, "turn", room.CurrentTurnPlayerID)
-
-	// Поля игроков
-	if len(room.Players) > 0 {
-		playerIDs := make([]string, len(room.Players))
-		for i, p := range room.Players {
-			playerIDs[i] = p.ID
-
-			// Сохраняем индивидуальные состояния игроков
-			readyStatus := "0"
-			if p.IsReady {
-				readyStatus = "1"
-			}
-			pipe.HSet(ctx, key, fmt.Sprintf("readyStatus.%s", p.ID), readyStatus)
-			pipe.HSet(ctx, key, fmt.Sprintf("scores.%s", p.ID), strconv.Itoa(p.Score))
-
-			var handStr string
-			if len(p.Hand) == 0 {
-				handStr = "nil"
-			} else {
-				cardStrings := make([]string, len(p.Hand))
-				for j, card := range p.Hand {
-					cardStrings[j] = card.Value + card.Suit
-				}
-				handStr = strings.Join(cardStrings, ",")
-			}
-			pipe.HSet(ctx, key, fmt.Sprintf("hands.%s", p.ID), handStr)
-			pipe.HSet(ctx, key, fmt.Sprintf("lastAction.%s", p.ID), p.LastAction)
-		}
-		pipe.HSet(ctx, key, "players", strings.Join(playerIDs, ","))
-	} else {
-		pipe.HSet(ctx, key, "players", "") // Если игроков нет (маловероятно для CreateRoom)
+// ListRoomIDs возвращает ID всех комнат из rooms:activity.
+func (r *RoomStateRepoImpl) ListRoomIDs(ctx context.Context) ([]string, error) {
+	ids, err := r.client.Unwrap().ZRange(ctx, roomActivityKey, 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis ZRANGE %s failed: %w", roomActivityKey, err)
 	}
+	return ids, nil
+}
 
-	_, err := pipe.Exec(ctx)
+// ListIdleRooms возвращает ID комнат, в которые ничего не записывали с момента before.
+// Среди них могут быть и комнаты, уже удалённые по TTL.
+func (r *RoomStateRepoImpl) ListIdleRooms(ctx context.Context, before time.Time) ([]string, error) {
+	ids, err := r.client.Unwrap().ZRangeByScore(ctx, roomActivityKey, &go_redis.ZRangeBy{
+		Min: "-inf",
+		Max: strconv.FormatInt(before.Unix(), 10),
+	}).Result()
 	if err != nil {
-		return fmt.Errorf("redis pipeline failed for SaveRoom, roomID %s: %w", room.ID, err)
+		return nil, fmt.Errorf("redis ZRANGEBYSCORE %s failed: %w", roomActivityKey, err)
 	}
-	log.Printf("Redis: Successfully saved room %s state", room.ID)
-	return nil
+	return ids, nil
 }
 
-func (r *RoomStateRepoImpl) AddJoiningPlayer(ctx context.Context, roomID string, joiningUserID string, updatedPlayersStr string) error {
-	key := roomKey(roomID)
-	pipe := r.client.Unwrap().Pipeline()
-
-	// Update the players list
-	pipe.HSet(ctx, key, "players", updatedPlayersStr)
-
-	// Set default fields for the joining player
-	pipe.HSet(ctx, key, fmt.Sprintf("readyStatus.%s", joiningUserID), "0") // "0" for false
-	pipe.HSet(ctx, key, fmt.Sprintf("scores.%s", joiningUserID), "0")
-	pipe.HSet(ctx, key, fmt.Sprintf("hands.%s", joiningUserID), "nil")      // Default empty/nil hand
-	pipe.HSet(ctx, key, fmt.Sprintf("lastAction.%s", joiningUserID), "nil") // No last action yet
-
-	_, err := pipe.Exec(ctx)
-	if err != nil {
-		return fmt.Errorf("redis pipeline failed for AddJoiningPlayer in room %s for user %s: %w", roomID, joiningUserID, err)
+// ForgetRoom убирает из rooms:activity комнату, которую Redis уже удалил по TTL.
+func (r *RoomStateRepoImpl) ForgetRoom(ctx context.Context, roomID string) error {
+	if err := r.client.Unwrap().ZRem(ctx, roomActivityKey, roomID).Err(); err != nil {
+		return fmt.Errorf("redis ZREM room %s from %s failed: %w", roomID, roomActivityKey, err)
 	}
-	log.Printf("Redis: Successfully added player %s to room %s with default fields", joiningUserID, roomID)
 	return nil
 }
@@ -0,0 +1,293 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"game_svc/internal/model"
+	"game_svc/pkg/redis"
+
+	"github.com/alicebob/miniredis/v2"
+	go_redis "github.com/redis/go-redis/v9"
+)
+
+// Бенчмарки сравнивают хранение комнаты документом с CAS-скриптом (SaveRoom) и прежнюю схему,
+// где каждое поле комнаты и игрока лежало отдельным полем хеша, а переход применялся
+// скриптом по списку полей. Обе схемы делают одно и то же: читают комнату, меняют её
+// как ход игрока и записывают с проверкой версии. Redis здесь — miniredis в том же процессе,
+// поэтому время и аллокации включают работу сервера, а сетевой задержки нет.
+//
+//	go test -run '^$' -bench . -benchmem ./internal/adapter/redis/
+
+const (
+	benchRoomID = "bench"
+	benchP1     = "101"
+	benchP2     = "202"
+)
+
+func newBenchClient(b *testing.B) *redis.Client {
+	b.Helper()
+	mr := miniredis.RunT(b)
+	client, err := redis.NewClient(context.Background(), redis.Config{Host: mr.Addr()})
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+// benchRoom — партия в середине игры: у каждого игрока по две карты, в колоде остальные.
+func benchRoom() *model.Room {
+	var deck []model.Card
+	for _, suit := range []string{"H", "D", "C", "S"} {
+		for _, value := range []string{"A", "2", "3", "4", "5", "6", "7", "8", "9", "10", "J", "Q", "K"} {
+			deck = append(deck, model.Card{Value: value, Suit: suit})
+		}
+	}
+	return &model.Room{
+		ID:                  benchRoomID,
+		Status:              "in_progress",
+		Bet:                 100,
+		GameID:              "game-1",
+		CurrentTurnPlayerID: benchP1,
+		Deck:                deck[4:],
+		Players: []*model.Player{
+			{ID: benchP1, IsReady: true, Hand: deck[0:2], Score: 3},
+			{ID: benchP2, IsReady: true, Hand: deck[2:4], Score: 7},
+		},
+	}
+}
+
+// Действия меняют комнату так, чтобы она не росла от итерации к итерации: карта из колоды
+// идёт в руку и возвращается в конец колоды, ход и флаги переключаются туда и обратно.
+
+func benchHit(room *model.Room, i int) {
+	p := room.Players[i%2]
+	card := room.Deck[0]
+	room.Deck = append(room.Deck[1:], card)
+	p.Hand = append(p.Hand[:2:2], card)
+	p.Score = calculateBenchScore(p.Hand)
+	room.CurrentTurnPlayerID = room.Players[(i+1)%2].ID
+}
+
+func benchStand(room *model.Room, i int) {
+	p := room.Players[i%2]
+	p.Stood = !p.Stood
+	room.CurrentTurnPlayerID = room.Players[(i+1)%2].ID
+}
+
+func benchReady(room *model.Room, i int) {
+	p := room.Players[i%2]
+	p.IsReady = !p.IsReady
+}
+
+func calculateBenchScore(hand []model.Card) int {
+	score := 0
+	for _, c := range hand {
+		if v, err := strconv.Atoi(c.Value); err == nil {
+			score += v
+		} else {
+			score += 10
+		}
+	}
+	return score
+}
+
+func benchmarkDocument(b *testing.B, action func(*model.Room, int)) {
+	ctx := context.Background()
+	repo := NewRoomStateRepoImpl(newBenchClient(b), time.Hour)
+	if err := repo.SaveRoom(ctx, benchRoom()); err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		room, err := repo.GetRoom(ctx, benchRoomID)
+		if err != nil {
+			b.Fatal(err)
+		}
+		action(room, i)
+		if err := repo.SaveRoom(ctx, room); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkRoomDocumentHit(b *testing.B)   { benchmarkDocument(b, benchHit) }
+func BenchmarkRoomDocumentStand(b *testing.B) { benchmarkDocument(b, benchStand) }
+func BenchmarkRoomDocumentReady(b *testing.B) { benchmarkDocument(b, benchReady) }
+
+// legacyTransitionScript — скрипт прежней схемы: проверка версии и HSET/HDEL по списку полей.
+var legacyTransitionScript = go_redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return -2
+end
+local current = tonumber(redis.call('HGET', KEYS[1], 'version') or '0')
+if current ~= tonumber(ARGV[1]) then
+	return -1
+end
+local setCount = tonumber(ARGV[2])
+local i = 3
+for _ = 1, setCount do
+	redis.call('HSET', KEYS[1], ARGV[i], ARGV[i + 1])
+	i = i + 2
+end
+return redis.call('HINCRBY', KEYS[1], 'version', 1)
+`)
+
+// legacyFields раскладывает комнату по полям хеша так, как их хранила прежняя схема.
+func legacyFields(room *model.Room) map[string]string {
+	fields := map[string]string{
+		"roomID": room.ID,
+		"status": room.Status,
+		"bet":    strconv.Itoa(room.Bet),
+		"turn":   room.CurrentTurnPlayerID,
+		"gameID": room.GameID,
+		"deck":   legacyCards(room.Deck),
+	}
+	ids := make([]string, 0, len(room.Players))
+	for _, p := range room.Players {
+		ids = append(ids, p.ID)
+		fields["readyStatus."+p.ID] = legacyFlag(p.IsReady)
+		fields["scores."+p.ID] = strconv.Itoa(p.Score)
+		fields["hands."+p.ID] = legacyCards(p.Hand)
+		fields["lastAction."+p.ID] = p.LastAction
+		fields["stood."+p.ID] = legacyFlag(p.Stood)
+	}
+	fields["players"] = strings.Join(ids, ",")
+	return fields
+}
+
+func legacyFlag(v bool) string {
+	if v {
+		return "1"
+	}
+	return "0"
+}
+
+func legacyCards(cards []model.Card) string {
+	if len(cards) == 0 {
+		return "nil"
+	}
+	parts := make([]string, len(cards))
+	for i, c := range cards {
+		parts[i] = c.Value + c.Suit
+	}
+	return strings.Join(parts, ",")
+}
+
+func legacyParseCards(s string) []model.Card {
+	if s == "nil" || s == "" {
+		return nil
+	}
+	parts := strings.Split(s, ",")
+	cards := make([]model.Card, 0, len(parts))
+	for _, part := range parts {
+		cards = append(cards, model.Card{Value: part[:len(part)-1], Suit: part[len(part)-1:]})
+	}
+	return cards
+}
+
+// legacyLoad читает комнату HGETALL и собирает модель из полей, как это делали действия до перехода на документ.
+func legacyLoad(ctx context.Context, client *go_redis.Client) (*model.Room, int64, error) {
+	fields, err := client.HGetAll(ctx, roomKey(benchRoomID)).Result()
+	if err != nil {
+		return nil, 0, err
+	}
+	version, _ := strconv.ParseInt(fields["version"], 10, 64)
+	bet, _ := strconv.Atoi(fields["bet"])
+	room := &model.Room{
+		ID:                  fields["roomID"],
+		Status:              fields["status"],
+		Bet:                 bet,
+		GameID:              fields["gameID"],
+		CurrentTurnPlayerID: fields["turn"],
+		Deck:                legacyParseCards(fields["deck"]),
+	}
+	for _, id := range strings.Split(fields["players"], ",") {
+		score, _ := strconv.Atoi(fields["scores."+id])
+		room.Players = append(room.Players, &model.Player{
+			ID:         id,
+			IsReady:    fields["readyStatus."+id] == "1",
+			Score:      score,
+			Hand:       legacyParseCards(fields["hands."+id]),
+			LastAction: fields["lastAction."+id],
+			Stood:      fields["stood."+id] == "1",
+		})
+	}
+	return room, version, nil
+}
+
+// legacyApply записывает поля, которые изменило действие, одним скриптом с проверкой версии.
+func legacyApply(ctx context.Context, client *go_redis.Client, version int64, set map[string]string) error {
+	args := make([]interface{}, 0, 2+2*len(set))
+	args = append(args, version, len(set))
+	for field, value := range set {
+		args = append(args, field, value)
+	}
+	res, err := legacyTransitionScript.Run(ctx, client, []string{roomKey(benchRoomID)}, args...).Int64()
+	if err != nil {
+		return err
+	}
+	if res < 0 {
+		return fmt.Errorf("legacy transition rejected: %d", res)
+	}
+	return nil
+}
+
+func benchmarkLegacy(b *testing.B, action func(*model.Room, int), changed func(*model.Room, int) map[string]string) {
+	ctx := context.Background()
+	client := newBenchClient(b).Unwrap()
+	fields := legacyFields(benchRoom())
+	fields["version"] = "0"
+	if err := client.HSet(ctx, roomKey(benchRoomID), fields).Err(); err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		room, version, err := legacyLoad(ctx, client)
+		if err != nil {
+			b.Fatal(err)
+		}
+		action(room, i)
+		if err := legacyApply(ctx, client, version, changed(room, i)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkRoomLegacyFieldsHit(b *testing.B) {
+	benchmarkLegacy(b, benchHit, func(room *model.Room, i int) map[string]string {
+		p := room.Players[i%2]
+		return map[string]string{
+			"hands." + p.ID:  legacyCards(p.Hand),
+			"scores." + p.ID: strconv.Itoa(p.Score),
+			"deck":           legacyCards(room.Deck),
+			"turn":           room.CurrentTurnPlayerID,
+		}
+	})
+}
+
+func BenchmarkRoomLegacyFieldsStand(b *testing.B) {
+	benchmarkLegacy(b, benchStand, func(room *model.Room, i int) map[string]string {
+		p := room.Players[i%2]
+		return map[string]string{
+			"stood." + p.ID: legacyFlag(p.Stood),
+			"turn":          room.CurrentTurnPlayerID,
+		}
+	})
+}
+
+func BenchmarkRoomLegacyFieldsReady(b *testing.B) {
+	benchmarkLegacy(b, benchReady, func(room *model.Room, i int) map[string]string {
+		p := room.Players[i%2]
+		return map[string]string{"readyStatus." + p.ID: legacyFlag(p.IsReady)}
+	})
+}
@@ -0,0 +1,52 @@
+package redis
+
+import (
+	"context"
+	"testing"
+
+	"game_svc/internal/model"
+	"game_svc/pkg/redis"
+
+	"github.com/alicebob/miniredis/v2"
+)
+
+func TestPlayerRoomIDFollowsRoomWrites(t *testing.T) {
+	ctx := context.Background()
+	mr := miniredis.RunT(t)
+	client, err := redis.NewClient(ctx, redis.Config{Host: mr.Addr()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	repo := NewRoomStateRepoImpl(client, 0)
+
+	wantRoom := func(userID, want string) {
+		t.Helper()
+		got, err := repo.PlayerRoomID(ctx, userID)
+		if err != nil {
+			t.Fatalf("PlayerRoomID(%s): %v", userID, err)
+		}
+		if got != want {
+			t.Errorf("PlayerRoomID(%s) = %q, want %q", userID, got, want)
+		}
+	}
+
+	a := &model.Room{ID: "a", Status: "waiting", Players: []*model.Player{{ID: "1"}, {ID: "2"}}}
+	if err := repo.SaveRoom(ctx, a); err != nil {
+		t.Fatal(err)
+	}
+	wantRoom("1", "a")
+	wantRoom("2", "a")
+	wantRoom("3", "")
+
+	// Игрок 2 переходит в другую комнату; удаление первой не стирает его новую запись
+	b := &model.Room{ID: "b", Status: "waiting", Players: []*model.Player{{ID: "2"}}}
+	if err := repo.SaveRoom(ctx, b); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.DeleteRoom(ctx, a); err != nil {
+		t.Fatal(err)
+	}
+	wantRoom("1", "")
+	wantRoom("2", "b")
+}
@@ -0,0 +1,168 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"game_svc/internal/adapter/redis/dto"
+	"game_svc/internal/model"
+	"game_svc/pkg/redis"
+	go_redis "github.com/redis/go-redis/v9"
+)
+
+// Турнир хранится так же, как комната: хешем tournament:<id> с полями state и version.
+// tournaments:all — все турниры по времени создания, tournaments:active — ещё не завершённые,
+// их обходит планировщик. Завершённый или отменённый турнир живёт finishedTTL.
+// tournaments:lease — какой узел сейчас ведёт турниры.
+const (
+	tournamentsAllKey    = "tournaments:all"
+	tournamentsActiveKey = "tournaments:active"
+	tournamentsLeaseKey  = "tournaments:lease"
+)
+
+type TournamentRepoImpl struct {
+	client      *redis.Client
+	finishedTTL time.Duration
+}
+
+// NewTournamentRepoImpl создаёт репозиторий турниров. Завершённые турниры остаются видны finishedTTL.
+func NewTournamentRepoImpl(client *redis.Client, finishedTTL time.Duration) *TournamentRepoImpl {
+	return &TournamentRepoImpl{client: client, finishedTTL: finishedTTL}
+}
+
+func tournamentKey(tournamentID string) string {
+	return fmt.Sprintf("tournament:%s", tournamentID)
+}
+
+func (r *TournamentRepoImpl) GetTournament(ctx context.Context, tournamentID string) (*model.Tournament, error) {
+	values, err := r.client.Unwrap().HMGet(ctx, tournamentKey(tournamentID), roomStateField, roomVersionField).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis HMGET for tournament %s failed: %w", tournamentID, err)
+	}
+	state, ok := values[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("tournament %s: %w", tournamentID, model.ErrTournamentNotFound)
+	}
+	var version int64
+	if v, ok := values[1].(string); ok {
+		if version, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return nil, fmt.Errorf("tournament %s has invalid version %q: %w", tournamentID, v, err)
+		}
+	}
+
+	var doc dto.Tournament
+	if err := json.Unmarshal([]byte(state), &doc); err != nil {
+		return nil, fmt.Errorf("failed to decode tournament %s: %w", tournamentID, err)
+	}
+	return dto.ToTournamentModel(doc, version), nil
+}
+
+// saveTournamentScript записывает документ при совпадении версии, как saveRoomScript.
+// Активный турнир попадает в tournaments:active, завершённый убирается оттуда и получает TTL.
+var saveTournamentScript = go_redis.NewScript(`
+local current = tonumber(redis.call('HGET', KEYS[1], 'version') or '0')
+if current ~= tonumber(ARGV[1]) then
+	return -1
+end
+redis.call('HSET', KEYS[1], 'state', ARGV[2], 'version', current + 1)
+redis.call('ZADD', KEYS[2], ARGV[4], ARGV[5])
+if ARGV[3] == '1' then
+	redis.call('SADD', KEYS[3], ARGV[5])
+else
+	redis.call('SREM', KEYS[3], ARGV[5])
+	redis.call('EXPIRE', KEYS[1], ARGV[6])
+end
+return current + 1
+`)
+
+// SaveTournament сохраняет турнир, если его не изменили с момента чтения, и увеличивает t.Version.
+func (r *TournamentRepoImpl) SaveTournament(ctx context.Context, t *model.Tournament) error {
+	state, err := json.Marshal(dto.FromTournamentModel(t))
+	if err != nil {
+		return fmt.Errorf("failed to encode tournament %s: %w", t.ID, err)
+	}
+	active := "0"
+	if t.Status == model.TournamentStatusRegistering || t.Status == model.TournamentStatusRunning {
+		active = "1"
+	}
+
+	keys := []string{tournamentKey(t.ID), tournamentsAllKey, tournamentsActiveKey}
+	version, err := saveTournamentScript.Run(ctx, r.client.Unwrap(), keys,
+		t.Version, state, active, t.CreatedAt.Unix(), t.ID, int64(r.finishedTTL.Seconds())).Int64()
+	if err != nil {
+		return fmt.Errorf("redis save of tournament %s failed: %w", t.ID, err)
+	}
+	if version < 0 {
+		return fmt.Errorf("tournament %s at version %d: %w", t.ID, t.Version, model.ErrTournamentStateConflict)
+	}
+	t.Version = version
+	return nil
+}
+
+// ListTournaments возвращает турниры, начиная с самых новых. Истёкшие по TTL убираются из индекса.
+func (r *TournamentRepoImpl) ListTournaments(ctx context.Context, limit int) ([]*model.Tournament, error) {
+	ids, err := r.client.Unwrap().ZRevRange(ctx, tournamentsAllKey, 0, int64(limit)-1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis ZREVRANGE %s failed: %w", tournamentsAllKey, err)
+	}
+	tournaments, missing, err := r.load(ctx, ids)
+	if len(missing) > 0 {
+		r.client.Unwrap().ZRem(ctx, tournamentsAllKey, missing...)
+	}
+	return tournaments, err
+}
+
+// ListActiveTournaments возвращает турниры, которые ещё регистрируют игроков или идут.
+func (r *TournamentRepoImpl) ListActiveTournaments(ctx context.Context) ([]*model.Tournament, error) {
+	ids, err := r.client.Unwrap().SMembers(ctx, tournamentsActiveKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis SMEMBERS %s failed: %w", tournamentsActiveKey, err)
+	}
+	tournaments, missing, err := r.load(ctx, ids)
+	if len(missing) > 0 {
+		r.client.Unwrap().SRem(ctx, tournamentsActiveKey, missing...)
+	}
+	return tournaments, err
+}
+
+// load загружает турниры по ID и возвращает ID тех, которых в Redis уже нет.
+func (r *TournamentRepoImpl) load(ctx context.Context, ids []string) ([]*model.Tournament, []interface{}, error) {
+	tournaments := make([]*model.Tournament, 0, len(ids))
+	var missing []interface{}
+	for _, id := range ids {
+		t, err := r.GetTournament(ctx, id)
+		if errors.Is(err, model.ErrTournamentNotFound) {
+			missing = append(missing, id)
+			continue
+		}
+		if err != nil {
+			return nil, missing, err
+		}
+		tournaments = append(tournaments, t)
+	}
+	return tournaments, missing, nil
+}
+
+// acquireLeaseScript выдаёт аренду узлу, если она свободна или уже принадлежит ему, и продлевает её.
+var acquireLeaseScript = go_redis.NewScript(`
+local holder = redis.call('GET', KEYS[1])
+if holder and holder ~= ARGV[1] then
+	return 0
+end
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+return 1
+`)
+
+// AcquireSchedulerLease сообщает, ведёт ли турниры узел nodeID. Аренда продлевается на ttl
+// при каждом успешном вызове, так что после падения узла её через ttl подхватит другой.
+func (r *TournamentRepoImpl) AcquireSchedulerLease(ctx context.Context, nodeID string, ttl time.Duration) (bool, error) {
+	ok, err := acquireLeaseScript.Run(ctx, r.client.Unwrap(), []string{tournamentsLeaseKey}, nodeID, ttl.Milliseconds()).Int64()
+	if err != nil {
+		return false, fmt.Errorf("redis acquire of %s failed: %w", tournamentsLeaseKey, err)
+	}
+	return ok == 1, nil
+}
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"game_svc/internal/adapter/ws/server/dto"
+	"game_svc/internal/model"
+	gameservicews "game_svc/pkg/ws"
+)
+
+// OnlineCount returns the number of players online across the cluster and the connections of this instance.
+func (gmh *GameMessageHandler) OnlineCount(ctx context.Context) (players int64, connections int64, err error) {
+	players, err = gmh.hub.OnlineCount(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	return players, int64(gmh.hub.GetClientCount()), nil
+}
+
+// ForceCloseRoom closes a room on an admin's request. Stakes of a running game are refunded;
+// the players are told why and the room disappears from every lobby. It runs in the room's
+// mailbox, after the commands already queued there.
+func (gmh *GameMessageHandler) ForceCloseRoom(ctx context.Context, roomID string, reason string) (closed *model.ClosedRoom, err error) {
+	<-gmh.hub.RunInRoom(roomID, func() {
+		closed, err = gmh.gameUseCase.ForceCloseRoom(ctx, roomID)
+		if err != nil {
+			return
+		}
+		msg := "The room was closed by an administrator."
+		if reason != "" {
+			msg = fmt.Sprintf("The room was closed by an administrator: %s.", reason)
+		}
+		if closed.Refunded {
+			msg += " Your stake was refunded."
+		}
+		gmh.announceClosedRoom(*closed, msg)
+	})
+	return closed, err
+}
+
+// KickPlayer closes every connection of the user and takes them out of their room the same way
+// a disconnect does. It returns whether the user was online and the room they were taken out of.
+func (gmh *GameMessageHandler) KickPlayer(ctx context.Context, userID string, reason string) (wasOnline bool, roomID string, err error) {
+	roomID, err = gmh.findPlayerRoom(ctx, userID)
+	if err != nil {
+		return false, "", err
+	}
+
+	notice, err := json.Marshal(gameservicews.OutboundMessage{Type: "kicked", Content: dto.KickedPayload{Reason: reason}})
+	if err != nil {
+		return false, "", fmt.Errorf("marshal kicked: %w", err)
+	}
+	wasOnline = gmh.hub.KickUser(userID, notice)
+	log.Printf("Handler: Kicked user %s (online: %t, room: %q, reason: %q)", userID, wasOnline, roomID, reason)
+
+	if roomID != "" {
+		<-gmh.hub.RunInRoom(roomID, func() { gmh.HandlePlayerDisconnect(userID, roomID) })
+	}
+	return wasOnline, roomID, nil
+}
+
+// findPlayerRoom looks the user's room up in Redis, since they may be connected to another instance.
+func (gmh *GameMessageHandler) findPlayerRoom(ctx context.Context, userID string) (string, error) {
+	return gmh.gameUseCase.FindPlayerRoom(ctx, userID)
+}
@@ -17,6 +17,9 @@ type contextKey string
 // UserIDKey ключ для хранения userID в контексте запроса.
 const UserIDKey contextKey = "userID"
 
+// adminRole — роль в access-токене, которой доступны служебные эндпоинты.
+const adminRole = "admin"
+
 // AuthJWTMiddleware проверяет JWT токен.
 // Если токен валиден, добавляет userID в контекст запроса.
 // Теперь он будет использовать предоставленный JWTManager.
@@ -88,3 +91,26 @@ func AuthJWTMiddleware(next http.Handler, jwtManager *security.JWTManager) http.
 		next.ServeHTTP(w, r.WithContext(ctxWithUser))
 	})
 }
+
+// AdminJWTMiddleware пропускает только запросы с валидным access-токеном роли admin
+// в заголовке Authorization.
+func AdminJWTMiddleware(next http.Handler, jwtManager *security.JWTManager) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeader := r.Header.Get("Authorization")
+		if len(authHeader) <= len("Bearer ") || !strings.HasPrefix(strings.ToLower(authHeader), "bearer ") {
+			http.Error(w, "Unauthorized: Missing token", http.StatusUnauthorized)
+			return
+		}
+		claims, err := jwtManager.Verify(authHeader[len("Bearer "):])
+		if err != nil {
+			http.Error(w, "Unauthorized: Invalid token", http.StatusUnauthorized)
+			return
+		}
+		if role, _ := claims["role"].(string); role != adminRole {
+			log.Printf("AdminJWTMiddleware: Denied %s %s for role %q", r.Method, r.URL.Path, role)
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
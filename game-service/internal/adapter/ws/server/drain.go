@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"game_svc/internal/adapter/ws/server/dto"
+	"game_svc/internal/model"
+
+	"github.com/gorilla/websocket"
+)
+
+// What happens to games still running when the drain deadline passes.
+const (
+	// DrainLeftoverResume keeps the rooms in Redis: the next instance restores them and
+	// players resume after reconnecting (see RestoreRooms).
+	DrainLeftoverResume = "resume"
+	// DrainLeftoverRefund cancels the games and refunds the stakes.
+	DrainLeftoverRefund = "refund"
+)
+
+// drainingCommands are refused while the instance drains: they would start something new here.
+var drainingCommands = map[string]bool{
+	"create_room":       true,
+	"join_room":         true,
+	"find_ranked_match": true,
+}
+
+// IsDraining reports whether the instance is draining before a shutdown.
+func (gmh *GameMessageHandler) IsDraining() bool {
+	return gmh.draining.Load()
+}
+
+// Drain stops new games on this instance, warns its players and waits up to timeout for the
+// games they are in to finish. Games still running then are left to resume on the next instance
+// or refunded, depending on leftover, and every connection is closed without counting as a forfeit.
+// Cancelling ctx cuts the wait short; the games still running are then left to resume.
+func (gmh *GameMessageHandler) Drain(ctx context.Context, timeout, pollInterval time.Duration, leftover string) {
+	if !gmh.draining.CompareAndSwap(false, true) {
+		return
+	}
+	deadline := time.Now().Add(timeout)
+	log.Printf("GameMessageHandler: Draining, waiting for running games until %s", deadline.Format(time.RFC3339))
+	gmh.broadcastLocal("server_draining", dto.ServerDrainingPayload{
+		Message:  "The server is restarting soon. Finish your game; new games can't be started here.",
+		Deadline: deadline.Unix(),
+	})
+
+	waitCtx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	running := gmh.localRunningRooms(waitCtx)
+	for len(running) > 0 {
+		select {
+		case <-waitCtx.Done():
+			if ctx.Err() != nil {
+				// The shutdown cut the wait short: no time for refunds, the next instance resumes the games
+				log.Printf("GameMessageHandler: Drain cut short with %d games running, they are left to resume", len(running))
+			} else {
+				log.Printf("GameMessageHandler: Drain deadline passed with %d games running", len(running))
+				gmh.handOverRooms(ctx, running, leftover)
+			}
+			gmh.hub.CloseAll(websocket.CloseServiceRestart, "server restarting")
+			return
+		case <-ticker.C:
+			running = gmh.localRunningRooms(waitCtx)
+		}
+	}
+	log.Println("GameMessageHandler: Drain finished, no games running")
+	gmh.hub.CloseAll(websocket.CloseServiceRestart, "server restarting")
+}
+
+// localRunningRooms returns the games in progress that have players connected to this instance.
+func (gmh *GameMessageHandler) localRunningRooms(ctx context.Context) []*model.Room {
+	var running []*model.Room
+	for _, roomID := range gmh.hub.LocalRoomIDs() {
+		room, err := gmh.gameUseCase.GetRoom(ctx, roomID)
+		if err != nil {
+			continue
+		}
+		if room.Status == "in_progress" {
+			running = append(running, room)
+		}
+	}
+	return running
+}
+
+func (gmh *GameMessageHandler) handOverRooms(ctx context.Context, rooms []*model.Room, leftover string) {
+	for _, room := range rooms {
+		if leftover != DrainLeftoverRefund {
+			log.Printf("GameMessageHandler: Room %s left to resume on the next instance", room.ID)
+			continue
+		}
+		<-gmh.hub.RunInRoom(room.ID, func() {
+			closed, err := gmh.gameUseCase.CloseAbandonedGame(ctx, room.ID, dto.GetPlayerIDsFromModels(room.Players))
+			if err != nil {
+				log.Printf("GameMessageHandler: Failed to refund room %s while draining: %v", room.ID, err)
+				return
+			}
+			if closed != nil {
+				gmh.announceClosedRoom(*closed, "The server is restarting; your game was cancelled and your stake refunded.")
+			}
+		})
+	}
+}
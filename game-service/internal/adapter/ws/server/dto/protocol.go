@@ -0,0 +1,114 @@
+package dto
+
+//go:generate go run ../../../../../cmd/wsschema -out ../../../../../api/ws/protocol.schema.json
+
+// Коды ошибок в сообщении "error": поле code в протоколе версии 1, error_type в прежнем формате.
+const (
+	ErrCodeInvalidMessageFormat   = "invalid_message_format"
+	ErrCodeUnsupportedVersion     = "unsupported_version"
+	ErrCodeAuthenticationRequired = "authentication_required"
+	ErrCodeReadOnlySession        = "read_only_session"
+	ErrCodeUnknownMessageType     = "unknown_message_type"
+	ErrCodeInvalidPayload         = "invalid_payload"
+	ErrCodeInvalidBet             = "invalid_bet"
+	ErrCodeNotInRoom              = "not_in_room"
+	ErrCodeNotYourTurn            = "not_your_turn"
+	ErrCodeRoomStateConflict      = "room_state_conflict"
+	ErrCodePlayRestricted         = "play_restricted"
+	ErrCodeCreateRoomFailed       = "create_room_failed"
+	ErrCodeJoinRoomFailed         = "join_room_failed"
+	ErrCodeLeaveRoomFailed        = "leave_room_failed"
+	ErrCodeSetReadyFailed         = "set_ready_failed"
+	ErrCodeHitFailed              = "hit_failed"
+	ErrCodeStandFailed            = "stand_failed"
+	ErrCodeRankedSearchFailed     = "ranked_search_failed"
+	ErrCodeMatchFailed            = "match_failed"
+	ErrCodeServerDraining         = "server_draining"
+	ErrCodeRateLimited            = "rate_limited"
+	ErrCodeTooManyInFlight        = "too_many_in_flight"
+	ErrCodeInternal               = "internal_error"
+)
+
+// ErrorCodes — все коды ошибок, для схемы протокола.
+var ErrorCodes = []string{
+	ErrCodeInvalidMessageFormat, ErrCodeUnsupportedVersion, ErrCodeAuthenticationRequired,
+	ErrCodeReadOnlySession, ErrCodeUnknownMessageType, ErrCodeInvalidPayload, ErrCodeInvalidBet,
+	ErrCodeNotInRoom, ErrCodeNotYourTurn, ErrCodeRoomStateConflict, ErrCodePlayRestricted,
+	ErrCodeCreateRoomFailed, ErrCodeJoinRoomFailed, ErrCodeLeaveRoomFailed, ErrCodeSetReadyFailed,
+	ErrCodeHitFailed, ErrCodeStandFailed, ErrCodeRankedSearchFailed, ErrCodeMatchFailed, ErrCodeServerDraining, ErrCodeRateLimited, ErrCodeTooManyInFlight,
+	ErrCodeInternal,
+}
+
+// HelloPayload — первое сообщение сервера клиенту версии 1: согласованная и поддерживаемые версии.
+type HelloPayload struct {
+	Version           int   `json:"version"`
+	SupportedVersions []int `json:"supported_versions"`
+}
+
+// AckPayload подтверждает выполнение команды. Result заполняется, если команде есть что вернуть.
+type AckPayload struct {
+	Command string      `json:"command"`
+	Result  interface{} `json:"result,omitempty"`
+}
+
+// ErrorPayload — ошибка выполнения команды в протоколе версии 1.
+type ErrorPayload struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// RoomRefResult — результат команд create_room и join_room.
+type RoomRefResult struct {
+	RoomID string `json:"room_id"`
+}
+
+// Commands — команды клиента и их payload, для схемы протокола.
+var Commands = map[string]interface{}{
+	"create_room":       CreateRoomPayload{},
+	"join_room":         JoinRoomPayload{},
+	"leave_room":        LeaveRoomPayload{},
+	"ready":             ReadyPayload{},
+	"hit":               HitPayload{},
+	"stand":             StandPayload{},
+	"find_ranked_match": FindRankedMatchPayload{},
+}
+
+// Replies — ответы сервера на команды, для схемы протокола.
+var Replies = map[string]interface{}{
+	"hello": HelloPayload{},
+	"ack":   AckPayload{},
+	"error": ErrorPayload{},
+}
+
+// Events — события сервера и их payload, для схемы протокола. nil — форма payload не зафиксирована.
+var Events = map[string]interface{}{
+	"room_created":           "",
+	"update_list":            RoomListUpdateDTO{},
+	"room_joined":            []string{},
+	"game_waiting":           nil,
+	"left_room_successfully": "",
+	"room_left":              PlayerLeftNotificationDTO{},
+	"player_left":            PlayerLeftNotificationDTO{},
+	"player_ready":           nil,
+	"game_started":           nil,
+	"game_state_update":      GameStateUpdate{},
+	"hit":                    HitBroadcastPayloadDTO{},
+	"busted":                 BustedBroadcastPayloadDTO{},
+	"stand":                  nil,
+	"turn":                   TurnBroadcastPayloadDTO{},
+	"game_end":               GameEndBroadcastPayloadDTO{},
+	"warning":                nil,
+	"ranked_search_started":  "",
+	"match_found":            nil,
+	"session_reminder":       SessionReminderPayload{},
+	"session_replaced":       SessionStatePayload{},
+	"session_activated":      SessionStatePayload{},
+	"game_resumed":           GameStateUpdate{},
+	"player_reconnected":     nil,
+	"room_closed":            nil,
+	"server_draining":        ServerDrainingPayload{},
+	"kicked":                 KickedPayload{},
+	"tournament_match":       TournamentMatchPayload{},
+	"tournament_finished":    TournamentFinishedPayload{},
+	"tournament_cancelled":   TournamentCancelledPayload{},
+}
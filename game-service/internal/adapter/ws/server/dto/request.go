@@ -5,9 +5,12 @@ import (
 	"fmt"
 )
 
+// GameMessage — входящая команда. V и RequestID присылают только клиенты протокола версии 1.
 type GameMessage struct {
-	Type    string      `json:"type"`
-	Payload interface{} `json:"payload"`
+	V         int             `json:"v"`
+	Type      string          `json:"type"`
+	RequestID string          `json:"request_id"`
+	Payload   json.RawMessage `json:"payload"`
 }
 
 type CreateRoomPayload struct {
@@ -28,13 +31,15 @@ type HitPayload struct{}
 
 type StandPayload struct{}
 
-func MapToStruct(data interface{}, result interface{}) error {
-	jsonData, err := json.Marshal(data)
-	if err != nil {
-		return fmt.Errorf("failed to marshal data for struct conversion: %w", err)
+type FindRankedMatchPayload struct{}
+
+// DecodePayload разбирает payload команды. Пустой payload допустим для команд без параметров.
+func DecodePayload(payload json.RawMessage, result interface{}) error {
+	if len(payload) == 0 {
+		return nil
 	}
-	if err := json.Unmarshal(jsonData, result); err != nil {
-		return fmt.Errorf("failed to unmarshal data into struct: %w", err)
+	if err := json.Unmarshal(payload, result); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
 	}
 	return nil
 }
@@ -13,6 +13,54 @@ type RoomCreatedResponse struct {
 	Message string `json:"message,omitempty"`
 }
 
+// SessionReminderPayload напоминает игроку, сколько минут он уже играет.
+type SessionReminderPayload struct {
+	MinutesPlayed int `json:"minutes_played"`
+}
+
+// SessionStatePayload сообщает соединению, может ли оно действовать в игре:
+// для "session_replaced" и "session_activated".
+type SessionStatePayload struct {
+	ReadOnly bool   `json:"read_only"`
+	Message  string `json:"message"`
+}
+
+// ServerDrainingPayload предупреждает, что сервер скоро перезапустится: Deadline — unix-время,
+// после которого незавершённые игры будут переданы дальше.
+type ServerDrainingPayload struct {
+	Message  string `json:"message"`
+	Deadline int64  `json:"deadline"`
+}
+
+// KickedPayload приходит соединению игрока, которого отключил администратор, перед закрытием с кодом 4002.
+type KickedPayload struct {
+	Reason string `json:"reason"`
+}
+
+// TournamentMatchPayload сообщает игрокам, что для их турнирного матча создана комната.
+// Deadline — unix-время, до которого партию нужно начать, иначе засчитывается неявка.
+type TournamentMatchPayload struct {
+	TournamentID string   `json:"tournamentID"`
+	Round        int      `json:"round"`
+	RoomID       string   `json:"roomID"`
+	Players      []string `json:"players"`
+	Deadline     int64    `json:"deadline"`
+}
+
+// TournamentFinishedPayload — итог турнира для одного участника.
+type TournamentFinishedPayload struct {
+	TournamentID string `json:"tournamentID"`
+	Name         string `json:"name"`
+	Place        int    `json:"place"`
+	Prize        int64  `json:"prize"`
+}
+
+// TournamentCancelledPayload — турнир не набрал участников, бай-ин возвращён.
+type TournamentCancelledPayload struct {
+	TournamentID string `json:"tournamentID"`
+	Name         string `json:"name"`
+}
+
 type PlayerLeftNotificationDTO struct {
 	RoomID  string   `json:"roomID"`
 	Players []string `json:"players"`
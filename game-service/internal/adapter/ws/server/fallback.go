@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"game_svc/internal/model"
+	gameservicews "game_svc/pkg/ws"
+)
+
+// OpenStream serves the events of a player whose network blocks WebSockets. The stream is a hub
+// client like any connection: it gets the same room broadcasts, takes part in session policy and
+// connection limits, and closing it counts as a disconnect. It blocks until ctx is done or the hub closes it.
+func (gmh *GameMessageHandler) OpenStream(ctx context.Context, userID, remoteIP string, send func(message []byte) error) error {
+	if gmh.IsDraining() {
+		return model.ErrServerDraining
+	}
+	if !gmh.hub.AcquireConnection(userID, remoteIP) {
+		log.Printf("OpenStream: Too many connections for UserID %s or IP %s", userID, remoteIP)
+		return model.ErrTooManyConnections
+	}
+
+	client := gameservicews.NewStreamClient(gmh.hub, userID, remoteIP, gameservicews.JSONCodec{})
+	log.Printf("Stream client connected: UserID %s, RemoteAddr: %s", client.UserID, client.RemoteAddr())
+	gmh.Greet(client)
+	gmh.ReattachClient(client)
+	gmh.hub.Register <- client
+
+	go gmh.RunSessionReminders(client)
+	client.StreamPump(ctx, send)
+	return nil
+}
+
+// ExecuteCommand runs a command that came without a connection (REST through api-gateway).
+// It goes through the command limits of the user, the room's queue and the same handlers as a
+// WebSocket command; the ack or error (rate_limited included) is returned as a protocol v1 envelope,
+// everything else reaches the player's stream or socket.
+func (gmh *GameMessageHandler) ExecuteCommand(ctx context.Context, userID, msgType, requestID string, payload json.RawMessage) ([]byte, error) {
+	roomID, ok := gmh.hub.LocalUserRoom(userID)
+	if !ok {
+		var err error
+		if roomID, err = gmh.findPlayerRoom(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	envelope, err := json.Marshal(gameservicews.Envelope{V: gameservicews.ProtocolV1, Type: msgType, RequestID: requestID, Payload: payload})
+	if err != nil {
+		return nil, fmt.Errorf("marshal command: %w", err)
+	}
+	client := gameservicews.NewCommandClient(gmh.hub, userID, roomID, gameservicews.JSONCodec{})
+	reply := make(chan []byte, 1)
+	gmh.hub.Submit(&gameservicews.RawMessage{Client: client, Payload: envelope, Reply: reply})
+
+	select {
+	case response := <-reply:
+		return client.Encode(response)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -1,21 +1,28 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"game_svc/internal/model"
 	"log"
+	"sync/atomic"
+	"time"
 
 	"game_svc/internal/adapter/ws/server/dto"
 	gameservicews "game_svc/pkg/ws"
 )
 
 type GameMessageHandler struct {
-	roomUseCase   RoomUseCase
-	gameUseCase   GameUseCase
-	rankedUseCase RankedUseCase
-	hub           *gameservicews.Hub
+	roomUseCase       RoomUseCase
+	gameUseCase       GameUseCase
+	rankedUseCase     RankedUseCase
+	sessionUseCase    SessionUseCase
+	tournamentUseCase TournamentUseCase
+	hub               *gameservicews.Hub
+	resume            *resumeIndex
+	draining          atomic.Bool
 }
 
 func NewGameMessageHandler(
@@ -23,73 +30,115 @@ func NewGameMessageHandler(
 	roomUC RoomUseCase,
 	gameUC GameUseCase,
 	rankedUC RankedUseCase,
+	sessionUC SessionUseCase,
+	tournamentUC TournamentUseCase,
 ) *GameMessageHandler {
-	if hub == nil || roomUC == nil || gameUC == nil || rankedUC == nil {
+	if hub == nil || roomUC == nil || gameUC == nil || rankedUC == nil || sessionUC == nil || tournamentUC == nil {
 		log.Fatal("GameMessageHandler: Cannot create with nil dependencies")
 	}
 	return &GameMessageHandler{
-		hub:           hub,
-		roomUseCase:   roomUC,
-		gameUseCase:   gameUC,
-		rankedUseCase: rankedUC,
+		hub:               hub,
+		roomUseCase:       roomUC,
+		gameUseCase:       gameUC,
+		rankedUseCase:     rankedUC,
+		sessionUseCase:    sessionUC,
+		tournamentUseCase: tournamentUC,
+		resume:            newResumeIndex(),
 	}
 }
 
 func (gmh *GameMessageHandler) Handle(rawMsg *gameservicews.RawMessage) {
 	client := rawMsg.Client
 	log.Printf("GameMessageHandler: Received message from client %s (UserID: %s, RoomID: %s), Payload: %s",
-		client.Conn.RemoteAddr(), client.UserID, client.RoomID, string(rawMsg.Payload))
+		client.RemoteAddr(), client.UserID, client.RoomID(), string(rawMsg.Payload))
 
 	var msg dto.GameMessage
 	if err := json.Unmarshal(rawMsg.Payload, &msg); err != nil {
 		log.Printf("GameMessageHandler: Error unmarshalling message from client %s: %v", client.UserID, err)
-		gmh.sendErrorToClient(client, "invalid_message_format", "Could not parse message.")
+		gmh.replyError(&command{client: client, reply: rawMsg.Reply}, dto.ErrCodeInvalidMessageFormat, "Could not parse message.")
+		return
+	}
+	cmd := &command{client: client, msgType: msg.Type, requestID: msg.RequestID, reply: rawMsg.Reply}
+
+	if msg.V != client.ProtocolVersion {
+		gmh.replyError(cmd, dto.ErrCodeUnsupportedVersion,
+			fmt.Sprintf("This connection negotiated protocol v%d, the message is v%d.", client.ProtocolVersion, msg.V))
 		return
 	}
 
 	if client.UserID == "" {
-		log.Printf("GameMessageHandler: Denying message from unauthenticated client %s", client.Conn.RemoteAddr())
-		gmh.sendErrorToClient(client, "authentication_required", "User ID is missing.")
+		log.Printf("GameMessageHandler: Denying message from unauthenticated client %s", client.RemoteAddr())
+		gmh.replyError(cmd, dto.ErrCodeAuthenticationRequired, "User ID is missing.")
+		return
+	}
+
+	if drainingCommands[msg.Type] && gmh.IsDraining() {
+		gmh.replyError(cmd, dto.ErrCodeServerDraining, "The server is restarting; start a new game after reconnecting.")
+		return
+	}
+
+	if client.IsReadOnly() {
+		gmh.replyError(cmd, dto.ErrCodeReadOnlySession, "This connection is view-only; another connection holds your seat.")
 		return
 	}
 
 	var err error
 	switch msg.Type {
 	case "create_room":
-		err = gmh.handleCreateRoom(client, msg.Payload)
+		err = gmh.handleCreateRoom(cmd, msg.Payload)
 	case "join_room":
-		err = gmh.handleJoinRoom(client, msg.Payload)
+		err = gmh.handleJoinRoom(cmd, msg.Payload)
 	case "leave_room":
-		err = gmh.handleLeaveRoom(client)
+		err = gmh.handleLeaveRoom(cmd)
 	case "ready":
-		err = gmh.handleReady(client, msg.Payload)
+		err = gmh.handleReady(cmd, msg.Payload)
 	case "hit":
-		err = gmh.handleHit(client)
+		err = gmh.handleHit(cmd)
 	case "stand":
-		err = gmh.handleStand(client)
+		err = gmh.handleStand(cmd)
 	case "find_ranked_match":
-		err = gmh.handleFindRankedMatch(client)
+		err = gmh.handleFindRankedMatch(cmd)
 	default:
 		log.Printf("GameMessageHandler: Unknown message type '%s' from client %s", msg.Type, client.UserID)
-		gmh.sendErrorToClient(client, "unknown_message_type", fmt.Sprintf("Unknown message type: %s", msg.Type))
+		gmh.replyError(cmd, dto.ErrCodeUnknownMessageType, fmt.Sprintf("Unknown message type: %s", msg.Type))
 		return
 	}
 
 	if err != nil {
 		log.Printf("GameMessageHandler: Error handling message type '%s' for client %s: %v", msg.Type, client.UserID, err)
+		if !cmd.replied {
+			gmh.replyError(cmd, dto.ErrCodeInternal, "Failed to process the request.")
+		}
+		return
+	}
+	gmh.ack(cmd)
+}
+
+// MailboxKey queues join_room behind the other commands of the room being joined;
+// every other command is queued by the client's current room.
+func (gmh *GameMessageHandler) MailboxKey(rawMsg *gameservicews.RawMessage) string {
+	var msg dto.GameMessage
+	if err := json.Unmarshal(rawMsg.Payload, &msg); err != nil || msg.Type != "join_room" {
+		return ""
 	}
+	var req dto.JoinRoomPayload
+	if err := dto.DecodePayload(msg.Payload, &req); err != nil {
+		return ""
+	}
+	return req.RoomID
 }
 
-func (gmh *GameMessageHandler) handleCreateRoom(client *gameservicews.Client, payload interface{}) error {
+func (gmh *GameMessageHandler) handleCreateRoom(cmd *command, payload json.RawMessage) error {
+	client := cmd.client
 	var req dto.CreateRoomPayload
-	if err := dto.MapToStruct(payload, &req); err != nil {
-		gmh.sendErrorToClient(client, "invalid_payload", "Could not parse create_room payload.")
+	if err := dto.DecodePayload(payload, &req); err != nil {
+		gmh.replyError(cmd, dto.ErrCodeInvalidPayload, "Could not parse create_room payload.")
 		return fmt.Errorf("parsing create_room payload: %w", err)
 	}
 
 	if req.Bet <= 0 {
 		err := errors.New("bet must be a positive value")
-		gmh.sendErrorToClient(client, "invalid_bet", err.Error())
+		gmh.replyError(cmd, dto.ErrCodeInvalidBet, err.Error())
 		return err
 	}
 
@@ -97,12 +146,13 @@ func (gmh *GameMessageHandler) handleCreateRoom(client *gameservicews.Client, pa
 
 	ucResponse, err := gmh.roomUseCase.CreateRoom(*ucParams)
 	if err != nil {
-		gmh.sendErrorToClient(client, "create_room_failed", err.Error())
+		gmh.replyError(cmd, dto.ErrCodeCreateRoomFailed, err.Error())
 		return err
 	}
 
 	// После успешного создания комнаты в use case, присваиваем RoomID клиенту
-	client.RoomID = ucResponse.ID
+	gmh.hub.SetClientRoom(client, ucResponse.ID)
+	cmd.result = dto.RoomRefResult{RoomID: ucResponse.ID}
 
 	notification := dto.FromModelToListResponse(ucResponse)
 
@@ -116,15 +166,16 @@ func (gmh *GameMessageHandler) handleCreateRoom(client *gameservicews.Client, pa
 	return nil
 }
 
-func (gmh *GameMessageHandler) handleJoinRoom(client *gameservicews.Client, payload interface{}) error {
+func (gmh *GameMessageHandler) handleJoinRoom(cmd *command, payload json.RawMessage) error {
+	client := cmd.client
 	var req dto.JoinRoomPayload
-	if err := dto.MapToStruct(payload, &req); err != nil {
-		gmh.sendErrorToClient(client, "invalid_payload", "Could not parse join_room payload.")
+	if err := dto.DecodePayload(payload, &req); err != nil {
+		gmh.replyError(cmd, dto.ErrCodeInvalidPayload, "Could not parse join_room payload.")
 		return fmt.Errorf("parsing join_room payload: %w", err)
 	}
 	if req.RoomID == "" {
 		err := errors.New("room_id cannot be empty")
-		gmh.sendErrorToClient(client, "invalid_payload", err.Error())
+		gmh.replyError(cmd, dto.ErrCodeInvalidPayload, err.Error())
 		return err
 	}
 
@@ -132,10 +183,11 @@ func (gmh *GameMessageHandler) handleJoinRoom(client *gameservicews.Client, payl
 
 	ucResponse, err := gmh.roomUseCase.JoinRoom(*ucParams)
 	if err != nil {
-		gmh.sendErrorToClient(client, "join_room_failed", err.Error())
+		gmh.replyError(cmd, roomErrorType(err, dto.ErrCodeJoinRoomFailed), err.Error())
 		return err
 	}
-	client.RoomID = req.RoomID
+	gmh.hub.SetClientRoom(client, req.RoomID)
+	cmd.result = dto.RoomRefResult{RoomID: req.RoomID}
 
 	notification := dto.FromModelToListResponse(ucResponse)
 
@@ -147,13 +199,14 @@ func (gmh *GameMessageHandler) handleJoinRoom(client *gameservicews.Client, payl
 	return nil
 }
 
-func (gmh *GameMessageHandler) handleLeaveRoom(client *gameservicews.Client) error {
-	if client.RoomID == "" {
-		gmh.sendErrorToClient(client, "not_in_room", "You are not currently in a room.")
+func (gmh *GameMessageHandler) handleLeaveRoom(cmd *command) error {
+	client := cmd.client
+	if client.RoomID() == "" {
+		gmh.replyError(cmd, dto.ErrCodeNotInRoom, "You are not currently in a room.")
 		return nil
 	}
 
-	roomIDToLeave := client.RoomID
+	roomIDToLeave := client.RoomID()
 	userID := client.UserID
 	log.Printf("Handler: User %s attempting to leave room %s", userID, roomIDToLeave)
 
@@ -161,16 +214,28 @@ func (gmh *GameMessageHandler) handleLeaveRoom(client *gameservicews.Client) err
 
 	updatedRoomModel, wasRoomDeleted, err := gmh.roomUseCase.LeaveRoom(*ucParams)
 
+	// Игрок ушёл посреди партии: засчитываем ему поражение так же, как при отключении.
+	// Мы уже в очереди комнаты, поэтому обработчик отключения вызывается напрямую.
+	if errors.Is(err, model.ErrGameInProgress) {
+		gmh.HandlePlayerDisconnect(userID, roomIDToLeave)
+		if client.RoomID() == roomIDToLeave {
+			gmh.hub.SetClientRoom(client, "")
+		}
+		gmh.sendToClient(client, "left_room_successfully", "you have left the room and forfeited the game")
+		log.Printf("Handler: User %s forfeited the game in room %s by leaving", userID, roomIDToLeave)
+		return nil
+	}
+
 	if err != nil {
-		gmh.sendErrorToClient(client, "leave_room_failed", err.Error())
-		if client.RoomID == roomIDToLeave {
-			client.RoomID = ""
+		gmh.replyError(cmd, roomErrorType(err, dto.ErrCodeLeaveRoomFailed), err.Error())
+		if client.RoomID() == roomIDToLeave {
+			gmh.hub.SetClientRoom(client, "")
 		}
 		return err
 	}
 
-	if client.RoomID == roomIDToLeave {
-		client.RoomID = ""
+	if client.RoomID() == roomIDToLeave {
+		gmh.hub.SetClientRoom(client, "")
 	}
 
 	// 1. Оповещаем самого клиента, что он успешно вышел
@@ -193,33 +258,34 @@ func (gmh *GameMessageHandler) handleLeaveRoom(client *gameservicews.Client) err
 	return nil
 }
 
-func (gmh *GameMessageHandler) handleReady(client *gameservicews.Client, payload interface{}) error {
-	if client.RoomID == "" {
-		gmh.sendErrorToClient(client, "not_in_room", "You must be in a room to set ready status.")
+func (gmh *GameMessageHandler) handleReady(cmd *command, payload json.RawMessage) error {
+	client := cmd.client
+	if client.RoomID() == "" {
+		gmh.replyError(cmd, dto.ErrCodeNotInRoom, "You must be in a room to set ready status.")
 		return errors.New("client not in a room for ready")
 	}
 
 	var reqPayload dto.ReadyPayload
-	if err := dto.MapToStruct(payload, &reqPayload); err != nil {
-		gmh.sendErrorToClient(client, "invalid_payload", "Could not parse ready payload.")
+	if err := dto.DecodePayload(payload, &reqPayload); err != nil {
+		gmh.replyError(cmd, dto.ErrCodeInvalidPayload, "Could not parse ready payload.")
 		return fmt.Errorf("parsing ready payload: %w", err)
 	}
 
 	ucParams := model.PlayerReadyParams{
 		UserID:  client.UserID,
-		RoomID:  client.RoomID,
+		RoomID:  client.RoomID(),
 		IsReady: reqPayload.IsReady,
 	}
 
 	ucResult, err := gmh.gameUseCase.PlayerReady(ucParams)
 	if err != nil {
-		gmh.sendErrorToClient(client, "set_ready_failed", err.Error())
+		gmh.replyError(cmd, roomErrorType(err, dto.ErrCodeSetReadyFailed), err.Error())
 		return err
 	}
 
 	if ucResult.UpdatedRoom == nil {
-		log.Printf("Handler handleReady: Received nil UpdatedRoom from use case for room %s without error.", client.RoomID)
-		gmh.sendErrorToClient(client, "internal_error", "Failed to process ready status.")
+		log.Printf("Handler handleReady: Received nil UpdatedRoom from use case for room %s without error.", client.RoomID())
+		gmh.replyError(cmd, dto.ErrCodeInternal, "Failed to process ready status.")
 		return errors.New("use case returned nil room without error on ready")
 	}
 
@@ -236,7 +302,7 @@ func (gmh *GameMessageHandler) handleReady(client *gameservicews.Client, payload
 		gameStartDTO := dto.FromRoomModelToGameStateUpdate(ucResult.UpdatedRoom, "Game started! Initial cards dealt.")
 		if gameStartDTO == nil {
 			log.Printf("Handler handleReady: Failed to map room model to game start DTO for room %s", ucResult.UpdatedRoom.ID)
-			gmh.sendErrorToClient(client, "internal_error", "Failed to prepare game start message.")
+			gmh.replyError(cmd, dto.ErrCodeInternal, "Failed to prepare game start message.")
 			return errors.New("failed to map room model to game start DTO")
 		}
 		gmh.broadcastToRoom(ucResult.UpdatedRoom.ID, "game_started", gameStartDTO.State)
@@ -254,27 +320,28 @@ func (gmh *GameMessageHandler) handleReady(client *gameservicews.Client, payload
 	return nil
 }
 
-func (gmh *GameMessageHandler) handleHit(client *gameservicews.Client) error {
-	if client.RoomID == "" {
-		gmh.sendErrorToClient(client, "not_in_room", "You must be in a room to hit.")
+func (gmh *GameMessageHandler) handleHit(cmd *command) error {
+	client := cmd.client
+	if client.RoomID() == "" {
+		gmh.replyError(cmd, dto.ErrCodeNotInRoom, "You must be in a room to hit.")
 		return errors.New("client not in a room for hit")
 	}
 
-	ucParams := model.HitParams{UserID: client.UserID, RoomID: client.RoomID}
+	ucParams := model.HitParams{UserID: client.UserID, RoomID: client.RoomID()}
 	ucResult, err := gmh.gameUseCase.Hit(ucParams)
 
 	if err != nil {
 		if err.Error() == "not your turn" {
-			gmh.sendToClient(client, "warning", map[string]interface{}{"roomID": client.RoomID, "msg": "Not your turn"})
+			gmh.replyNotYourTurn(cmd)
 		} else {
-			gmh.sendErrorToClient(client, "hit_failed", err.Error())
+			gmh.replyError(cmd, roomErrorType(err, dto.ErrCodeHitFailed), err.Error())
 		}
 		return err
 	}
 
 	if ucResult == nil {
-		log.Printf("Handler handleHit: Received nil HitResult from use case for room %s without error.", client.RoomID)
-		gmh.sendErrorToClient(client, "internal_error", "Failed to process hit.")
+		log.Printf("Handler handleHit: Received nil HitResult from use case for room %s without error.", client.RoomID())
+		gmh.replyError(cmd, dto.ErrCodeInternal, "Failed to process hit.")
 		return errors.New("use case returned nil result without error on hit")
 	}
 
@@ -295,27 +362,7 @@ func (gmh *GameMessageHandler) handleHit(client *gameservicews.Client) error {
 
 	// 3. Если игра завершилась (например, из-за bust)
 	if ucResult.GameEnded {
-		// Формируем руки для ответа (map[string][]string)
-		finalHandsStr := make(map[string][]string)
-		if ucResult.FinalHands != nil {
-			for playerID, hand := range ucResult.FinalHands {
-				handS := make([]string, len(hand))
-				for i, card := range hand {
-					handS[i] = cardToString(card)
-				}
-				finalHandsStr[playerID] = handS
-			}
-		}
-
-		gmh.broadcastToRoom(ucResult.RoomID, "game_end", map[string]interface{}{
-			"roomID": ucResult.RoomID,
-			"winner": ucResult.Winner,      // Будет ID оппонента или "0"
-			"scores": ucResult.FinalScores, // map[string]int
-			"hands":  finalHandsStr,        // map[string][]string
-		})
-		gmh.broadcastToRoom(ucResult.RoomID, "game_waiting", map[string]interface{}{
-			"msg": "Both players need to press 'Ready' to start the next round.",
-		})
+		gmh.broadcastGameEnd(ucResult)
 		log.Printf("Handler: Game ended in room %s after HIT by %s. Winner: %s", ucResult.RoomID, ucResult.PlayerID, ucResult.Winner)
 	} else if !ucResult.IsBusted { // Если не bust и игра не закончилась, передаем ход
 		gmh.broadcastToRoom(ucResult.RoomID, "turn", map[string]interface{}{
@@ -326,30 +373,54 @@ func (gmh *GameMessageHandler) handleHit(client *gameservicews.Client) error {
 	return nil
 }
 
+// broadcastGameEnd объявляет итог партии и приглашает игроков к следующему раунду.
+func (gmh *GameMessageHandler) broadcastGameEnd(ucResult *model.Result) {
+	// Формируем руки для ответа (map[string][]string)
+	finalHandsStr := make(map[string][]string)
+	for playerID, hand := range ucResult.FinalHands {
+		handS := make([]string, len(hand))
+		for i, card := range hand {
+			handS[i] = cardToString(card)
+		}
+		finalHandsStr[playerID] = handS
+	}
+
+	gmh.broadcastToRoom(ucResult.RoomID, "game_end", map[string]interface{}{
+		"roomID": ucResult.RoomID,
+		"winner": ucResult.Winner,      // ID победителя или "0" при ничьей
+		"scores": ucResult.FinalScores, // map[string]int
+		"hands":  finalHandsStr,        // map[string][]string
+	})
+	gmh.broadcastToRoom(ucResult.RoomID, "game_waiting", map[string]interface{}{
+		"msg": "Both players need to press 'Ready' to start the next round.",
+	})
+}
+
 func cardToString(card model.Card) string {
 	return card.Value + card.Suit
 }
 
-func (gmh *GameMessageHandler) handleStand(client *gameservicews.Client) error {
-	if client.RoomID == "" {
-		gmh.sendErrorToClient(client, "not_in_room", "You are not currently in a room to stand.")
+func (gmh *GameMessageHandler) handleStand(cmd *command) error {
+	client := cmd.client
+	if client.RoomID() == "" {
+		gmh.replyError(cmd, dto.ErrCodeNotInRoom, "You are not currently in a room to stand.")
 		return errors.New("client not in a room for stand")
 	}
 
-	ucParams := model.StandParams{UserID: client.UserID, RoomID: client.RoomID}
+	ucParams := model.StandParams{UserID: client.UserID, RoomID: client.RoomID()}
 	ucResult, err := gmh.gameUseCase.Stand(ucParams) // ucResult это *usecase.StandResult
 
 	if err != nil {
 		if err.Error() == "not your turn" {
-			gmh.sendToClient(client, "warning", map[string]interface{}{"roomID": client.RoomID, "msg": "Not your turn"})
+			gmh.replyNotYourTurn(cmd)
 		} else {
-			gmh.sendErrorToClient(client, "stand_failed", err.Error())
+			gmh.replyError(cmd, roomErrorType(err, dto.ErrCodeStandFailed), err.Error())
 		}
 		return err
 	}
 	if ucResult == nil {
-		log.Printf("Handler handleStand: Received nil StandResult from use case for room %s without error.", client.RoomID)
-		gmh.sendErrorToClient(client, "internal_error", "Failed to process stand.")
+		log.Printf("Handler handleStand: Received nil StandResult from use case for room %s without error.", client.RoomID())
+		gmh.replyError(cmd, dto.ErrCodeInternal, "Failed to process stand.")
 		return errors.New("use case returned nil result without error on stand")
 	}
 
@@ -360,26 +431,7 @@ func (gmh *GameMessageHandler) handleStand(client *gameservicews.Client) error {
 
 	// 2. Если игра завершилась (например, оба "stand")
 	if ucResult.GameEnded {
-		finalHandsStr := make(map[string][]string)
-		if ucResult.FinalHands != nil {
-			for playerID, hand := range ucResult.FinalHands {
-				handS := make([]string, len(hand))
-				for i, card := range hand {
-					handS[i] = cardToString(card)
-				}
-				finalHandsStr[playerID] = handS
-			}
-		}
-
-		gmh.broadcastToRoom(ucResult.RoomID, "game_end", map[string]interface{}{
-			"roomID": ucResult.RoomID,
-			"winner": ucResult.Winner,
-			"scores": ucResult.FinalScores,
-			"hands":  finalHandsStr,
-		})
-		gmh.broadcastToRoom(ucResult.RoomID, "game_waiting", map[string]interface{}{
-			"msg": "Both players need to press 'Ready' to start the next round.",
-		})
+		gmh.broadcastGameEnd(ucResult)
 		log.Printf("Handler: Game ended in room %s after STAND by %s. Winner: %s", ucResult.RoomID, ucResult.PlayerID, ucResult.Winner)
 	} else {
 		gmh.broadcastToRoom(ucResult.RoomID, "turn", map[string]interface{}{
@@ -390,12 +442,13 @@ func (gmh *GameMessageHandler) handleStand(client *gameservicews.Client) error {
 	return nil
 }
 
-func (gmh *GameMessageHandler) sendErrorToClient(client *gameservicews.Client, errorType string, message string) {
-	errorResp := dto.ErrorResponse{
-		ErrorType: errorType,
-		Message:   message,
+// roomErrorType отдаёт клиенту room_state_conflict, если действие отклонено из-за
+// параллельного изменения комнаты: клиенту достаточно дождаться нового состояния и повторить.
+func roomErrorType(err error, fallback string) string {
+	if errors.Is(err, model.ErrRoomStateConflict) {
+		return dto.ErrCodeRoomStateConflict
 	}
-	gmh.sendToClient(client, "error", errorResp)
+	return fallback
 }
 
 func (gmh *GameMessageHandler) sendToClient(client *gameservicews.Client, messageType string, content interface{}) {
@@ -428,6 +481,16 @@ func (gmh *GameMessageHandler) broadcastToRoom(roomID string, messageType string
 	gmh.hub.BroadcastToRoom(roomID, jsonResponse)
 }
 
+// broadcastLocal sends a message to the clients of this instance only.
+func (gmh *GameMessageHandler) broadcastLocal(messageType string, content interface{}) {
+	response, err := json.Marshal(gameservicews.OutboundMessage{Type: messageType, Content: content})
+	if err != nil {
+		log.Printf("GameMessageHandler: Error marshalling message for local broadcast (type: %s): %v", messageType, err)
+		return
+	}
+	gmh.hub.BroadcastToLocal(response)
+}
+
 func (gmh *GameMessageHandler) broadcastAll(messageType string, content interface{}) {
 	response := gameservicews.OutboundMessage{
 		Type:    messageType,
@@ -442,7 +505,8 @@ func (gmh *GameMessageHandler) broadcastAll(messageType string, content interfac
 }
 
 // HandlePlayerDisconnect обрабатывает логику, когда игрок неожиданно отключается.
-// Этот метод вызывается из app.go через коллбэк OnDisconnectHandler хаба.
+// Этот метод вызывается из app.go через коллбэк OnDisconnectHandler хаба, уже в очереди комнаты;
+// остальные вызывающие ставят его туда через hub.RunInRoom.
 func (gmh *GameMessageHandler) HandlePlayerDisconnect(userID string, roomID string) {
 	if userID == "" {
 		log.Printf("Handler (HandlePlayerDisconnect): UserID is empty. Cannot process disconnect.")
@@ -522,14 +586,19 @@ func (gmh *GameMessageHandler) HandlePlayerDisconnect(userID string, roomID stri
 		userID, roomID, ucResult.IsRoomDeleted)
 }
 
-func (gmh *GameMessageHandler) handleFindRankedMatch(client *gameservicews.Client) error {
+func (gmh *GameMessageHandler) handleFindRankedMatch(cmd *command) error {
+	client := cmd.client
 	log.Printf("User %s is searching for a ranked match.", client.UserID)
 	gmh.sendToClient(client, "ranked_search_started", "Searching for an opponent...")
 
 	// Your use case finds the match and returns the IDs
 	match, err := gmh.rankedUseCase.FindMatch(client.UserID)
 	if err != nil {
-		gmh.sendErrorToClient(client, "ranked_search_failed", "An error occurred.")
+		if errors.Is(err, model.ErrPlayRestricted) {
+			gmh.replyError(cmd, dto.ErrCodePlayRestricted, err.Error())
+			return err
+		}
+		gmh.replyError(cmd, dto.ErrCodeRankedSearchFailed, "An error occurred.")
 		return err
 	}
 
@@ -549,20 +618,18 @@ func (gmh *GameMessageHandler) handleFindRankedMatch(client *gameservicews.Clien
 		opponentID = match.Players[0]
 	}
 
-	opponentClient, ok := gmh.hub.GetClientByUserID(opponentID)
-	if !ok {
+	// Соперник может быть подключен к другому узлу: хаб проверит присутствие и переведёт его в комнату там.
+	if !gmh.hub.IsUserOnline(opponentID) {
 		log.Printf("CRITICAL: Opponent %s disconnected before match could be finalized.", opponentID)
-		gmh.sendErrorToClient(searcherClient, "match_failed", "Your opponent disconnected before the game could start.")
+		gmh.replyError(cmd, dto.ErrCodeMatchFailed, "Your opponent disconnected before the game could start.")
 		return errors.New("opponent disconnected during match finalization")
 	}
 
-	log.Printf("Successfully retrieved client objects for searcher %s and opponent %s", searcherClient.UserID, opponentClient.UserID)
-
-	searcherClient.RoomID = match.RoomID
-	opponentClient.RoomID = match.RoomID
+	gmh.hub.SetClientRoom(searcherClient, match.RoomID)
+	gmh.hub.SetUserRoom(opponentID, match.RoomID)
 
-	log.Printf("In-memory state updated: Client %s -> Room %s, Client %s -> Room %s",
-		searcherClient.UserID, searcherClient.RoomID, opponentClient.UserID, opponentClient.RoomID)
+	log.Printf("Room assignment updated: User %s -> Room %s, User %s -> Room %s",
+		searcherClient.UserID, match.RoomID, opponentID, match.RoomID)
 
 	notification := map[string]interface{}{
 		"roomId": match.RoomID,
@@ -571,3 +638,102 @@ func (gmh *GameMessageHandler) handleFindRankedMatch(client *gameservicews.Clien
 
 	return nil
 }
+
+// RunSessionReminders periodically tells the player how long they have been connected,
+// at the interval they chose in their responsible gaming settings. It returns when the client disconnects.
+func (gmh *GameMessageHandler) RunSessionReminders(client *gameservicews.Client) {
+	interval, err := gmh.sessionUseCase.ReminderInterval(client.UserID)
+	if err != nil {
+		log.Printf("GameMessageHandler: Could not load session reminder settings for user %s: %v", client.UserID, err)
+		return
+	}
+	if interval <= 0 {
+		return
+	}
+
+	startedAt := time.Now()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-client.Done:
+			return
+		case <-ticker.C:
+			gmh.sendToClient(client, "session_reminder", dto.SessionReminderPayload{
+				MinutesPlayed: int(time.Since(startedAt).Minutes()),
+			})
+		}
+	}
+}
+
+// settleRetryAfter is how long a room must sit in "settling" before the janitor retries its
+// settlement, long enough for the retries within the player's move to have finished.
+const settleRetryAfter = 30 * time.Second
+
+// RunRoomJanitor periodically closes games nobody has touched for idleAfter, refunding the stakes,
+// retries settlements user-service didn't confirm and announces those games once they settle,
+// and removes expired rooms from every lobby. It returns when ctx is cancelled.
+func (gmh *GameMessageHandler) RunRoomJanitor(ctx context.Context, interval time.Duration, idleAfter time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			gmh.sweepRooms(ctx, settleRetryAfter, func(roomID string) {
+				result, err := gmh.gameUseCase.SettlePendingGame(ctx, roomID)
+				if err != nil {
+					log.Printf("GameMessageHandler: Retrying settlement of room %s failed: %v", roomID, err)
+					return
+				}
+				if result != nil {
+					gmh.broadcastGameEnd(result)
+					log.Printf("Handler: Game in room %s settled on retry. Winner: %s", roomID, result.Winner)
+				}
+			})
+			gmh.sweepRooms(ctx, idleAfter, func(roomID string) {
+				closed, err := gmh.gameUseCase.CloseIdleRoom(ctx, roomID)
+				if err != nil {
+					log.Printf("GameMessageHandler: Room janitor skipped room %s: %v", roomID, err)
+					return
+				}
+				if closed != nil {
+					gmh.announceClosedRoom(*closed, "The game was idle for too long and has been closed. Your stake was refunded.")
+				}
+			})
+		}
+	}
+}
+
+// sweepRooms runs task for every room idle for longer than idleAfter, each in the mailbox of its
+// room so it never overlaps a command or a disconnect there, and waits for all of them.
+func (gmh *GameMessageHandler) sweepRooms(ctx context.Context, idleAfter time.Duration, task func(roomID string)) {
+	roomIDs, err := gmh.gameUseCase.ListIdleRooms(ctx, idleAfter)
+	if err != nil {
+		log.Printf("GameMessageHandler: Room janitor failed: %v", err)
+		return
+	}
+	done := make([]<-chan struct{}, 0, len(roomIDs))
+	for _, roomID := range roomIDs {
+		done = append(done, gmh.hub.RunInRoom(roomID, func() { task(roomID) }))
+	}
+	for _, d := range done {
+		<-d
+	}
+}
+
+// announceClosedRoom tells the players of a closed room why it closed, takes them out of it
+// and removes it from every lobby. A room that expired on its own has no players to tell.
+func (gmh *GameMessageHandler) announceClosedRoom(closed model.ClosedRoom, msg string) {
+	if len(closed.PlayerIDs) > 0 {
+		gmh.broadcastToRoom(closed.RoomID, "room_closed", map[string]interface{}{
+			"roomID": closed.RoomID,
+			"msg":    msg,
+		})
+	}
+	for _, pID := range closed.PlayerIDs {
+		gmh.hub.ClearUserRoom(pID, closed.RoomID)
+	}
+	gmh.broadcastAll("update_list", dto.RoomListUpdateDTO{Action: "remove", RoomID: closed.RoomID})
+}
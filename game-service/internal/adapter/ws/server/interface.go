@@ -1,8 +1,10 @@
 package server
 
 import (
+	"context"
 	"game_svc/internal/adapter/ws/server/dto"
 	"game_svc/internal/model"
+	"time"
 )
 
 type RoomUseCase interface {
@@ -16,8 +18,25 @@ type GameUseCase interface {
 	Hit(params model.HitParams) (*model.Result, error)
 	Stand(params model.StandParams) (*model.Result, error)
 	HandlePlayerDisconnect(userID string, roomID string) (*dto.DisconnectResponse, error)
+	ListIdleRooms(ctx context.Context, idleAfter time.Duration) ([]string, error)
+	CloseIdleRoom(ctx context.Context, roomID string) (*model.ClosedRoom, error)
+	SettlePendingGame(ctx context.Context, roomID string) (*model.Result, error)
+	CloseAbandonedGame(ctx context.Context, roomID string, absentIDs []string) (*model.ClosedRoom, error)
+	ForceCloseRoom(ctx context.Context, roomID string) (*model.ClosedRoom, error)
+	ListRooms(ctx context.Context) ([]*model.Room, error)
+	GetRoom(ctx context.Context, roomID string) (*model.Room, error)
+	FindPlayerRoom(ctx context.Context, userID string) (string, error)
 }
 
 type RankedUseCase interface {
 	FindMatch(userID string) (*model.Match, error)
 }
+
+type TournamentUseCase interface {
+	Tick(ctx context.Context) (*model.TournamentTick, error)
+	PendingMatch(ctx context.Context, userID string) (*model.TournamentMatchStart, error)
+}
+
+type SessionUseCase interface {
+	ReminderInterval(userID string) (time.Duration, error)
+}
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"fmt"
+
+	"game_svc/config"
+	gameservicews "game_svc/pkg/ws"
+)
+
+// LimitsFromConfig turns the WS_* limit settings into hub limits.
+func LimitsFromConfig(cfg config.WSLimits) (gameservicews.Limits, error) {
+	limits := gameservicews.Limits{
+		MaxInFlight:     cfg.MaxInFlight,
+		MaxConnsPerUser: cfg.MaxConnsPerUser,
+		MaxConnsPerIP:   cfg.MaxConnsPerIP,
+		MaxViolations:   cfg.MaxViolations,
+		ViolationWindow: cfg.ViolationWindow,
+		TypeRates:       make(map[string]gameservicews.Rate, len(cfg.TypeRates)),
+	}
+	if cfg.MessageRate != "" {
+		rate, err := gameservicews.ParseRate(cfg.MessageRate)
+		if err != nil {
+			return limits, fmt.Errorf("WS_MESSAGE_RATE: %w", err)
+		}
+		limits.MessageRate = rate
+	}
+	for msgType, s := range cfg.TypeRates {
+		rate, err := gameservicews.ParseRate(s)
+		if err != nil {
+			return limits, fmt.Errorf("WS_MESSAGE_TYPE_RATES %s: %w", msgType, err)
+		}
+		limits.TypeRates[msgType] = rate
+	}
+	return limits, nil
+}
@@ -0,0 +1,152 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"game_svc/internal/adapter/grpc/server/frontend/proto/events"
+	"game_svc/internal/adapter/ws/server/dto"
+	gameservicews "game_svc/pkg/ws"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// SubprotocolV1Proto — подпротокол версии 1 с конвертами events.WsEnvelope в бинарных кадрах.
+const SubprotocolV1Proto = "dueljack.v1.proto"
+
+// ProtoCodec translates protocol v1 between the hub's JSON and protobuf frames.
+// Frequent messages get typed payloads; everything else travels as a google.protobuf.Value
+// of the same shape as in JSON, so new message types work without touching the codec.
+type ProtoCodec struct{}
+
+func (ProtoCodec) Subprotocol() string { return SubprotocolV1Proto }
+func (ProtoCodec) Version() int        { return gameservicews.ProtocolV1 }
+func (ProtoCodec) FrameType() int      { return websocket.BinaryMessage }
+
+func (ProtoCodec) Encode(message []byte) ([]byte, error) {
+	out, err := gameservicews.DecodeOutbound(message)
+	if err != nil {
+		return nil, err
+	}
+	env := &events.WsEnvelope{V: gameservicews.ProtocolV1, Type: out.Type, RequestId: out.RequestID}
+	if err := setProtoPayload(env, out.Content); err != nil {
+		return nil, fmt.Errorf("encode %s payload: %w", out.Type, err)
+	}
+	return proto.Marshal(env)
+}
+
+func setProtoPayload(env *events.WsEnvelope, content json.RawMessage) error {
+	switch env.Type {
+	case "hello":
+		var p dto.HelloPayload
+		if err := json.Unmarshal(content, &p); err != nil {
+			return err
+		}
+		hello := &events.WsHello{Version: uint32(p.Version)}
+		for _, v := range p.SupportedVersions {
+			hello.SupportedVersions = append(hello.SupportedVersions, uint32(v))
+		}
+		env.Payload = &events.WsEnvelope_Hello{Hello: hello}
+	case "ack":
+		var p struct {
+			Command string          `json:"command"`
+			Result  json.RawMessage `json:"result"`
+		}
+		if err := json.Unmarshal(content, &p); err != nil {
+			return err
+		}
+		result, err := protoValue(p.Result)
+		if err != nil {
+			return err
+		}
+		env.Payload = &events.WsEnvelope_Ack{Ack: &events.WsAck{Command: p.Command, Result: result}}
+	case "error":
+		var p dto.ErrorPayload
+		if err := json.Unmarshal(content, &p); err != nil {
+			return err
+		}
+		env.Payload = &events.WsEnvelope_Error{Error: &events.WsError{Code: p.Code, Message: p.Message}}
+	case "hit":
+		var p dto.HitBroadcastPayloadDTO
+		if err := json.Unmarshal(content, &p); err != nil {
+			return err
+		}
+		env.Payload = &events.WsEnvelope_Hit{Hit: &events.WsHit{ForPlayer: p.ForPlayer, Card: p.Card, Score: int64(p.Score)}}
+	case "turn":
+		var p dto.TurnBroadcastPayloadDTO
+		if err := json.Unmarshal(content, &p); err != nil {
+			return err
+		}
+		env.Payload = &events.WsEnvelope_Turn{Turn: &events.WsTurn{Turn: p.Turn}}
+	case "game_end":
+		var p dto.GameEndBroadcastPayloadDTO
+		if err := json.Unmarshal(content, &p); err != nil {
+			return err
+		}
+		gameEnd := &events.WsGameEnd{
+			RoomId: p.RoomID,
+			Winner: p.Winner,
+			Scores: make(map[string]int64, len(p.Scores)),
+			Hands:  make(map[string]*events.WsHand, len(p.Hands)),
+		}
+		for id, score := range p.Scores {
+			gameEnd.Scores[id] = int64(score)
+		}
+		for id, hand := range p.Hands {
+			gameEnd.Hands[id] = &events.WsHand{Cards: hand}
+		}
+		env.Payload = &events.WsEnvelope_GameEnd{GameEnd: gameEnd}
+	default:
+		value, err := protoValue(content)
+		if err != nil {
+			return err
+		}
+		if value != nil {
+			env.Payload = &events.WsEnvelope_Value{Value: value}
+		}
+	}
+	return nil
+}
+
+func protoValue(raw json.RawMessage) (*structpb.Value, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, err
+	}
+	return structpb.NewValue(v)
+}
+
+// Decode turns a protobuf command into the JSON envelope the game handler reads.
+func (ProtoCodec) Decode(data []byte) ([]byte, error) {
+	var env events.WsEnvelope
+	if err := proto.Unmarshal(data, &env); err != nil {
+		return nil, fmt.Errorf("unmarshal envelope: %w", err)
+	}
+
+	var payload interface{}
+	switch p := env.Payload.(type) {
+	case *events.WsEnvelope_CreateRoom:
+		payload = dto.CreateRoomPayload{Bet: int(p.CreateRoom.GetBet())}
+	case *events.WsEnvelope_JoinRoom:
+		payload = dto.JoinRoomPayload{RoomID: p.JoinRoom.GetRoomId(), Bet: int(p.JoinRoom.GetBet())}
+	case *events.WsEnvelope_Ready:
+		payload = dto.ReadyPayload{IsReady: p.Ready.GetIsReady()}
+	case *events.WsEnvelope_Value:
+		payload = p.Value.AsInterface()
+	}
+
+	out := gameservicews.Envelope{V: int(env.GetV()), Type: env.GetType(), RequestID: env.GetRequestId()}
+	if payload != nil {
+		raw, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("marshal payload: %w", err)
+		}
+		out.Payload = raw
+	}
+	return json.Marshal(out)
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"encoding/json"
+	"log"
+
+	"game_svc/internal/adapter/ws/server/dto"
+	gameservicews "game_svc/pkg/ws"
+)
+
+// supportedProtocolVersions — версии протокола, которые сервер принимает, от старой к новой.
+var supportedProtocolVersions = []int{gameservicews.ProtocolLegacy, gameservicews.ProtocolV1}
+
+// command is an incoming client command. Every command gets exactly one reply:
+// an ack when it succeeds or an error with a code. Legacy clients get no acks.
+type command struct {
+	client    *gameservicews.Client
+	msgType   string
+	requestID string
+	// result is returned in the ack when the command has something to report
+	result  interface{}
+	replied bool
+	// reply takes the ack or error of a command that came without a connection
+	reply chan<- []byte
+}
+
+// replyError answers the command with an error carrying a machine-readable code.
+func (gmh *GameMessageHandler) replyError(cmd *command, code string, message string) {
+	cmd.replied = true
+	if cmd.client.ProtocolVersion == gameservicews.ProtocolLegacy {
+		gmh.sendToClient(cmd.client, "error", dto.ErrorResponse{ErrorType: code, Message: message})
+		return
+	}
+	gmh.sendReply(cmd, "error", dto.ErrorPayload{Code: code, Message: message})
+}
+
+// replyNotYourTurn keeps the legacy "warning" message for old clients.
+func (gmh *GameMessageHandler) replyNotYourTurn(cmd *command) {
+	if cmd.client.ProtocolVersion == gameservicews.ProtocolLegacy {
+		cmd.replied = true
+		gmh.sendToClient(cmd.client, "warning", map[string]interface{}{"roomID": cmd.client.RoomID(), "msg": "Not your turn"})
+		return
+	}
+	gmh.replyError(cmd, dto.ErrCodeNotYourTurn, "Not your turn")
+}
+
+// ack confirms the command, unless it has already been answered.
+func (gmh *GameMessageHandler) ack(cmd *command) {
+	if cmd.replied || cmd.client.ProtocolVersion == gameservicews.ProtocolLegacy {
+		return
+	}
+	cmd.replied = true
+	gmh.sendReply(cmd, "ack", dto.AckPayload{Command: cmd.msgType, Result: cmd.result})
+}
+
+func (gmh *GameMessageHandler) sendReply(cmd *command, messageType string, content interface{}) {
+	response, err := json.Marshal(gameservicews.OutboundMessage{Type: messageType, RequestID: cmd.requestID, Content: content})
+	if err != nil {
+		log.Printf("GameMessageHandler: Error marshalling %s for request %s of client %s: %v", messageType, cmd.requestID, cmd.client.UserID, err)
+		return
+	}
+	if cmd.reply != nil {
+		cmd.reply <- response
+		return
+	}
+	gmh.hub.BroadcastToClient(cmd.client, response)
+}
+
+// Reject answers a command the hub refused because the client exceeded its limits.
+func (gmh *GameMessageHandler) Reject(rawMsg *gameservicews.RawMessage, reason string) {
+	var msg dto.GameMessage
+	_ = json.Unmarshal(rawMsg.Payload, &msg) // request_id is best effort: the command was not parsed yet
+	cmd := &command{client: rawMsg.Client, msgType: msg.Type, requestID: msg.RequestID, reply: rawMsg.Reply}
+	switch reason {
+	case gameservicews.RejectTooManyInFlight:
+		gmh.replyError(cmd, dto.ErrCodeTooManyInFlight, "Too many commands in progress; wait for the previous ones to finish.")
+	default:
+		gmh.replyError(cmd, dto.ErrCodeRateLimited, "Too many commands; slow down.")
+	}
+}
+
+// Greet tells a client that negotiated a versioned protocol which version it got.
+// It runs before the client is registered, so the message is queued directly.
+func (gmh *GameMessageHandler) Greet(client *gameservicews.Client) {
+	if client.ProtocolVersion == gameservicews.ProtocolLegacy {
+		return
+	}
+	gmh.queueToClient(client, "hello", dto.HelloPayload{
+		Version:           client.ProtocolVersion,
+		SupportedVersions: supportedProtocolVersions,
+	})
+}
+
+// queueToClient puts a message on the send buffer of a client that is not registered with the hub yet.
+func (gmh *GameMessageHandler) queueToClient(client *gameservicews.Client, messageType string, content interface{}) {
+	response, err := json.Marshal(gameservicews.OutboundMessage{Type: messageType, Content: content})
+	if err != nil {
+		log.Printf("GameMessageHandler: Error marshalling %s for user %s: %v", messageType, client.UserID, err)
+		return
+	}
+	data, err := client.Encode(response)
+	if err != nil {
+		log.Printf("GameMessageHandler: Error encoding %s for user %s: %v", messageType, client.UserID, err)
+		return
+	}
+	select {
+	case client.Send <- data:
+	default:
+	}
+}
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"game_svc/internal/adapter/ws/server/dto"
+	gameservicews "game_svc/pkg/ws"
+)
+
+// resumeIndex помнит игроков из комнат, переживших рестарт сервиса, которые ещё не переподключились.
+// Ключ — userID из JWT, значение — ID комнаты.
+type resumeIndex struct {
+	mu    sync.Mutex
+	rooms map[string]string
+}
+
+func newResumeIndex() *resumeIndex {
+	return &resumeIndex{rooms: make(map[string]string)}
+}
+
+// take возвращает комнату игрока и убирает его из индекса.
+func (ri *resumeIndex) take(userID string) (string, bool) {
+	ri.mu.Lock()
+	defer ri.mu.Unlock()
+	roomID, ok := ri.rooms[userID]
+	delete(ri.rooms, userID)
+	return roomID, ok
+}
+
+// drain забирает всех не вернувшихся игроков, сгруппированных по комнатам.
+func (ri *resumeIndex) drain() map[string][]string {
+	ri.mu.Lock()
+	defer ri.mu.Unlock()
+	byRoom := make(map[string][]string)
+	for userID, roomID := range ri.rooms {
+		byRoom[roomID] = append(byRoom[roomID], userID)
+	}
+	ri.rooms = make(map[string]string)
+	return byRoom
+}
+
+// RestoreRooms rebuilds the player-to-room index from Redis after a restart. Players who reconnect
+// within grace are put back into their room; the rest are settled by settleUnresumed.
+func (gmh *GameMessageHandler) RestoreRooms(ctx context.Context, grace time.Duration) {
+	rooms, err := gmh.gameUseCase.ListRooms(ctx)
+	if err != nil {
+		log.Printf("GameMessageHandler: Failed to restore rooms: %v", err)
+		return
+	}
+	if len(rooms) == 0 {
+		return
+	}
+
+	gmh.resume.mu.Lock()
+	for _, room := range rooms {
+		for _, p := range room.Players {
+			gmh.resume.rooms[p.ID] = room.ID
+		}
+	}
+	restoredPlayers := len(gmh.resume.rooms)
+	gmh.resume.mu.Unlock()
+	log.Printf("GameMessageHandler: Restored %d rooms with %d players, waiting %s for them to reconnect", len(rooms), restoredPlayers, grace)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-time.After(grace):
+			gmh.settleUnresumed(ctx)
+		}
+	}()
+}
+
+// ReattachClient puts a reconnecting player back into the room they were in before the restart
+// and sends them the current game state; a player without such a room is seated in their pending
+// tournament match. It must be called before the client is registered in the hub,
+// so the state is queued directly on the client's send buffer.
+func (gmh *GameMessageHandler) ReattachClient(client *gameservicews.Client) {
+	roomID, ok := gmh.resume.take(client.UserID)
+	if !ok {
+		gmh.attachTournamentMatch(client)
+		return
+	}
+	room, err := gmh.gameUseCase.GetRoom(context.Background(), roomID)
+	if err != nil || !slices.Contains(dto.GetPlayerIDsFromModels(room.Players), client.UserID) {
+		log.Printf("GameMessageHandler: Room %s of user %s is gone, nothing to resume: %v", roomID, client.UserID, err)
+		return
+	}
+
+	gmh.hub.SetClientRoom(client, roomID) // клиент ещё не зарегистрирован: индекс комнат обновит Register
+	state := dto.FromRoomModelToGameStateUpdate(room, "Welcome back! Your game has been resumed.")
+	gmh.queueToClient(client, "game_resumed", state)
+	gmh.broadcastToRoom(roomID, "player_reconnected", map[string]string{"roomID": roomID, "userID": client.UserID})
+	log.Printf("GameMessageHandler: User %s reattached to room %s", client.UserID, roomID)
+}
+
+// settleUnresumed applies the policy for players who did not come back after a restart:
+// a game nobody returned to is cancelled and the stakes are refunded; otherwise every absent
+// player is treated as disconnected, so a returned opponent wins by forfeit and waiting rooms drop them.
+func (gmh *GameMessageHandler) settleUnresumed(ctx context.Context) {
+	for roomID, restoredIDs := range gmh.resume.drain() {
+		// Every replica restores the same rooms, so a player who came back through another node
+		// is still in this node's index; presence tells them apart from players who never returned.
+		var absentIDs []string
+		for _, userID := range restoredIDs {
+			if !gmh.hub.IsUserOnline(userID) {
+				absentIDs = append(absentIDs, userID)
+			}
+		}
+		if len(absentIDs) == 0 {
+			continue
+		}
+		<-gmh.hub.RunInRoom(roomID, func() {
+			closed, err := gmh.gameUseCase.CloseAbandonedGame(ctx, roomID, absentIDs)
+			if err != nil {
+				log.Printf("GameMessageHandler: Failed to settle abandoned room %s: %v", roomID, err)
+				return
+			}
+			if closed != nil {
+				gmh.broadcastAll("update_list", dto.RoomListUpdateDTO{Action: "remove", RoomID: roomID})
+				return
+			}
+			for _, userID := range absentIDs {
+				gmh.HandlePlayerDisconnect(userID, roomID)
+			}
+		})
+	}
+}
@@ -3,9 +3,11 @@ package server
 import (
 	"context"
 	"errors"
+	"expvar"
 	"fmt"
 	"game_svc/pkg/security"
 	"log"
+	"net"
 	"net/http"
 
 	"game_svc/config"
@@ -13,7 +15,11 @@ import (
 	"github.com/gorilla/websocket"
 )
 
-const defaultWebSocketPath = "/ws"
+const (
+	defaultWebSocketPath = "/ws"
+	metricsPath          = "/debug/vars"
+	drainPath            = "/admin/drain"
+)
 
 // WebSocketServer manages the HTTP server for WebSocket connections.
 type WebSocketServer struct {
@@ -22,11 +28,15 @@ type WebSocketServer struct {
 	handler    *GameMessageHandler
 	Cfg        config.ServerConfig
 	wsPath     string
+	drainCh    chan struct{}
 }
 
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
 	WriteBufferSize: 1024,
+	// Клиент выбирает версию протокола и кодирование подпротоколом; без него остаётся прежний формат.
+	// JSON идёт первым, чтобы оставаться выбором по умолчанию.
+	Subprotocols: []string{gameservicews.SubprotocolV1JSON, SubprotocolV1Proto},
 	CheckOrigin: func(r *http.Request) bool {
 		// TODO: Implement proper origin checking using cfg.AllowedOrigins
 		log.Printf("WebSocket CheckOrigin: Host %s, Origin %s", r.Host, r.Header.Get("Origin"))
@@ -54,6 +64,22 @@ func New(
 	}), jwtManager) // <<<< Передаем jwtManager в middleware
 
 	mux.Handle(wsPath, wsHandlerWithAuth)
+	// Счётчики хаба (клиенты, комнаты, отброшенные сообщения) публикуются через expvar
+	expvar.Publish("ws_hub", expvar.Func(func() any { return hub.Metrics() }))
+	mux.Handle(metricsPath, expvar.Handler())
+	// Администратор может перевести узел в режим drain перед выкладкой, не посылая SIGTERM
+	drainCh := make(chan struct{}, 1)
+	mux.Handle(drainPath, AdminJWTMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		select {
+		case drainCh <- struct{}{}:
+		default:
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}), jwtManager))
 
 	addr := ":" + cfg.WebSocketPort
 	httpSrv := &http.Server{
@@ -70,18 +96,12 @@ func New(
 		handler:    gameHandler,
 		Cfg:        cfg,
 		wsPath:     wsPath,
+		drainCh:    drainCh,
 	}
 }
 
 // serveWsLogic handles the upgrade of an HTTP connection to a WebSocket connection.
 func serveWsLogic(hub *gameservicews.Hub, gameHandler *GameMessageHandler, w http.ResponseWriter, r *http.Request) {
-	conn, err := upgrader.Upgrade(w, r, nil)
-	if err != nil {
-		log.Printf("WebSocket upgrade error from %s: %v", r.RemoteAddr, err)
-		http.Error(w, "Could not open websocket connection", http.StatusBadRequest)
-		return
-	}
-
 	userIDFromCtx := r.Context().Value(UserIDKey)
 
 	userID, ok := userIDFromCtx.(string)
@@ -91,22 +111,58 @@ func serveWsLogic(hub *gameservicews.Hub, gameHandler *GameMessageHandler, w htt
 		return
 	}
 
+	if gameHandler.IsDraining() {
+		// Балансировщик отправит клиента на другой узел
+		http.Error(w, "Server is draining", http.StatusServiceUnavailable)
+		return
+	}
+
+	remoteIP := remoteIPOf(r)
+	if !hub.AcquireConnection(userID, remoteIP) {
+		log.Printf("serveWsLogic: Too many connections for UserID %s or IP %s", userID, remoteIP)
+		http.Error(w, "Too many connections", http.StatusTooManyRequests)
+		return
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		hub.ReleaseConnection(userID, remoteIP)
+		log.Printf("WebSocket upgrade error from %s: %v", r.RemoteAddr, err)
+		return
+	}
+
 	client := &gameservicews.Client{
-		Hub:    hub,
-		Conn:   conn,
-		Send:   make(chan []byte, 256), // Buffered channel
-		UserID: userID,
+		Hub:      hub,
+		Conn:     conn,
+		Send:     make(chan []byte, 256), // Buffered channel
+		UserID:   userID,
+		RemoteIP: remoteIP,
+		Done:     make(chan struct{}),
 		// RoomID will be set by game logic via messages
 	}
+	client.Codec, client.ProtocolVersion = gameservicews.NewClientProtocol(conn.Subprotocol(), gameservicews.JSONCodec{}, ProtoCodec{})
 
-	log.Printf("Client connected: UserID %s, RemoteAddr: %s", client.UserID, client.Conn.RemoteAddr().String())
+	log.Printf("Client connected: UserID %s, RemoteAddr: %s, protocol v%d", client.UserID, client.RemoteAddr(), client.ProtocolVersion)
+	gameHandler.Greet(client)
+	gameHandler.ReattachClient(client)
 	client.Hub.Register <- client
 
 	go client.WritePump()
 	go client.ReadPump()
+	go gameHandler.RunSessionReminders(client)
 	// Disconnect logic is now handled via hub.OnDisconnectHandler set in app.go
 }
 
+// remoteIPOf returns the peer address without the port. Forwarding headers are not trusted:
+// clients connect to the game service directly.
+func remoteIPOf(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
 // Run starts the WebSocket HTTP server.
 func (s *WebSocketServer) Run(errCh chan<- error) {
 	go func() {
@@ -136,6 +192,11 @@ func (s *WebSocketServer) Stop(ctx context.Context) error {
 	return nil
 }
 
+// DrainRequested fires when an admin asks this instance to drain.
+func (s *WebSocketServer) DrainRequested() <-chan struct{} {
+	return s.drainCh
+}
+
 // GetAddr returns the address the server is configured to listen on.
 func (s *WebSocketServer) GetAddr() string {
 	return s.httpServer.Addr
@@ -0,0 +1,37 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"game_svc/internal/adapter/ws/server/dto"
+	gameservicews "game_svc/pkg/ws"
+)
+
+// ConfigureSessions sets how the hub treats a second connection of the same user
+// and the messages connections get when they lose or take over the active seat.
+func ConfigureSessions(hub *gameservicews.Hub, policy string) error {
+	sessionPolicy, err := gameservicews.ParseSessionPolicy(policy)
+	if err != nil {
+		return err
+	}
+	replaced, err := json.Marshal(gameservicews.OutboundMessage{
+		Type:    "session_replaced",
+		Content: dto.SessionStatePayload{ReadOnly: true, Message: "You connected from another device or tab; this connection is closed."},
+	})
+	if err != nil {
+		return fmt.Errorf("marshal session_replaced: %w", err)
+	}
+	activated, err := json.Marshal(gameservicews.OutboundMessage{
+		Type:    "session_activated",
+		Content: dto.SessionStatePayload{ReadOnly: false, Message: "Your other connection closed; this one now holds your seat."},
+	})
+	if err != nil {
+		return fmt.Errorf("marshal session_activated: %w", err)
+	}
+
+	hub.SessionPolicy = sessionPolicy
+	hub.SessionReplacedNotice = replaced
+	hub.SessionActivatedNotice = activated
+	return nil
+}
@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"slices"
+	"time"
+
+	"game_svc/internal/adapter/ws/server/dto"
+	"game_svc/internal/model"
+	gameservicews "game_svc/pkg/ws"
+)
+
+// RunTournaments periodically advances tournaments and tells the players what happened: which room
+// their next match is in, that a match room is closed, and how the tournament ended for them.
+// Only the instance holding the scheduler lease does the work. It returns when ctx is cancelled.
+func (gmh *GameMessageHandler) RunTournaments(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			tick, err := gmh.tournamentUseCase.Tick(ctx)
+			if err != nil {
+				log.Printf("GameMessageHandler: Tournament scheduler failed: %v", err)
+				continue
+			}
+			gmh.announceTournamentTick(tick)
+		}
+	}
+}
+
+// announceTournamentTick moves players in and out of match rooms in the mailbox of each room,
+// so the change never lands in the middle of a command there.
+func (gmh *GameMessageHandler) announceTournamentTick(tick *model.TournamentTick) {
+	var done []<-chan struct{}
+	for _, closed := range tick.ClosedRooms {
+		done = append(done, gmh.hub.RunInRoom(closed.RoomID, func() {
+			gmh.announceClosedRoom(closed, "The tournament match is over.")
+		}))
+	}
+	for _, match := range tick.StartedMatches {
+		done = append(done, gmh.hub.RunInRoom(match.RoomID, func() {
+			// Игроки могут быть подключены к другим узлам: хаб переведёт их в комнату там
+			for _, pID := range match.PlayerIDs {
+				gmh.hub.SetUserRoom(pID, match.RoomID)
+			}
+			gmh.broadcastToRoom(match.RoomID, "tournament_match", tournamentMatchPayload(match))
+		}))
+	}
+	for _, d := range done {
+		<-d
+	}
+	for _, t := range tick.Finished {
+		for _, st := range t.Standings {
+			gmh.sendToUser(st.PlayerID, "tournament_finished", dto.TournamentFinishedPayload{
+				TournamentID: t.ID,
+				Name:         t.Name,
+				Place:        st.Place,
+				Prize:        st.Prize,
+			})
+		}
+	}
+	for _, t := range tick.Cancelled {
+		for _, pID := range t.Players {
+			gmh.sendToUser(pID, "tournament_cancelled", dto.TournamentCancelledPayload{TournamentID: t.ID, Name: t.Name})
+		}
+	}
+}
+
+// attachTournamentMatch seats a connecting player in the room of their pending tournament match,
+// so a player who was offline when the match started can still play it before the no-show deadline.
+func (gmh *GameMessageHandler) attachTournamentMatch(client *gameservicews.Client) {
+	ctx := context.Background()
+	match, err := gmh.tournamentUseCase.PendingMatch(ctx, client.UserID)
+	if err != nil {
+		log.Printf("GameMessageHandler: Could not look up tournament match of user %s: %v", client.UserID, err)
+		return
+	}
+	if match == nil {
+		return
+	}
+	room, err := gmh.gameUseCase.GetRoom(ctx, match.RoomID)
+	if err != nil || !slices.Contains(dto.GetPlayerIDsFromModels(room.Players), client.UserID) {
+		return
+	}
+
+	gmh.hub.SetClientRoom(client, match.RoomID) // клиент ещё не зарегистрирован: индекс комнат обновит Register
+	gmh.queueToClient(client, "tournament_match", tournamentMatchPayload(*match))
+	log.Printf("GameMessageHandler: User %s seated in tournament match room %s", client.UserID, match.RoomID)
+}
+
+func tournamentMatchPayload(match model.TournamentMatchStart) dto.TournamentMatchPayload {
+	return dto.TournamentMatchPayload{
+		TournamentID: match.TournamentID,
+		Round:        match.Round,
+		RoomID:       match.RoomID,
+		Players:      match.PlayerIDs,
+		Deadline:     match.Deadline.Unix(),
+	}
+}
+
+// sendToUser delivers a message to every connection of the user, on whichever instance it is.
+func (gmh *GameMessageHandler) sendToUser(userID string, messageType string, content interface{}) {
+	response, err := json.Marshal(gameservicews.OutboundMessage{Type: messageType, Content: content})
+	if err != nil {
+		log.Printf("GameMessageHandler: Error marshalling message for user %s (type: %s): %v", userID, messageType, err)
+		return
+	}
+	gmh.hub.SendToUser(userID, response)
+}
@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 	"fmt"
+	"game_svc/internal/adapter/nats/fanout"
 	"game_svc/internal/adapter/nats/producer"
 	"game_svc/pkg/security"
 	"log"
@@ -10,26 +11,38 @@ import (
 	"os/signal"
 	"strings"
 	"syscall"
+	"time"
 
 	"game_svc/config"
+	grpcserver "game_svc/internal/adapter/grpc/server"
 	usersvc "game_svc/internal/adapter/grpc/server/frontend/proto/user"
 	grpcusersvcclient "game_svc/internal/adapter/grpc/users"
 	redisrepo "game_svc/internal/adapter/redis"
 	wsserver "game_svc/internal/adapter/ws/server"
+	"game_svc/internal/model"
 	"game_svc/internal/usecase"
 	grpcconn "game_svc/pkg/grpcconn"
 	natsconn "game_svc/pkg/nats"
 	redisconn "game_svc/pkg/redis"
 	gameservicews "game_svc/pkg/ws"
+
+	"github.com/google/uuid"
 )
 
 const serviceName = "game-service"
 
 type App struct {
-	webSocketServer *wsserver.WebSocketServer
-	wsHub           *gameservicews.Hub
-	redis           *redisconn.Client
-	natsClient      *natsconn.Client
+	webSocketServer    *wsserver.WebSocketServer
+	grpcServer         *grpcserver.API
+	wsHub              *gameservicews.Hub
+	gameMessageHandler *wsserver.GameMessageHandler
+	rooms              config.Rooms
+	drain              config.Drain
+	tournament         config.Tournament
+	presenceHeartbeat  time.Duration
+	stopJanitor        context.CancelFunc
+	redis              *redisconn.Client
+	natsClient         *natsconn.Client
 }
 
 func New(ctx context.Context, cfg *config.Config) (*App, error) {
@@ -57,6 +70,7 @@ func New(ctx context.Context, cfg *config.Config) (*App, error) {
 
 	// Initialize NATS producer
 	gameProducer := producer.NewGameEvent(natsClient, cfg.Nats.NatsSubjects.GameResultSubject)
+	tournamentProducer := producer.NewTournamentEvent(natsClient, cfg.Nats.NatsSubjects.TournamentResultSubject)
 
 	// 2. Initialize Redis connection
 	log.Println("Initializing Redis connection...")
@@ -71,29 +85,76 @@ func New(ctx context.Context, cfg *config.Config) (*App, error) {
 
 	// 3. Initialize Repositories
 	log.Println("Initializing repositories...")
-	roomStateRepo := redisrepo.NewRoomStateRepoImpl(redisClient)
+	roomStateRepo := redisrepo.NewRoomStateRepoImpl(redisClient, cfg.Rooms.IdleTTL)
 	rankedRepo := redisrepo.NewRankedRepoImpl(redisClient)
+	tournamentRepo := redisrepo.NewTournamentRepoImpl(redisClient, cfg.Tournament.FinishedTTL)
+
+	// Replicas share Redis and NATS; nodeID tells them apart in presence records and the tournament scheduler lease
+	nodeID := cfg.Server.NodeID
+	if nodeID == "" {
+		nodeID = uuid.New().String()
+	}
+	payouts, err := usecase.ParsePayoutTable(cfg.Tournament.Payouts)
+	if err != nil {
+		return nil, fmt.Errorf("TOURNAMENT_PAYOUTS: %w", err)
+	}
+	tournamentRules := model.TournamentRules{
+		NoShowTimeout:  cfg.Tournament.NoShowTimeout,
+		HoldTTL:        cfg.Tournament.HoldTTL,
+		SchedulerLease: 3 * cfg.Tournament.TickInterval,
+		SitAndGoSizes:  cfg.Tournament.SitAndGoSizes,
+		SitAndGoBuyIn:  cfg.Tournament.SitAndGoBuyIn,
+		Payouts:        payouts,
+	}
+
 	// 4. Initialize Use Cases
 	log.Println("Initializing use cases...")
-	roomUseCase := usecase.NewRoomService(roomStateRepo, clientServiceClient)               // Ensure NewRoomService matches this
-	gameUseCase := usecase.NewGameService(roomStateRepo, gameProducer, clientServiceClient) // Ensure NewGameService matches
+	roomUseCase := usecase.NewRoomService(roomStateRepo, clientServiceClient) // Ensure NewRoomService matches this
+	tournamentUseCase := usecase.NewTournamentService(tournamentRepo, roomStateRepo, roomUseCase, clientServiceClient, tournamentProducer, tournamentRules, nodeID)
+	gameUseCase := usecase.NewGameService(roomStateRepo, gameProducer, clientServiceClient, tournamentUseCase, model.GameRules(cfg.Game)) // Ensure NewGameService matches
 	rankedUseCase := usecase.NewRankedUseCase(rankedRepo, clientServiceClient, roomUseCase)
+	sessionUseCase := usecase.NewSessionService(clientServiceClient)
 	// 5. Initialize WebSocket Hub
 	log.Println("Initializing WebSocket Hub...")
 	// The hub itself doesn't directly need messageHandler at construction if it's set later
 	// NewHub(messageHandler, onDisconnectHandler)
 	hub := gameservicews.NewHub(nil, nil) // Will set handlers next
+	hub.MaxConsecutiveDrops = cfg.Server.SlowConsumerMaxDrops
+
+	// Replicas relay room broadcasts and direct messages through NATS and track which node each user is on in Redis
+	if cfg.Server.PresenceHeartbeat <= 0 || cfg.Server.PresenceHeartbeat >= cfg.Server.PresenceTTL {
+		return nil, fmt.Errorf("WEBSOCKET_PRESENCE_HEARTBEAT must be positive and shorter than WEBSOCKET_PRESENCE_TTL")
+	}
+	presenceRepo := redisrepo.NewPresenceRepoImpl(redisClient, cfg.Server.PresenceTTL)
+	if err := hub.EnableCluster(nodeID, cfg.Nats.NatsSubjects.WSFanoutSubject, fanout.NewBus(natsClient), presenceRepo); err != nil {
+		return nil, fmt.Errorf("hub cluster setup failed: %w", err)
+	}
 
 	// 6. Initialize GameMessageHandler
 	log.Println("Initializing GameMessageHandler...")
-	gameMessageHandler := wsserver.NewGameMessageHandler(hub, roomUseCase, gameUseCase, rankedUseCase)
+	gameMessageHandler := wsserver.NewGameMessageHandler(hub, roomUseCase, gameUseCase, rankedUseCase, sessionUseCase, tournamentUseCase)
+
+	if err := wsserver.ConfigureSessions(hub, cfg.Server.SessionPolicy); err != nil {
+		return nil, fmt.Errorf("websocket session policy: %w", err)
+	}
+	if p := cfg.Drain.LeftoverPolicy; p != wsserver.DrainLeftoverResume && p != wsserver.DrainLeftoverRefund {
+		return nil, fmt.Errorf("unknown DRAIN_LEFTOVER_POLICY %q", p)
+	}
+	limits, err := wsserver.LimitsFromConfig(cfg.WSLimits)
+	if err != nil {
+		return nil, fmt.Errorf("websocket limits: %w", err)
+	}
+	hub.Limits = limits
 
 	// 7. Set Hub's handlers
 	hub.MessageHandler = gameMessageHandler.Handle
+	hub.RejectHandler = gameMessageHandler.Reject
+	hub.MailboxKey = gameMessageHandler.MailboxKey
+	// The hub calls it in the mailbox of the client's room, in order with the commands of that room
 	hub.OnDisconnectHandler = func(client *gameservicews.Client) {
-		if client.RoomID != "" {
-			log.Printf("App: Handling disconnect for UserID: %s, RoomID: %s", client.UserID, client.RoomID)
-			go gameMessageHandler.HandlePlayerDisconnect(client.UserID, client.RoomID)
+		if roomID := client.RoomID(); roomID != "" {
+			log.Printf("App: Handling disconnect for UserID: %s, RoomID: %s", client.UserID, roomID)
+			gameMessageHandler.HandlePlayerDisconnect(client.UserID, roomID)
 		} else {
 			log.Printf("App: Client UserID %s disconnected, was not in a room.", client.UserID)
 		}
@@ -108,12 +169,21 @@ func New(ctx context.Context, cfg *config.Config) (*App, error) {
 		jwtManager,
 	)
 
+	// 9. Initialize gRPC server for the lobby, tournament and admin API
+	grpcServer := grpcserver.New(cfg.GRPC.GRPCServer, gameUseCase, tournamentUseCase, gameMessageHandler)
+
 	log.Printf("%s application initialized successfully.", serviceName)
 	return &App{
-		webSocketServer: wsServer,
-		wsHub:           hub,
-		redis:           redisClient,
-		natsClient:      natsClient,
+		webSocketServer:    wsServer,
+		grpcServer:         grpcServer,
+		wsHub:              hub,
+		gameMessageHandler: gameMessageHandler,
+		rooms:              cfg.Rooms,
+		drain:              cfg.Drain,
+		tournament:         cfg.Tournament,
+		presenceHeartbeat:  cfg.Server.PresenceHeartbeat,
+		redis:              redisClient,
+		natsClient:         natsClient,
 	}, nil
 }
 
@@ -125,10 +195,22 @@ func (a *App) Run() error {
 	log.Println("Starting WebSocket Hub...")
 	go a.wsHub.Run()
 
+	// Start the janitor that closes stuck games and expired rooms, and give players of games
+	// that survived a restart time to reconnect
+	janitorCtx, stopJanitor := context.WithCancel(context.Background())
+	a.stopJanitor = stopJanitor
+	a.gameMessageHandler.RestoreRooms(janitorCtx, a.rooms.ResumeGrace)
+	go a.gameMessageHandler.RunRoomJanitor(janitorCtx, a.rooms.JanitorInterval, a.rooms.StuckGameAfter)
+	go a.gameMessageHandler.RunTournaments(janitorCtx, a.tournament.TickInterval)
+	go a.wsHub.RunPresenceHeartbeat(janitorCtx, a.presenceHeartbeat)
+
 	// Start the WebSocket HTTP server
 	log.Println("Starting WebSocket server...")
 	a.webSocketServer.Run(errCh) // This now runs its ListenAndServe in a goroutine
 
+	log.Println("Starting gRPC server...")
+	a.grpcServer.Run(context.Background(), errCh)
+
 	log.Printf("Service %s listeners started. Awaiting signals or errors.", serviceName)
 
 	// Wait for errors or shutdown signal
@@ -145,19 +227,57 @@ func (a *App) Run() error {
 		return fmt.Errorf("%s service run failed: %w", serviceName, err)
 
 	case sig := <-signalCh:
+		// SIGTERM comes from a deploy: let running games finish first. SIGINT stops right away.
+		if sig == syscall.SIGTERM {
+			log.Printf("Signal %v received. Draining before shutdown...", sig)
+			a.drainUntilSignal(signalCh)
+		}
 		log.Printf("Signal %v received. Initiating graceful shutdown...", sig)
-		shutdownCtx, cancel := context.WithTimeout(context.Background(), a.webSocketServer.Cfg.ShutdownTimeoutSec)
-		defer cancel()
-		a.shutdown(shutdownCtx)
-		log.Println("Graceful shutdown completed.")
+		a.gracefulShutdown()
+
+	case <-a.webSocketServer.DrainRequested():
+		log.Println("Drain requested by an admin. Draining before shutdown...")
+		a.drainUntilSignal(signalCh)
+		a.gracefulShutdown()
 	}
 	return nil
 }
 
+// drainUntilSignal waits for the running games to finish. A signal during the drain cuts it short,
+// so a second SIGTERM or a SIGINT stops a stuck deploy without waiting for the timeout.
+func (a *App) drainUntilSignal(signalCh <-chan os.Signal) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		a.gameMessageHandler.Drain(ctx, a.drain.Timeout, a.drain.PollInterval, a.drain.LeftoverPolicy)
+	}()
+
+	select {
+	case <-done:
+	case sig := <-signalCh:
+		log.Printf("Signal %v received while draining. Stopping the drain...", sig)
+		cancel()
+		<-done
+	}
+}
+
+func (a *App) gracefulShutdown() {
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), a.webSocketServer.Cfg.ShutdownTimeoutSec)
+	defer cancel()
+	a.shutdown(shutdownCtx)
+	log.Println("Graceful shutdown completed.")
+}
+
 // shutdown attempts to gracefully close all resources.
 func (a *App) shutdown(ctx context.Context) {
 	log.Println("Executing application shutdown sequence...")
 
+	if a.stopJanitor != nil {
+		a.stopJanitor()
+	}
+
 	// Stop WebSocket HTTP server
 	if a.webSocketServer != nil {
 		if err := a.webSocketServer.Stop(ctx); err != nil { // Stop server
@@ -165,6 +285,13 @@ func (a *App) shutdown(ctx context.Context) {
 		}
 	}
 
+	// Stop gRPC server
+	if a.grpcServer != nil {
+		if err := a.grpcServer.Stop(ctx); err != nil {
+			log.Printf("Error stopping gRPC server: %v", err)
+		}
+	}
+
 	// Close Hub (if it has a Close method for resource cleanup, e.g., closing channels)
 	//if a.wsHub != nil && hasattr(a.wsHub, "Close") { a.wsHub.Close(); }
 
@@ -0,0 +1,43 @@
+package model
+
+import "errors"
+
+var (
+	// ErrPlayRestricted means the player can't start a game right now: they are
+	// self-excluded or one of their loss or wager limits is reached.
+	ErrPlayRestricted = errors.New("play restricted")
+
+	// ErrRoomStateConflict means the room changed between reading and writing its
+	// state, so the transition was rejected and nothing was written.
+	ErrRoomStateConflict = errors.New("room state changed concurrently")
+
+	// ErrRoomNotFound means the room no longer exists in Redis.
+	ErrRoomNotFound = errors.New("room not found")
+
+	// ErrGameSettling means the game in the room has ended but its settlement is not
+	// confirmed yet, so the room can't start a new game or take new players until it is.
+	ErrGameSettling = errors.New("game result is being settled")
+
+	// ErrGameInProgress means the room is in the middle of a hand: players can't change their
+	// ready status, and a player who leaves forfeits the game instead of just leaving the room.
+	ErrGameInProgress = errors.New("game is in progress")
+
+	// ErrServerDraining means the instance is shutting down and takes no new connections.
+	ErrServerDraining = errors.New("server is draining")
+
+	// ErrTournamentNotFound means there is no tournament with this ID.
+	ErrTournamentNotFound = errors.New("tournament not found")
+
+	// ErrTournamentStateConflict means the tournament changed between reading and writing it.
+	ErrTournamentStateConflict = errors.New("tournament state changed concurrently")
+
+	// ErrTournamentRegistration means the player can't register or unregister right now:
+	// registration is closed, the tournament is full or the player is (not) registered already.
+	ErrTournamentRegistration = errors.New("tournament registration rejected")
+
+	// ErrInvalidTournament means the tournament parameters are inconsistent.
+	ErrInvalidTournament = errors.New("invalid tournament parameters")
+
+	// ErrTooManyConnections means the user or their address already holds the maximum number of connections.
+	ErrTooManyConnections = errors.New("too many connections")
+)
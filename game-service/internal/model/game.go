@@ -9,6 +9,7 @@ type Player struct {
 	Score      int
 	LastAction string
 	Hand       []Card
+	Stood      bool
 }
 
 // Card представляет игральную карту.
@@ -20,11 +21,16 @@ type Card struct {
 // Room представляет игровую комнату в доменной логике.
 type Room struct {
 	ID                  string
-	Status              string // "waiting", "in_progress", "finished"
+	Status              string // "waiting", "in_progress", "settling", "finished"
 	Bet                 int
-	Players             []*Player // Список игроков в комнате
-	Deck                []Card    // Игровая колода для этой комнаты (будет управляться GameUseCase)
-	CurrentTurnPlayerID string    // ID игрока, чей сейчас ход (может быть пустым)
+	Players             []*Player       // Список игроков в комнате
+	Deck                []Card          // Игровая колода для этой комнаты (будет управляться GameUseCase)
+	CurrentTurnPlayerID string          // ID игрока, чей сейчас ход (может быть пустым)
+	GameID              string          // ID текущей партии, под ним резервируются ставки
+	TournamentID        string          // турнир, матч которого играется в комнате; пусто для обычных комнат
+	Ranked              bool            // комната рейтингового матча
+	PendingGameEnd      *PendingGameEnd // итог партии, ожидающий расчёта, пока комната в статусе "settling"
+	Version             int64           // Версия сохранённого состояния для compare-and-swap
 }
 
 type PlayerReadyResult struct {
@@ -47,9 +53,13 @@ type Result struct {
 	Loser              string
 	FinalScores        map[string]int
 	FinalHands         map[string][]Card
+	Stood              map[string]bool // игроки, сказавшие stand до конца партии
 	NextTurnPlayerID   string
 	PlayerCurrentScore *int
 	AllPlayerScores    *map[string]int
+	Rake               int64
+	JackpotPayout      int64
+	Ranked             bool // партия сыграна в рейтинговом матче
 }
 
 type User struct {
@@ -63,6 +73,8 @@ type User struct {
 	Nickname  *string
 	Bio       *string
 	Balance   *int64
+	Held      *int64 // фишки, удерживаемые в незавершённых играх
+	Available *int64 // Balance - Held: столько игрок может поставить
 	Rating    *int64
 }
 
@@ -75,3 +87,59 @@ type Match struct {
 	RoomID  string
 	Players []string
 }
+
+// ChipHold описывает фишки, зарезервированные в user-service на время игры.
+type ChipHold struct {
+	UserID    int64
+	Amount    int64
+	Reference string // ID игры, за которой закреплены фишки
+	TTL       time.Duration
+}
+
+// PlayerDelta — изменение баланса игрока по итогам игры.
+type PlayerDelta struct {
+	UserID int64
+	Delta  int64
+}
+
+// Settlement — итог игры, который передаётся в user-service одной операцией.
+type Settlement struct {
+	GameID              string
+	Deltas              []PlayerDelta
+	Rake                int64 // комиссия дома с банка
+	JackpotContribution int64 // часть комиссии, которая уходит в джекпот
+	JackpotWinnerID     int64 // 0, если джекпот не разыгран
+}
+
+// PendingGameEnd — итог завершённой партии, расчёт которой user-service ещё не подтвердил.
+// Хранится в комнате, чтобы расчёт можно было повторить и после рестарта.
+type PendingGameEnd struct {
+	Settlement  Settlement
+	Winner      string
+	Loser       string
+	FinalScores map[string]int
+	FinalHands  map[string][]Card
+	Stood       map[string]bool
+}
+
+// Payout — что было удержано и выплачено при расчёте игры.
+type Payout struct {
+	Rake          int64
+	JackpotPayout int64
+}
+
+// GameRules — настраиваемые параметры расчёта игры.
+type GameRules struct {
+	HoldTTL             time.Duration
+	RakePercent         float64
+	RakeCap             int64
+	JackpotSharePercent float64
+}
+
+// ClosedRoom — комната, которую закрыл уборщик или администратор. PlayerIDs пуст, если комната
+// уже истекла по TTL. Refunded — партия шла, и ставки игроков вернулись им.
+type ClosedRoom struct {
+	RoomID    string
+	PlayerIDs []string
+	Refunded  bool
+}
@@ -1,14 +1,17 @@
 package model
 
 type CreateRoomParams struct {
-	UserID string
-	Bet    int
+	UserID       string
+	Bet          int
+	TournamentID string
+	Ranked       bool
 }
 
 type JoinRoomParams struct {
-	UserID string
-	RoomID string
-	Bet    int
+	UserID       string
+	RoomID       string
+	Bet          int
+	TournamentID string
 }
 
 type LeaveRoomParams struct {
@@ -0,0 +1,125 @@
+package model
+
+import "time"
+
+// Форматы турниров.
+const (
+	// TournamentFormatSingleElimination — турнир по расписанию: регистрация открыта в заданное окно,
+	// после него игроки рассаживаются в сетку на выбывание.
+	TournamentFormatSingleElimination = "single_elimination"
+	// TournamentFormatSitAndGo — быстрый турнир на 4 или 8 игроков, стартует, как только набран состав.
+	TournamentFormatSitAndGo = "sit_and_go"
+)
+
+// Статусы турнира.
+const (
+	TournamentStatusRegistering = "registering"
+	TournamentStatusRunning     = "running"
+	TournamentStatusFinished    = "finished"
+	TournamentStatusCancelled   = "cancelled"
+)
+
+// Tournament — турнир вместе с сеткой. Бай-ин игрока зарезервирован в user-service
+// под HoldReference, пока турнир не рассчитан или не отменён.
+type Tournament struct {
+	ID                   string
+	Name                 string
+	Format               string
+	Status               string
+	BuyIn                int64
+	MinPlayers           int
+	MaxPlayers           int
+	RegistrationOpensAt  time.Time
+	RegistrationClosesAt time.Time // для sit-and-go не задано: турнир стартует при полном составе
+	Players              []string  // в порядке регистрации, после старта — в порядке посева
+	Payouts              []int     // проценты призового фонда по местам, фиксируются при старте
+	Rounds               [][]*TournamentMatch
+	Standings            []TournamentStanding // итоговые места, заполняются при завершении
+	CreatedAt            time.Time
+	StartedAt            time.Time
+	FinishedAt           time.Time
+	Version              int64 // версия сохранённого состояния для compare-and-swap
+}
+
+// TournamentMatch — матч сетки. Пустой ID игрока означает, что соперник получил проход без игры.
+type TournamentMatch struct {
+	PlayerIDs [2]string
+	RoomID    string
+	WinnerID  string
+	Forfeit   bool      // победа присуждена, потому что соперник не пришёл
+	Deadline  time.Time // до этого времени игроки должны начать партию
+}
+
+// TournamentStanding — место игрока и его приз.
+type TournamentStanding struct {
+	PlayerID string
+	Place    int
+	Prize    int64
+}
+
+// HoldReference — под этой ссылкой в user-service зарезервированы бай-ины турнира. По префиксу
+// "tournament:" user-service отличает бай-ин от ставки партии и не блокирует из-за него бонусы.
+func (t *Tournament) HoldReference() string {
+	return "tournament:" + t.ID
+}
+
+// PrizePool — сумма бай-инов всех участников.
+func (t *Tournament) PrizePool() int64 {
+	return t.BuyIn * int64(len(t.Players))
+}
+
+// IsRegistered сообщает, зарегистрирован ли игрок.
+func (t *Tournament) IsRegistered(userID string) bool {
+	for _, p := range t.Players {
+		if p == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateTournamentParams — параметры турнира, который создаёт администратор.
+type CreateTournamentParams struct {
+	Name                 string
+	Format               string
+	BuyIn                int64
+	MinPlayers           int
+	MaxPlayers           int
+	RegistrationOpensAt  time.Time
+	RegistrationClosesAt time.Time
+}
+
+// TournamentRules — настраиваемые параметры турниров.
+type TournamentRules struct {
+	// NoShowTimeout — сколько у игроков есть времени начать партию матча, иначе засчитывается неявка
+	NoShowTimeout time.Duration
+	// HoldTTL — на сколько резервируется бай-ин. Sit-and-go, не набравший состав за половину
+	// этого срока, отменяется, а регистрация на турнир на выбывание не может длиться дольше
+	// половины, чтобы на сам турнир осталась вторая половина
+	HoldTTL time.Duration
+	// SchedulerLease — на сколько узел получает право вести турниры
+	SchedulerLease time.Duration
+	// SitAndGoSizes — размеры sit-and-go, для каждого всегда открыт один турнир
+	SitAndGoSizes []int
+	SitAndGoBuyIn int64
+	// Payouts — таблица выплат: размер поля -> проценты призового фонда по местам.
+	// Берётся строка для наибольшего размера, не превышающего число участников.
+	Payouts map[int][]int
+}
+
+// TournamentMatchStart — матч, для которого создана комната.
+type TournamentMatchStart struct {
+	TournamentID string
+	Round        int
+	RoomID       string
+	PlayerIDs    []string
+	Deadline     time.Time
+}
+
+// TournamentTick — что изменилось за один проход планировщика турниров.
+type TournamentTick struct {
+	StartedMatches []TournamentMatchStart
+	ClosedRooms    []ClosedRoom // комнаты сыгранных матчей
+	Finished       []*Tournament
+	Cancelled      []*Tournament
+}
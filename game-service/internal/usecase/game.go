@@ -5,14 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"game_svc/internal/adapter/ws/server/dto"
-	"game_svc/pkg/def"
 	"log"
+	"math"
 	"math/rand"
 	"strconv"
-	"strings"
 	"time"
 
 	"game_svc/internal/model"
+
+	"github.com/google/uuid"
 )
 
 // GameServiceImpl реализует GameUseCase.
@@ -20,14 +21,18 @@ type GameServiceImpl struct {
 	roomStateRepo   RoomStateRepository
 	producer        GameEventStorage
 	clientPresenter ClientPresenter
+	tournaments     TournamentResultRecorder
+	rules           model.GameRules
 }
 
 // NewGameService конструктор для GameServiceImpl.
-func NewGameService(rsr RoomStateRepository, pr GameEventStorage, presenter ClientPresenter) *GameServiceImpl {
+func NewGameService(rsr RoomStateRepository, pr GameEventStorage, presenter ClientPresenter, tournaments TournamentResultRecorder, rules model.GameRules) *GameServiceImpl {
 	return &GameServiceImpl{
 		roomStateRepo:   rsr,
 		producer:        pr,
 		clientPresenter: presenter,
+		tournaments:     tournaments,
+		rules:           rules,
 	}
 }
 
@@ -92,259 +97,321 @@ func (s *GameServiceImpl) PlayerReady(params model.PlayerReadyParams) (*model.Pl
 
 	log.Printf("Use Case PlayerReady: User %s in room %s set ready to %t", userID, roomID, isReady)
 
-	// 1. Обновляем статус готовности игрока в Redis
-	readyValue := "0"
-	if !isReady {
-		readyValue = "1"
-	}
-	if err := s.roomStateRepo.SetRoomField(ctx, roomID, fmt.Sprintf("readyStatus.%s", userID), readyValue); err != nil {
-		log.Printf("Use Case PlayerReady: Failed to set readyStatus for player %s in room %s: %v", userID, roomID, err)
-		return nil, fmt.Errorf("failed to update player ready status: %w", err)
-	}
-
-	// 2. Получаем текущее состояние комнаты, чтобы проверить готовность всех и собрать модель
-	roomStateMap, err := s.roomStateRepo.GetAllRoomFields(ctx, roomID)
-	if err != nil || len(roomStateMap) == 0 {
+	// 1. Получаем текущее состояние комнаты, чтобы проверить готовность всех и собрать модель
+	room, err := s.roomStateRepo.GetRoom(ctx, roomID)
+	if err != nil {
 		log.Printf("Use Case PlayerReady: Error retrieving room state for room %s or room not found: %v", roomID, err)
 		return nil, fmt.Errorf("room not found or error retrieving state: %w", err)
 	}
-
-	playerIDsStr := roomStateMap["players"]
-	allPlayerIDsInRoom := splitPlayers(playerIDsStr)
-
-	if len(allPlayerIDsInRoom) == 0 {
+	if len(room.Players) == 0 {
 		return nil, errors.New("no players found in room, cannot process ready status")
 	}
-	if len(allPlayerIDsInRoom) < 2 && isReady {
-		log.Printf("Use Case PlayerReady: Not enough players in room %s to start game.", roomID)
-		currentRoomModel := s.reconstructRoomModel(roomID, roomStateMap, allPlayerIDsInRoom, nil)
-		return &model.PlayerReadyResult{
-			UpdatedRoom:      currentRoomModel,
-			GameJustStarted:  false,
-			PlayerIDReady:    userID,
-			IsPlayerNowReady: isReady,
-		}, nil
+	if room.Status == "settling" {
+		return nil, model.ErrGameSettling
 	}
-
-	// 3. Проверяем, все ли игроки готовы (логика из allReady)
-	areAllPlayersReady := true
-	if len(allPlayerIDsInRoom) < 2 {
-		areAllPlayersReady = false
-	} else {
-		for _, pID := range allPlayerIDsInRoom {
-			var currentReadyStr string
-			if pID == userID {
-				currentReadyStr = readyValue
-			} else {
-				currentReadyStr = roomStateMap[fmt.Sprintf("readyStatus.%s", pID)]
-			}
-			if currentReadyStr != "1" {
-				areAllPlayersReady = false
-				break
-			}
-		}
+	// Готовность меняется только между партиями: иначе повторный "ready" посреди игры
+	// начал бы новую раздачу поверх текущей и оставил её резервы без расчёта
+	if room.Status != "waiting" {
+		return nil, model.ErrGameInProgress
+	}
+	player := findPlayer(room, userID)
+	if player == nil {
+		return nil, errors.New("player not in this room")
 	}
 
+	// 2. Статус готовности и, если все готовы, старт игры сохраняются одной записью
+	player.IsReady = !isReady
+
 	result := &model.PlayerReadyResult{
+		UpdatedRoom:      room,
 		PlayerIDReady:    userID,
 		IsPlayerNowReady: isReady,
 		GameJustStarted:  false,
 	}
 
-	if areAllPlayersReady && len(allPlayerIDsInRoom) == 2 {
-		log.Printf("Use Case PlayerReady: All %d players ready in room %s. Starting game.", len(allPlayerIDsInRoom), roomID)
-		result.GameJustStarted = true
-		result.RoomRemovedFromList = true
-
-		// --- Логика startGame ---
-		// Обновляем статус комнаты в Redis
-		if err := s.roomStateRepo.SetRoomField(ctx, roomID, "status", "in_progress"); err != nil {
-			log.Printf("Use Case PlayerReady: Failed to set room status to 'in_progress' for room %s: %v", roomID, err)
-			return nil, fmt.Errorf("failed to set room status: %w", err)
+	// 3. Проверяем, все ли игроки готовы (логика из allReady)
+	areAllPlayersReady := len(room.Players) == 2
+	for _, p := range room.Players {
+		if !p.IsReady {
+			areAllPlayersReady = false
+			break
 		}
-		roomStateMap["status"] = "in_progress"
+	}
 
-		turnPlayerID := allPlayerIDsInRoom[0]
-		if err := s.roomStateRepo.SetRoomField(ctx, roomID, "turn", turnPlayerID); err != nil {
-			log.Printf("Use Case PlayerReady: Failed to set turn for player %s in room %s: %v", turnPlayerID, roomID, err)
-			return nil, fmt.Errorf("failed to set turn: %w", err)
+	if !areAllPlayersReady {
+		log.Printf("Use Case PlayerReady: Not all players ready in room %s, or not enough players.", roomID)
+		if err := s.roomStateRepo.SaveRoom(ctx, room); err != nil {
+			log.Printf("Use Case PlayerReady: Failed to set readyStatus for player %s in room %s: %v", userID, roomID, err)
+			return nil, fmt.Errorf("failed to update player ready status: %w", err)
 		}
-		roomStateMap["turn"] = turnPlayerID
-
-		// Генерируем и перемешиваем колоду
-		gameDeck := generateShuffledDeckForGame()
-
-		// Раздаем по 2 карты каждому игроку
-		playerHands := make(map[string][]model.Card)
-		playerScores := make(map[string]int)
-
-		for _, pID := range allPlayerIDsInRoom {
-			var hand []model.Card
-			var card1, card2 model.Card
-			var deckAfterDeal1, deckAfterDeal2 []model.Card
-			var ok bool
-
-			card1, deckAfterDeal1, ok = dealCardFromDeck(gameDeck)
-			if !ok {
-				return nil, errors.New("deck ran out of cards during initial deal")
-			}
-			gameDeck = deckAfterDeal1
-
-			card2, deckAfterDeal2, ok = dealCardFromDeck(gameDeck)
-			if !ok {
-				return nil, errors.New("deck ran out of cards during initial deal")
-			}
-			gameDeck = deckAfterDeal2
+		return result, nil
+	}
+
+	log.Printf("Use Case PlayerReady: All %d players ready in room %s. Starting game.", len(room.Players), roomID)
+	result.GameJustStarted = true
+	result.RoomRemovedFromList = true
+
+	// --- Логика startGame ---
+	// Резервируем ставки обоих игроков в user-service до окончания игры
+	allPlayerIDsInRoom := playerIDs(room)
+	gameID := uuid.New().String()
+	if err := s.placeBetHolds(ctx, gameID, int64(room.Bet), allPlayerIDsInRoom); err != nil {
+		log.Printf("Use Case PlayerReady: Failed to hold bets for room %s: %v", roomID, err)
+		return nil, fmt.Errorf("failed to hold bets: %w", err)
+	}
+
+	room.GameID = gameID
+	room.Status = "in_progress"
+	room.CurrentTurnPlayerID = allPlayerIDsInRoom[0]
+
+	// Генерируем и перемешиваем колоду, раздаем по 2 карты каждому игроку
+	room.Deck = generateShuffledDeckForGame()
+	for _, p := range room.Players {
+		var card1, card2 model.Card
+		var ok1, ok2 bool
+		card1, room.Deck, ok1 = dealCardFromDeck(room.Deck)
+		card2, room.Deck, ok2 = dealCardFromDeck(room.Deck)
+		if !ok1 || !ok2 {
+			s.releaseBetHolds(ctx, gameID, allPlayerIDsInRoom)
+			return nil, errors.New("deck ran out of cards during initial deal")
+		}
+		p.Hand = []model.Card{card1, card2}
+		p.Score = calculateScoreForHand(p.Hand)
+	}
 
-			hand = []model.Card{card1, card2}
-			playerHands[pID] = hand
-			playerScores[pID] = calculateScoreForHand(hand)
+	// Если комнату успели изменить (кто-то вышел или уже начал игру), старт отклоняется и резервы снимаются
+	if err := s.roomStateRepo.SaveRoom(ctx, room); err != nil {
+		log.Printf("Use Case PlayerReady: Failed to start game in room %s: %v", roomID, err)
+		s.releaseBetHolds(ctx, gameID, allPlayerIDsInRoom)
+		return nil, fmt.Errorf("failed to start game: %w", err)
+	}
+	return result, nil
+}
 
-			// Обновляем руки и очки в Redis
-			handStr := serializeHand(hand)
-			if err := s.roomStateRepo.SetRoomField(ctx, roomID, fmt.Sprintf("hands.%s", pID), handStr); err != nil {
-				return nil, fmt.Errorf("failed to set hand for player %s: %w", pID, err)
-			}
-			if err := s.roomStateRepo.SetRoomField(ctx, roomID, fmt.Sprintf("scores.%s", pID), strconv.Itoa(playerScores[pID])); err != nil {
-				return nil, fmt.Errorf("failed to set score for player %s: %w", pID, err)
-			}
-			// Обновляем roomStateMap для конструирования модели
-			roomStateMap[fmt.Sprintf("hands.%s", pID)] = handStr
-			roomStateMap[fmt.Sprintf("scores.%s", pID)] = strconv.Itoa(playerScores[pID])
+// placeBetHolds резервирует ставку каждого игрока под gameID. Если хотя бы один
+// резерв не удался, уже поставленные резервы снимаются.
+func (s *GameServiceImpl) placeBetHolds(ctx context.Context, gameID string, bet int64, playerIDs []string) error {
+	if bet <= 0 {
+		return nil
+	}
+	for i, pID := range playerIDs {
+		pIDint, err := strconv.ParseInt(pID, 10, 64)
+		if err == nil {
+			err = s.clientPresenter.PlaceHold(ctx, model.ChipHold{UserID: pIDint, Amount: bet, Reference: gameID, TTL: s.rules.HoldTTL})
 		}
-		serializedDeck := serializeDeck(gameDeck)
-		err := s.roomStateRepo.SetRoomField(ctx, roomID, "deck", serializedDeck)
 		if err != nil {
-			log.Printf("Use Case LeaveRoom: Failed to set room status to 'waiting' for room %s: %v", roomID, err)
+			s.releaseBetHolds(ctx, gameID, playerIDs[:i])
+			return fmt.Errorf("player %s: %w", pID, err)
 		}
-		roomStateMap["deck"] = serializedDeck
-
-		result.UpdatedRoom = s.reconstructRoomModel(roomID, roomStateMap, allPlayerIDsInRoom, &gameDeck)
-		result.UpdatedRoom.Deck = gameDeck
-	} else {
-		log.Printf("Use Case PlayerReady: Not all players ready in room %s, or not enough players.", roomID)
-		result.UpdatedRoom = s.reconstructRoomModel(roomID, roomStateMap, allPlayerIDsInRoom, nil) // deck nil, т.к. игра не началась
 	}
-
-	return result, nil
+	return nil
 }
 
-func (s *GameServiceImpl) reconstructRoomModel(roomID string, roomStateMap map[string]string, playerIDs []string, deckToUse *[]model.Card) *model.Room {
-	playersInModel := make([]*model.Player, 0, len(playerIDs))
+// releaseBetHolds снимает резервы игроков. Снятие уже списанного резерва ничего не делает.
+func (s *GameServiceImpl) releaseBetHolds(ctx context.Context, gameID string, playerIDs []string) {
 	for _, pID := range playerIDs {
-		player := &model.Player{ID: pID}
-
-		readyStr := roomStateMap[fmt.Sprintf("readyStatus.%s", pID)] // Обновленное значение для текущего юзера уже должно быть в roomStateMap если мы его обновили
-		player.IsReady = (readyStr == "1")
+		pIDint, err := strconv.ParseInt(pID, 10, 64)
+		if err != nil {
+			continue
+		}
+		if err := s.clientPresenter.ReleaseHold(ctx, pIDint, gameID); err != nil {
+			log.Printf("Use Case: Failed to release hold of player %s for game %s: %v", pID, gameID, err)
+		}
+	}
+}
 
-		scoreStr := roomStateMap[fmt.Sprintf("scores.%s", pID)]
-		score, _ := strconv.Atoi(scoreStr)
-		player.Score = score
+// rakeFor считает комиссию дома с банка 2*bet. Комиссия не может превышать выигрыш.
+func (s *GameServiceImpl) rakeFor(bet int) int64 {
+	rake := int64(math.Round(float64(2*bet) * s.rules.RakePercent / 100))
+	if s.rules.RakeCap > 0 && rake > s.rules.RakeCap {
+		rake = s.rules.RakeCap
+	}
+	if rake > int64(bet) {
+		rake = int64(bet)
+	}
+	if rake < 0 {
+		rake = 0
+	}
+	return rake
+}
 
-		handStr := roomStateMap[fmt.Sprintf("hands.%s", pID)]
-		player.Hand = parseHandString(handStr)
+// isSuitedBlackjack — туз и десятка одной масти на первых двух картах разыгрывают джекпот.
+func isSuitedBlackjack(hand []model.Card) bool {
+	if len(hand) != 2 || hand[0].Suit != hand[1].Suit {
+		return false
+	}
+	isTen := func(v string) bool { return v == "10" || v == "J" || v == "Q" || v == "K" }
+	return (hand[0].Value == "A" && isTen(hand[1].Value)) || (hand[1].Value == "A" && isTen(hand[0].Value))
+}
 
-		player.LastAction = roomStateMap[fmt.Sprintf("lastAction.%s", pID)]
+// settleAttempts и settleRetryDelay ограничивают повторы расчёта внутри хода игрока.
+// Если user-service так и не ответил, расчёт повторяет уборщик через SettlePendingGames.
+const (
+	settleAttempts   = 3
+	settleRetryDelay = 200 * time.Millisecond
+)
 
-		playersInModel = append(playersInModel, player)
+// _endGameProcessing завершает игру. Сначала комната вместе с итогом партии сохраняется в
+// статусе "settling": проверка версии гарантирует, что игру завершит ровно один вызов, а новые
+// ходы отклоняются. Затем user-service рассчитывает игроков, и только после подтверждения
+// расчёта комната сбрасывается для новой игры. Если расчёт не прошёл, возвращается ошибка:
+// победителя не объявляют, комната остаётся в "settling", и расчёт повторит уборщик.
+// allPlayerIDs — игроки партии, включая того, кто уже вышел из комнаты.
+func (s *GameServiceImpl) _endGameProcessing(ctx context.Context, room *model.Room, result *model.Result, allPlayerIDs []string) (model.Payout, error) {
+	roomID, gameID := room.ID, room.GameID
+	log.Printf("Use Case: _endGameProcessing started for room %s (game %s). Winner: %s, Loser: %s, Bet: %d", roomID, gameID, result.Winner, result.Loser, room.Bet)
+
+	if gameID == "" {
+		log.Printf("Use Case _endGameProcessing: Room %s has no game id, nothing to settle.", roomID)
+		resetRoomForNextGame(room)
+		if err := s.roomStateRepo.SaveRoom(ctx, room); err != nil {
+			return model.Payout{}, fmt.Errorf("use Case: Failed to reset room %s: %w", roomID, err)
+		}
+		return model.Payout{}, nil
 	}
 
-	bet, _ := strconv.Atoi(roomStateMap["bet"])
-
-	roomModel := &model.Room{
-		ID:                  roomID,
-		Status:              roomStateMap["status"],
-		Bet:                 bet,
-		Players:             playersInModel,
-		CurrentTurnPlayerID: roomStateMap["turn"],
-		Deck:                []model.Card{},
+	settlement, err := s.settlementFor(gameID, result.Winner, result.Loser, room.Bet, allPlayerIDs, result.FinalHands)
+	if err != nil {
+		return model.Payout{}, err
 	}
-	if deckToUse != nil {
-		roomModel.Deck = *deckToUse
+	room.Status = "settling"
+	room.CurrentTurnPlayerID = ""
+	room.PendingGameEnd = &model.PendingGameEnd{
+		Settlement:  settlement,
+		Winner:      result.Winner,
+		Loser:       result.Loser,
+		FinalScores: result.FinalScores,
+		FinalHands:  result.FinalHands,
+		Stood:       result.Stood,
+	}
+	if err := s.roomStateRepo.SaveRoom(ctx, room); err != nil {
+		return model.Payout{}, fmt.Errorf("use Case: Failed to save result of game %s in room %s: %w", gameID, roomID, err)
 	}
 
-	return roomModel
-}
-
-func serializeHand(hand []model.Card) string {
-	if len(hand) == 0 {
-		return "nil"
+	payout, finished, err := s.settlePendingGame(ctx, room)
+	if err != nil {
+		return model.Payout{}, err
 	}
-	cardStrings := make([]string, len(hand))
-	for i, card := range hand {
-		cardStrings[i] = card.Value + card.Suit
+	if !finished {
+		return model.Payout{}, fmt.Errorf("use Case: Game %s in room %s was finished by another call: %w", gameID, roomID, model.ErrRoomStateConflict)
 	}
-	return strings.Join(cardStrings, ",")
+	return payout, nil
 }
 
-// serializeDeck converts a slice of model.Card (the deck) into a comma-separated string.
-func serializeDeck(deck []model.Card) string {
-	if len(deck) == 0 {
-		return ""
+// settlementFor переводит ставку проигравшего победителю за вычетом комиссии дома.
+// Ничья или игра без ставки просто возвращают фишки игрокам.
+func (s *GameServiceImpl) settlementFor(gameID, winnerID, loserID string, bet int, allPlayerIDs []string, finalHands map[string][]model.Card) (model.Settlement, error) {
+	hasWinner := winnerID != "" && winnerID != "0" && loserID != "" && loserID != "0" && bet > 0
+	settlement := model.Settlement{GameID: gameID, Deltas: make([]model.PlayerDelta, 0, len(allPlayerIDs))}
+	if hasWinner {
+		settlement.Rake = s.rakeFor(bet)
+		settlement.JackpotContribution = int64(float64(settlement.Rake) * s.rules.JackpotSharePercent / 100)
 	}
-	cardStrings := make([]string, len(deck))
-	for i, card := range deck {
-		cardStrings[i] = card.Value + card.Suit
+	for _, pID := range allPlayerIDs {
+		pIDint, err := strconv.ParseInt(pID, 10, 64)
+		if err != nil {
+			return model.Settlement{}, fmt.Errorf("use Case: Failed to parse player id %s: %w", pID, err)
+		}
+		delta := int64(0)
+		if hasWinner && pID == winnerID {
+			delta = int64(bet) - settlement.Rake
+			if isSuitedBlackjack(finalHands[pID]) {
+				settlement.JackpotWinnerID = pIDint
+			}
+		} else if hasWinner && pID == loserID {
+			delta = -int64(bet)
+		}
+		settlement.Deltas = append(settlement.Deltas, model.PlayerDelta{UserID: pIDint, Delta: delta})
 	}
-	return strings.Join(cardStrings, ",")
+	return settlement, nil
 }
 
-func (s *GameServiceImpl) _endGameProcessing(ctx context.Context, roomID string, winnerID, loserID string, bet int, allPlayerIDs []string, finalHands map[string][]model.Card) error {
-	log.Printf("Use Case: _endGameProcessing started for room %s. Winner: %s, Loser: %s, Bet: %d", roomID, winnerID, loserID, bet)
+// settlePendingGame рассчитывает партию, сохранённую в комнате в статусе "settling", и сбрасывает
+// комнату для новой игры; опустевшая комната удаляется. finished == false значит, что партию
+// уже завершил другой вызов и объявлять её второй раз не нужно.
+func (s *GameServiceImpl) settlePendingGame(ctx context.Context, room *model.Room) (payout model.Payout, finished bool, err error) {
+	roomID, gameID := room.ID, room.GameID
+	pending := room.PendingGameEnd
 
-	// 1. Обновляем балансы игроков (если есть победитель и проигравший, и ставка > 0)
-	if winnerID != "" && winnerID != "0" && loserID != "" && loserID != "0" && bet > 0 {
-		winnerIDint, err := strconv.ParseInt(winnerID, 10, 64)
-		loserIDint, err := strconv.ParseInt(loserID, 10, 64)
-		if err != nil {
-			return fmt.Errorf("use Case: Failed to parse winnerID: %w", err)
+	// Расчёт идемпотентен по ID игры, поэтому повтор после таймаута ничего не спишет дважды
+	jackpotPayout, err := s.settleWithRetry(ctx, pending.Settlement)
+	if err != nil {
+		return model.Payout{}, false, fmt.Errorf("use Case: Failed to settle game %s, room %s stays pending: %w", gameID, roomID, err)
+	}
+	payout = model.Payout{Rake: pending.Settlement.Rake, JackpotPayout: jackpotPayout}
+
+	for {
+		resetRoomForNextGame(room)
+		if len(room.Players) == 0 {
+			err = s.roomStateRepo.DeleteRoom(ctx, room)
+		} else {
+			err = s.roomStateRepo.SaveRoom(ctx, room)
 		}
-		_, err = s.clientPresenter.AddBalance(ctx, model.User{ID: winnerIDint, Balance: def.Pointer(int64(bet))})
-		if err != nil {
-			return err
+		if err == nil {
+			break
 		}
-		_, err = s.clientPresenter.SubtractBalance(ctx, model.User{ID: loserIDint, Balance: def.Pointer(int64(bet))})
-		if err != nil {
-			return err
+		if !errors.Is(err, model.ErrRoomStateConflict) {
+			return model.Payout{}, false, fmt.Errorf("use Case: Game %s is settled but room %s was not reset: %w", gameID, roomID, err)
 		}
-		log.Printf("Use Case: Winner %s gets %d, Loser %s loses %d", winnerID, bet, loserID, bet)
-	}
-
-	finalHandsStrForHistory := make(map[string][]string)
-	if finalHands != nil {
-		for pID, hand := range finalHands {
-			handS := make([]string, len(hand))
-			for i, card := range hand {
-				handS[i] = card.Value + card.Suit
-			}
-			finalHandsStrForHistory[pID] = handS
+		// Пока шёл расчёт, игрок вышел из комнаты; сбрасываем её свежую версию
+		fresh, errGet := s.roomStateRepo.GetRoom(ctx, roomID)
+		if errors.Is(errGet, model.ErrRoomNotFound) {
+			return model.Payout{}, false, nil
+		}
+		if errGet != nil {
+			return model.Payout{}, false, fmt.Errorf("use Case: Game %s is settled but room %s was not reset: %w", gameID, roomID, errGet)
+		}
+		if fresh.Status != "settling" || fresh.GameID != gameID {
+			return model.Payout{}, false, nil
 		}
+		*room = *fresh
 	}
+	log.Printf("Use Case _endGameProcessing: Game %s settled, room %s state fully reset for new game.", gameID, roomID)
 
-	// 3. Сбрасываем состояние комнаты и игроков в Redis для новой игры
-	log.Printf("Use Case _endGameProcessing: Resetting Redis state for room %s.", roomID)
-
-	// Сбрасываем общие поля комнаты
-	if err := s.roomStateRepo.SetRoomField(ctx, roomID, "status", "waiting"); err != nil {
-		log.Printf("Use Case _endGameProcessing: Error resetting room status for room %s: %v", roomID, err)
-		// Можно рассмотреть возврат ошибки, если это критично
-	}
-	if err := s.roomStateRepo.SetRoomField(ctx, roomID, "turn", ""); err != nil {
-		log.Printf("Use Case _endGameProcessing: Error resetting turn for room %s: %v", roomID, err)
+	if pending.Settlement.Rake > 0 {
+		log.Printf("Use Case: Winner %s gets %d (rake %d), Loser %s loses %d", pending.Winner, int64(room.Bet)-pending.Settlement.Rake, pending.Settlement.Rake, pending.Loser, room.Bet)
 	}
-	if err := s.roomStateRepo.SetRoomField(ctx, roomID, "deck", ""); err != nil { // Очищаем колоду
-		log.Printf("Use Case _endGameProcessing: Error clearing deck for room %s: %v", roomID, err)
+	if payout.JackpotPayout > 0 {
+		log.Printf("Use Case: Player %s hit the jackpot of %d in game %s", pending.Winner, payout.JackpotPayout, gameID)
 	}
+	return payout, true, nil
+}
 
-	// Сбрасываем состояние каждого игрока, используя существующий метод репозитория
-	for _, pID := range allPlayerIDs {
-		if err := s.roomStateRepo.ResetPlayerState(ctx, roomID, pID); err != nil {
-			log.Printf("Use Case _endGameProcessing: Error resetting state for player %s in room %s: %v", pID, roomID, err)
+// settleWithRetry вызывает SettleMatch до settleAttempts раз с растущей паузой.
+func (s *GameServiceImpl) settleWithRetry(ctx context.Context, settlement model.Settlement) (int64, error) {
+	var err error
+	for attempt := 1; attempt <= settleAttempts; attempt++ {
+		var jackpotPayout int64
+		if jackpotPayout, err = s.clientPresenter.SettleMatch(ctx, settlement); err == nil {
+			return jackpotPayout, nil
+		}
+		log.Printf("Use Case: Attempt %d to settle game %s failed: %v", attempt, settlement.GameID, err)
+		if attempt < settleAttempts {
+			select {
+			case <-ctx.Done():
+				return 0, ctx.Err()
+			case <-time.After(time.Duration(attempt) * settleRetryDelay):
+			}
 		}
 	}
+	return 0, err
+}
 
-	log.Printf("Use Case _endGameProcessing: Room %s state fully reset for new game.", roomID)
-	return nil
+// publishGameEnd дополняет итог партии выплатами, передаёт его турниру и публикует GameEnd.
+func (s *GameServiceImpl) publishGameEnd(ctx context.Context, room *model.Room, result *model.Result, payout model.Payout) error {
+	result.Rake, result.JackpotPayout, result.Ranked = payout.Rake, payout.JackpotPayout, room.Ranked
+	s.recordTournamentResult(ctx, room, result.Winner)
+	return s.producer.PushGameEnd(ctx, result, int64(room.Bet))
+}
+
+// recordTournamentResult передаёт итог партии турниру, если в комнате играется его матч.
+func (s *GameServiceImpl) recordTournamentResult(ctx context.Context, room *model.Room, winnerID string) {
+	if room.TournamentID == "" || s.tournaments == nil {
+		return
+	}
+	if err := s.tournaments.RecordMatchResult(ctx, room.TournamentID, room.ID, winnerID); err != nil {
+		log.Printf("Use Case: Failed to record result of room %s in tournament %s: %v", room.ID, room.TournamentID, err)
+	}
 }
 
 func (s *GameServiceImpl) Hit(params model.HitParams) (*model.Result, error) {
@@ -355,96 +422,74 @@ func (s *GameServiceImpl) Hit(params model.HitParams) (*model.Result, error) {
 
 	result := &model.Result{RoomID: roomID, PlayerID: userID}
 
-	roomStateMap, err := s.roomStateRepo.GetAllRoomFields(ctx, roomID)
-	if err != nil || len(roomStateMap) == 0 {
+	room, err := s.roomStateRepo.GetRoom(ctx, roomID)
+	if err != nil {
 		return nil, fmt.Errorf("room %s not found or error retrieving state: %w", roomID, err)
 	}
 
-	if roomStateMap["status"] != "in_progress" {
+	if room.Status != "in_progress" {
 		return nil, errors.New("game is not in progress")
 	}
-	if roomStateMap["turn"] != userID {
+	if room.CurrentTurnPlayerID != userID {
 		return nil, errors.New("not your turn")
 	}
-
-	deckStr := roomStateMap["deck"]
-	currentDeck := parseHandString(deckStr)
-	if len(currentDeck) == 0 {
-		return nil, errors.New("deck is empty, cannot hit")
+	if len(room.Players) != 2 {
+		return nil, errors.New("invalid number of players for hit action")
+	}
+	player := findPlayer(room, userID)
+	if player == nil {
+		return nil, errors.New("player not in this room")
+	}
+	opponent := room.Players[0]
+	if opponent.ID == userID {
+		opponent = room.Players[1]
 	}
 
-	dealtCard, updatedDeck, dealtOK := dealCardFromDeck(currentDeck)
+	dealtCard, updatedDeck, dealtOK := dealCardFromDeck(room.Deck)
 	if !dealtOK {
-		return nil, errors.New("failed to deal card from deck")
+		return nil, errors.New("deck is empty, cannot hit")
 	}
 	result.DealtCard = &dealtCard
 
-	playerHandStr := roomStateMap[fmt.Sprintf("hands.%s", userID)]
-	playerHand := parseHandString(playerHandStr)
-	playerHand = append(playerHand, dealtCard)
+	playerHand := append(append([]model.Card{}, player.Hand...), dealtCard)
 	result.PlayerHand = &playerHand
 	newScore := calculateScoreForHand(playerHand)
 	result.NewScore = &newScore
 
-	allPlayerIDs := splitPlayers(roomStateMap["players"])
-	opponentID := ""
-	if len(allPlayerIDs) == 2 {
-		if allPlayerIDs[0] == userID {
-			opponentID = allPlayerIDs[1]
-		} else {
-			opponentID = allPlayerIDs[0]
-		}
-	} else {
-		return nil, errors.New("invalid number of players for hit action")
-	}
-
 	if newScore > 21 {
 		result.IsBusted = true
 		result.GameEnded = true
-		result.Winner = opponentID
+		result.Winner = opponent.ID
 		result.Loser = userID
 
 		// Собираем FinalScores и FinalHands
-		result.FinalScores = make(map[string]int)
-		result.FinalHands = make(map[string][]model.Card)
-		result.FinalScores[userID] = newScore
-		result.FinalHands[userID] = playerHand
-
-		opponentHand := parseHandString(roomStateMap[fmt.Sprintf("hands.%s", opponentID)])
-		opponentScore, _ := strconv.Atoi(roomStateMap[fmt.Sprintf("scores.%s", opponentID)])
-		result.FinalScores[opponentID] = opponentScore
-		result.FinalHands[opponentID] = opponentHand
-
-		roomBet, _ := strconv.Atoi(roomStateMap["bet"])
-		errEnd := s._endGameProcessing(ctx, roomID, result.Winner, result.Loser, roomBet, allPlayerIDs, result.FinalHands)
+		result.FinalScores = map[string]int{userID: newScore, opponent.ID: opponent.Score}
+		result.FinalHands = map[string][]model.Card{userID: playerHand, opponent.ID: opponent.Hand}
+		result.Stood = map[string]bool{userID: false, opponent.ID: opponent.Stood}
+
+		payout, errEnd := s._endGameProcessing(ctx, room, result, playerIDs(room))
 		if errEnd != nil {
 			log.Printf("Use Case Hit: Error during _endGameProcessing for room %s after bust: %v", roomID, errEnd)
-		} else {
-			err := s.producer.PushGameEnd(ctx, result, int64(roomBet))
-			if err != nil {
-				return nil, err
-			}
+			return nil, errEnd
 		}
-	} else {
-		result.IsBusted = false
-		result.GameEnded = false
-		result.NextTurnPlayerID = opponentID
+		if err := s.publishGameEnd(ctx, room, result, payout); err != nil {
+			return nil, err
+		}
+		return result, nil
 	}
 
-	// Сохранение изменений в Redis
-	pipeCmds := make(map[string]interface{})
-	pipeCmds[fmt.Sprintf("hands.%s", userID)] = serializeHand(playerHand)
-	pipeCmds[fmt.Sprintf("scores.%s", userID)] = strconv.Itoa(newScore)
-	pipeCmds["deck"] = serializeDeck(updatedDeck)
-	if !result.GameEnded {
-		pipeCmds["turn"] = result.NextTurnPlayerID
-	}
+	result.IsBusted = false
+	result.GameEnded = false
+	result.NextTurnPlayerID = opponent.ID
 
-	for field, value := range pipeCmds {
-		if errSet := s.roomStateRepo.SetRoomField(ctx, roomID, field, value); errSet != nil {
-			log.Printf("Use Case Hit: Failed to set Redis field %s for room %s: %v", field, roomID, errSet)
-			// Рассмотреть ошибку. Возможно, вернуть ее, если критично.
-		}
+	// Карта, очки, колода и передача хода сохраняются одной записью
+	player.Hand = playerHand
+	player.Score = newScore
+	room.Deck = updatedDeck
+	room.CurrentTurnPlayerID = opponent.ID
+	if err := s.roomStateRepo.SaveRoom(ctx, room); err != nil {
+		log.Printf("Use Case Hit: Failed to save hit of player %s in room %s: %v", userID, roomID, err)
+		return nil, fmt.Errorf("failed to save hit: %w", err)
 	}
 	return result, nil
 }
@@ -457,92 +502,80 @@ func (s *GameServiceImpl) Stand(params model.StandParams) (*model.Result, error)
 
 	result := &model.Result{RoomID: roomID, PlayerID: userID}
 
-	roomStateMap, err := s.roomStateRepo.GetAllRoomFields(ctx, roomID)
-	if err != nil || len(roomStateMap) == 0 {
+	room, err := s.roomStateRepo.GetRoom(ctx, roomID)
+	if err != nil {
 		return nil, fmt.Errorf("room %s not found or error retrieving state: %w", roomID, err)
 	}
 
-	if roomStateMap["status"] != "in_progress" {
+	if room.Status != "in_progress" {
 		return nil, errors.New("game is not in progress")
 	}
-	if roomStateMap["turn"] != userID {
+	if room.CurrentTurnPlayerID != userID {
 		return nil, errors.New("not your turn")
 	}
-
-	if err := s.roomStateRepo.SetRoomField(ctx, roomID, fmt.Sprintf("stood.%s", userID), "1"); err != nil {
-		return nil, fmt.Errorf("failed to set stood status for player %s: %w", userID, err)
-	}
-	roomStateMap[fmt.Sprintf("stood.%s", userID)] = "1"
-
-	allPlayerIDs := splitPlayers(roomStateMap["players"])
-	opponentID := ""
-	player1ID, player2ID := "", ""
-	if len(allPlayerIDs) == 2 {
-		player1ID = allPlayerIDs[0]
-		player2ID = allPlayerIDs[1]
-		if player1ID == userID {
-			opponentID = player2ID
-		} else {
-			opponentID = player1ID
-		}
-	} else {
+	if len(room.Players) != 2 {
 		return nil, errors.New("invalid number of players for stand action")
 	}
+	player := findPlayer(room, userID)
+	if player == nil {
+		return nil, errors.New("player not in this room")
+	}
+	opponent := room.Players[0]
+	if opponent.ID == userID {
+		opponent = room.Players[1]
+	}
+	opponentID := opponent.ID
 
-	scoreUser, _ := strconv.Atoi(roomStateMap[fmt.Sprintf("scores.%s", userID)])
-	scoreOpponent, _ := strconv.Atoi(roomStateMap[fmt.Sprintf("scores.%s", opponentID)])
+	scoreUser, scoreOpponent := player.Score, opponent.Score
 	result.PlayerCurrentScore = &scoreUser
 	result.AllPlayerScores = &map[string]int{userID: scoreUser, opponentID: scoreOpponent}
 
-	opponentStood := roomStateMap[fmt.Sprintf("stood.%s", opponentID)] == "1"
-	opponentBusted := false
-	if scoreOpponent > 21 {
-		opponentBusted = true
-	}
-
-	if opponentStood || opponentBusted {
-		result.GameEnded = true
-		log.Printf("Use Case Stand: Game ending condition met in room %s. Player %s stood. Opponent stood: %t, Opponent busted: %t", roomID, userID, opponentStood, opponentBusted)
-
-		// Собираем руки
-		playerHand := parseHandString(roomStateMap[fmt.Sprintf("hands.%s", userID)])
-		opponentHand := parseHandString(roomStateMap[fmt.Sprintf("hands.%s", opponentID)])
-		result.FinalHands = map[string][]model.Card{userID: playerHand, opponentID: opponentHand}
-		result.FinalScores = map[string]int{userID: scoreUser, opponentID: scoreOpponent}
-
-		if scoreUser > 21 {
-			result.Winner = opponentID
-			result.Loser = userID
-		} else if scoreOpponent > 21 {
-			result.Winner = userID
-			result.Loser = opponentID
-		} else if scoreUser > scoreOpponent {
-			result.Winner = userID
-			result.Loser = opponentID
-		} else if scoreOpponent > scoreUser {
-			result.Winner = opponentID
-			result.Loser = userID
-		} else { // Ничья
-			result.Winner = "0"
-			result.Loser = "0"
-		}
+	opponentStood := opponent.Stood
+	opponentBusted := scoreOpponent > 21
 
-		roomBet, _ := strconv.Atoi(roomStateMap["bet"])
-		errEnd := s._endGameProcessing(ctx, roomID, result.Winner, result.Loser, roomBet, allPlayerIDs, result.FinalHands)
-		if errEnd != nil {
-			log.Printf("Use Case Stand: Error during _endGameProcessing for room %s: %v", roomID, errEnd)
-		} else {
-			err := s.producer.PushGameEnd(ctx, result, int64(roomBet))
-			if err != nil {
-				return nil, err
-			}
-		}
-	} else {
+	if !opponentStood && !opponentBusted {
 		result.GameEnded = false
 		result.NextTurnPlayerID = opponentID
-		if err := s.roomStateRepo.SetRoomField(ctx, roomID, "turn", opponentID); err != nil {
-			log.Printf("Use Case Stand: Failed to set turn for room %s: %v", roomID, err)
+		player.Stood = true
+		room.CurrentTurnPlayerID = opponentID
+		if err := s.roomStateRepo.SaveRoom(ctx, room); err != nil {
+			log.Printf("Use Case Stand: Failed to save stand of player %s in room %s: %v", userID, roomID, err)
+			return nil, fmt.Errorf("failed to save stand: %w", err)
 		}
+		return result, nil
+	}
+
+	result.GameEnded = true
+	log.Printf("Use Case Stand: Game ending condition met in room %s. Player %s stood. Opponent stood: %t, Opponent busted: %t", roomID, userID, opponentStood, opponentBusted)
+
+	result.FinalHands = map[string][]model.Card{userID: player.Hand, opponentID: opponent.Hand}
+	result.FinalScores = map[string]int{userID: scoreUser, opponentID: scoreOpponent}
+	result.Stood = map[string]bool{userID: true, opponentID: opponentStood}
+
+	if scoreUser > 21 {
+		result.Winner = opponentID
+		result.Loser = userID
+	} else if scoreOpponent > 21 {
+		result.Winner = userID
+		result.Loser = opponentID
+	} else if scoreUser > scoreOpponent {
+		result.Winner = userID
+		result.Loser = opponentID
+	} else if scoreOpponent > scoreUser {
+		result.Winner = opponentID
+		result.Loser = userID
+	} else { // Ничья
+		result.Winner = "0"
+		result.Loser = "0"
+	}
+
+	payout, errEnd := s._endGameProcessing(ctx, room, result, playerIDs(room))
+	if errEnd != nil {
+		log.Printf("Use Case Stand: Error during _endGameProcessing for room %s: %v", roomID, errEnd)
+		return nil, errEnd
+	}
+	if err := s.publishGameEnd(ctx, room, result, payout); err != nil {
+		return nil, err
 	}
 	return result, nil
 }
@@ -556,171 +589,96 @@ func (s *GameServiceImpl) HandlePlayerDisconnect(disconnectedUserID string, room
 		RoomID:       roomID,
 	}
 
-	roomStateMap, err := s.roomStateRepo.GetAllRoomFields(ctx, roomID)
-	if err != nil {
-		log.Printf("Use Case HandlePlayerDisconnect: Error retrieving room state for room %s: %v", roomID, err)
-		return nil, fmt.Errorf("error retrieving room state: %w", err)
-	}
-	if len(roomStateMap) == 0 {
+	room, err := s.roomStateRepo.GetRoom(ctx, roomID)
+	if errors.Is(err, model.ErrRoomNotFound) {
 		log.Printf("Use Case HandlePlayerDisconnect: Room %s not found in Redis. Already deleted or never existed.", roomID)
 		response.IsRoomDeleted = true
 		response.RoomRemovedFromList = true // Если комнаты нет, ее точно нет в списке
 		return response, nil                // Комнаты нет, делать нечего
 	}
-
-	currentPlayersStr := roomStateMap["players"]
-	allPlayerIDsInRoom := splitPlayers(currentPlayersStr)
-
-	// Проверяем, был ли игрок действительно в этой комнате (на случай гонки состояний)
-	wasPlayerInRoom := false
-	var remainingPlayerIDs []string
-	for _, pID := range allPlayerIDsInRoom {
-		if pID == disconnectedUserID {
-			wasPlayerInRoom = true
-		} else {
-			remainingPlayerIDs = append(remainingPlayerIDs, pID)
-		}
+	if err != nil {
+		log.Printf("Use Case HandlePlayerDisconnect: Error retrieving room state for room %s: %v", roomID, err)
+		return nil, fmt.Errorf("error retrieving room state: %w", err)
 	}
 
-	if !wasPlayerInRoom {
-		log.Printf("Use Case HandlePlayerDisconnect: Player %s was not found in room %s's player list (%s). No action taken.", disconnectedUserID, roomID, currentPlayersStr)
-		// Игрок уже был удален или его там не было. Возвращаем текущее состояние (комната не удалена этим вызовом).
-		// response.IsRoomDeleted = false; // По умолчанию
-		// response.RoomRemovedFromList = false; // По умолчанию
-		return response, nil // Или вернуть ошибку "player not found in room"
-	}
+	allPlayerIDsInRoom := playerIDs(room)
+	gameStatus := room.Status
 
-	// Обновляем список игроков в Redis, удаляя отключившегося
-	updatedPlayersStrRedis := strings.Join(remainingPlayerIDs, ",")
-	if err := s.roomStateRepo.UpdatePlayerList(ctx, roomID, updatedPlayersStrRedis); err != nil {
-		log.Printf("Use Case HandlePlayerDisconnect: Failed to update players list in Redis for room %s: %v", roomID, err)
-		return nil, fmt.Errorf("failed to update players list in Redis: %w", err)
+	// Руки и очки до выхода игрока нужны для расчёта и для GameEndData
+	currentHands := make(map[string][]model.Card)
+	currentScores := make(map[string]int)
+	currentStood := make(map[string]bool)
+	for _, p := range room.Players {
+		currentHands[p.ID] = p.Hand
+		currentScores[p.ID] = p.Score
+		currentStood[p.ID] = p.Stood
 	}
 
-	// Удаляем специфичные для отключившегося игрока поля
-	if err := s.roomStateRepo.DeletePlayerSpecificFields(ctx, roomID, disconnectedUserID, playerSpecificBaseFields); err != nil {
-		log.Printf("Use Case HandlePlayerDisconnect: Warning - failed to delete specific fields for player %s in room %s: %v", disconnectedUserID, roomID, err)
+	// Проверяем, был ли игрок действительно в этой комнате (на случай гонки состояний)
+	if !removePlayer(room, disconnectedUserID) {
+		log.Printf("Use Case HandlePlayerDisconnect: Player %s was not found in room %s's player list (%v). No action taken.", disconnectedUserID, roomID, allPlayerIDsInRoom)
+		// Игрок уже был удален или его там не было. Возвращаем текущее состояние (комната не удалена этим вызовом).
+		return response, nil
 	}
+	remainingPlayerIDs := playerIDs(room)
 
 	// Логика для игры 1 на 1: если один игрок отключается, другой выигрывает, игра завершается.
-	roomBet, _ := strconv.Atoi(roomStateMap["bet"])
-	gameStatus := roomStateMap["status"]
-
 	if len(allPlayerIDsInRoom) == 2 && gameStatus == "in_progress" { // Если было 2 игрока и игра шла
 		response.GameEnded = true
 		response.RoomRemovedFromList = true // После завершения игры комната обычно убирается из активных списков или сбрасывается
 
-		opponentID := ""
-		if remainingPlayerIDs[0] != "" { // Должен остаться один
-			opponentID = remainingPlayerIDs[0]
+		opponentID := remainingPlayerIDs[0]
+		response.GameEndData = &dto.GameEndData{
+			RoomID:  roomID,
+			Winner:  opponentID, // Оставшийся игрок выигрывает
+			Loser:   disconnectedUserID,
+			Scores:  currentScores,
+			Hands:   currentHands,
+			Message: fmt.Sprintf("Player %s disconnected, player %s wins by default.", disconnectedUserID, opponentID),
 		}
 
-		if opponentID != "" {
-			response.GameEndData = &dto.GameEndData{ // GameEndData из usecase
-				RoomID:  roomID,
-				Winner:  opponentID, // Оставшийся игрок выигрывает
-				Loser:   disconnectedUserID,
-				Scores:  map[string]int{},          // Очки могут быть неактуальны или их нужно собрать из roomStateMap
-				Hands:   map[string][]model.Card{}, // Руки тоже
-				Message: fmt.Sprintf("Player %s disconnected, player %s wins by default.", disconnectedUserID, opponentID),
-			}
-			// Заполняем Scores и Hands для GameEndData из roomStateMap, если это нужно для истории
-			// Собираем руки и очки для _endGameProcessing и для GameEndData
-			currentHands := make(map[string][]model.Card)
-			currentScores := make(map[string]int)
-
-			for _, pID := range allPlayerIDsInRoom {
-				handStr := roomStateMap["hands."+pID]
-				currentHands[pID] = parseHandString(handStr)
-				scoreStr := roomStateMap["score."+pID]
-				score, _ := strconv.Atoi(scoreStr)
-				currentScores[pID] = score
-			}
-			response.GameEndData.Scores = currentScores
-			response.GameEndData.Hands = currentHands
-
-			// Вызываем _endGameProcessing для обновления балансов, истории и сброса комнаты
-			result := model.Result{
-				RoomID:      roomID,
-				Winner:      opponentID,
-				Loser:       disconnectedUserID,
-				FinalHands:  currentHands,
-				FinalScores: currentScores,
-			}
-			err := s._endGameProcessing(ctx, roomID, opponentID, disconnectedUserID, roomBet, allPlayerIDsInRoom, currentHands)
-			if err != nil {
-				log.Printf("Use Case HandlePlayerDisconnect: Error during _endGameProcessing for room %s: %v", roomID, err)
-			} else {
-				err := s.producer.PushGameEnd(ctx, &result, int64(roomBet))
-				if err != nil {
-					return nil, err
-				}
-			}
-		} else {
-			log.Printf("Use Case HandlePlayerDisconnect: Room %s had 2 players, but opponent ID not found after disconnect.", roomID)
-			response.GameEndData = &dto.GameEndData{RoomID: roomID, Winner: "0", Message: "Game ended due to disconnect, no winner determined."} // Ничья или системная ошибка
-			err := s._endGameProcessing(ctx, roomID, "0", "0", roomBet, allPlayerIDsInRoom, nil)
-			if err != nil {
-				log.Printf("Use Case HandlePlayerDisconnect: Error during _endGameProcessing for room %s with no winner: %v", roomID, err)
-			} else {
-				result := model.Result{
-					RoomID:      roomID,
-					Winner:      "0",
-					Loser:       "0",
-					FinalHands:  map[string][]model.Card{},
-					FinalScores: map[string]int{},
-				}
-				err := s.producer.PushGameEnd(ctx, &result, int64(roomBet))
-				if err != nil {
-					return nil, err
-				}
-			}
+		// Уход игрока сохраняется вместе с итогом партии, затем игроки рассчитываются
+		result := model.Result{
+			RoomID:      roomID,
+			Winner:      opponentID,
+			Loser:       disconnectedUserID,
+			FinalHands:  currentHands,
+			FinalScores: currentScores,
+			Stood:       currentStood,
 		}
-	} else if len(remainingPlayerIDs) == 0 { // Если комната стала пустой
-		response.IsRoomDeleted = true
-		response.RoomRemovedFromList = true
-		log.Printf("Use Case HandlePlayerDisconnect: Room %s is now empty. Deleting from Redis.", roomID)
-		if delErr := s.roomStateRepo.DeleteRoom(ctx, roomID); delErr != nil {
-			log.Printf("Use Case HandlePlayerDisconnect: Failed to delete empty room %s from Redis: %v", roomID, delErr)
-			response.IsRoomDeleted = false // Не удалось удалить, флаг сбрасываем
+		payout, err := s._endGameProcessing(ctx, room, &result, allPlayerIDsInRoom)
+		if err != nil {
+			log.Printf("Use Case HandlePlayerDisconnect: Error during _endGameProcessing for room %s: %v", roomID, err)
+			return nil, err
 		}
-	} else if len(remainingPlayerIDs) == 1 && gameStatus == "waiting" { // Если остался 1 игрок и игра не шла (ожидание)
-		// Сбрасываем состояние оставшегося игрока (ready=false, score=0)
-		// и статус комнаты на "waiting", если он был другим.
-		remainingSingleID := remainingPlayerIDs[0]
-		log.Printf("Use Case HandlePlayerDisconnect: One player %s remains in waiting room %s. Resetting their state.", remainingSingleID, roomID)
-		if err := s.roomStateRepo.ResetPlayerState(ctx, roomID, remainingSingleID); err != nil {
-			log.Printf("Use Case HandlePlayerDisconnect: Failed to reset state for remaining player %s: %v", remainingSingleID, err)
+		if err := s.publishGameEnd(ctx, room, &result, payout); err != nil {
+			return nil, err
 		}
-		if roomStateMap["status"] != "waiting" {
-			if err := s.roomStateRepo.SetRoomField(ctx, roomID, "status", "waiting"); err != nil {
-				log.Printf("Use Case HandlePlayerDisconnect: Failed to set room status to waiting: %v", err)
-			}
+	} else if len(remainingPlayerIDs) == 0 && gameStatus != "settling" { // Если комната стала пустой
+		log.Printf("Use Case HandlePlayerDisconnect: Room %s is now empty. Deleting from Redis.", roomID)
+		if err := s.roomStateRepo.DeleteRoom(ctx, room); err != nil {
+			log.Printf("Use Case HandlePlayerDisconnect: Failed to delete empty room %s from Redis: %v", roomID, err)
+			return nil, fmt.Errorf("failed to delete empty room: %w", err)
 		}
-		response.RoomRemovedFromList = true // Комната изменилась, список нужно обновить
-	}
-
-	// Заполняем RemainingPlayersInRoom в ответе
-	if !response.IsRoomDeleted && len(remainingPlayerIDs) > 0 {
-		currentRoomStateAfterUpdates, _ := s.roomStateRepo.GetAllRoomFields(ctx, roomID) // Перечитываем, чтобы получить актуальные сброшенные значения
-
-		for _, pID := range remainingPlayerIDs {
-			player := &model.Player{ID: pID}
-			if currentRoomStateAfterUpdates != nil { // Если комната еще существует
-				readyStr := currentRoomStateAfterUpdates["readyStatus."+pID]
-				player.IsReady = (readyStr == "1")
-				scoreStr := currentRoomStateAfterUpdates["score."+pID]
-				score, _ := strconv.Atoi(scoreStr)
-				player.Score = score
-				player.Hand = parseHandString(currentRoomStateAfterUpdates["hands."+pID])
-				player.LastAction = currentRoomStateAfterUpdates["lastAction."+pID]
-			} else {
-				break
-			}
-			response.RemainingPlayersInRoom = append(response.RemainingPlayersInRoom, player)
+		response.IsRoomDeleted = true
+		response.RoomRemovedFromList = true
+	} else {
+		if len(remainingPlayerIDs) == 1 && gameStatus == "waiting" { // Если остался 1 игрок и игра не шла (ожидание)
+			log.Printf("Use Case HandlePlayerDisconnect: One player %s remains in waiting room %s. Resetting their state.", remainingPlayerIDs[0], roomID)
+			resetPlayer(room.Players[0])
+			response.RoomRemovedFromList = true // Комната изменилась, список нужно обновить
+		}
+		if err := s.roomStateRepo.SaveRoom(ctx, room); err != nil {
+			log.Printf("Use Case HandlePlayerDisconnect: Failed to remove player %s from room %s: %v", disconnectedUserID, roomID, err)
+			return nil, fmt.Errorf("failed to update room state after disconnect: %w", err)
 		}
 	}
 
+	// Заполняем RemainingPlayersInRoom в ответе: room уже содержит сохранённое состояние
+	if !response.IsRoomDeleted {
+		response.RemainingPlayersInRoom = room.Players
+	}
+
 	log.Printf("Use Case HandlePlayerDisconnect: Processed for user %s in room %s. GameEnded: %t, RoomDeleted: %t",
 		disconnectedUserID, roomID, response.GameEnded, response.IsRoomDeleted)
 	return response, nil
@@ -3,43 +3,77 @@ package usecase
 import (
 	"context"
 	"game_svc/internal/model"
+	"time"
 )
 
-// RoomStateRepository отвечает за управление состоянием комнаты в Redis.
+// RoomStateRepository хранит комнату целиком одним версионированным документом.
 type RoomStateRepository interface {
-	// GetAllRoomFields получает все поля из хеша комнаты.
-	GetAllRoomFields(ctx context.Context, roomID string) (map[string]string, error)
+	// GetRoom загружает комнату вместе с её версией. Если комнаты нет — model.ErrRoomNotFound.
+	GetRoom(ctx context.Context, roomID string) (*model.Room, error)
 
-	// UpdatePlayerList обновляет поле "players" в хеше комнаты.
-	UpdatePlayerList(ctx context.Context, roomID string, updatedPlayersStr string) error
-
-	// DeletePlayerSpecificFields удаляет набор полей, относящихся к конкретному игроку, из хеша комнаты.
-	// Например: "readyStatus.playerID", "scores.playerID", "hands.playerID".
-	DeletePlayerSpecificFields(ctx context.Context, roomID string, playerID string, fields []string) error
+	// SaveRoom сохраняет комнату, если в хранилище лежит версия room.Version (0 — новая комната),
+	// и увеличивает room.Version. Иначе возвращает model.ErrRoomStateConflict и ничего не пишет.
+	SaveRoom(ctx context.Context, room *model.Room) error
 
-	// ResetPlayerState сбрасывает состояние игрока (score, readyStatus) в хеше комнаты.
-	ResetPlayerState(ctx context.Context, roomID string, playerID string) error
+	// DeleteRoom удаляет комнату при том же условии на версию.
+	DeleteRoom(ctx context.Context, room *model.Room) error
 
-	// DeleteRoom полностью удаляет хеш комнаты из Redis.
-	DeleteRoom(ctx context.Context, roomID string) error
+	// PlayerRoomID возвращает комнату, в которой игрок был при последней её записи, или пустую строку.
+	// Игрок мог с тех пор выйти, поэтому комнату надо загрузить и проверить, что он ещё в ней.
+	PlayerRoomID(ctx context.Context, userID string) (string, error)
 
-	// SetRoomField устанавливает значение одного поля в хеше комнаты (может понадобиться для статуса).
-	SetRoomField(ctx context.Context, roomID string, field string, value interface{}) error
+	// ListRoomIDs возвращает ID всех комнат из индекса активности.
+	ListRoomIDs(ctx context.Context) ([]string, error)
 
-	AddJoiningPlayer(ctx context.Context, roomID string, joiningUserID string, updatedPlayersStr string) error
+	// ListIdleRooms возвращает ID комнат без записей с момента before, включая уже истёкшие по TTL.
+	ListIdleRooms(ctx context.Context, before time.Time) ([]string, error)
 
-	SaveRoom(ctx context.Context, room *model.Room) error
+	// ForgetRoom убирает истёкшую комнату из индекса активности.
+	ForgetRoom(ctx context.Context, roomID string) error
 }
 
 type GameEventStorage interface {
 	PushGameEnd(ctx context.Context, results *model.Result, bet int64) error
 }
 
+type TournamentEventStorage interface {
+	PushTournamentResult(ctx context.Context, tournament *model.Tournament) error
+}
+
+// TournamentRepository хранит турнир одним версионированным документом, как RoomStateRepository.
+type TournamentRepository interface {
+	// GetTournament загружает турнир вместе с версией. Если турнира нет — model.ErrTournamentNotFound.
+	GetTournament(ctx context.Context, tournamentID string) (*model.Tournament, error)
+
+	// SaveTournament сохраняет турнир, если в хранилище лежит версия t.Version (0 — новый турнир),
+	// и увеличивает t.Version. Иначе возвращает model.ErrTournamentStateConflict.
+	SaveTournament(ctx context.Context, t *model.Tournament) error
+
+	// ListTournaments возвращает до limit последних турниров, начиная с самых новых.
+	ListTournaments(ctx context.Context, limit int) ([]*model.Tournament, error)
+
+	// ListActiveTournaments возвращает турниры в статусах registering и running.
+	ListActiveTournaments(ctx context.Context) ([]*model.Tournament, error)
+
+	// AcquireSchedulerLease берёт или продлевает на ttl право узла вести турниры.
+	AcquireSchedulerLease(ctx context.Context, nodeID string, ttl time.Duration) (bool, error)
+}
+
+// TournamentResultRecorder получает итоги партий, сыгранных в комнатах турнирных матчей.
+type TournamentResultRecorder interface {
+	RecordMatchResult(ctx context.Context, tournamentID, roomID, winnerID string) error
+}
+
 type ClientPresenter interface {
 	AddBalance(ctx context.Context, request model.User) (model.User, error)
 	SubtractBalance(ctx context.Context, request model.User) (model.User, error)
 	Get(ctx context.Context, id int64) (*model.User, error)
 	GetRating(ctx context.Context, id int64) (*model.User, error)
+	PlaceHold(ctx context.Context, hold model.ChipHold) error
+	ReleaseHold(ctx context.Context, userID int64, reference string) error
+	SettleMatch(ctx context.Context, settlement model.Settlement) (int64, error)
+	CheckPlayAllowed(ctx context.Context, userID int64, amount int64) error
+	GetSessionReminder(ctx context.Context, userID int64) (time.Duration, error)
 }
 
 type MatchmakingPoolRepo interface {
@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"game_svc/internal/model"
+	"log"
+	"time"
+)
+
+// ListIdleRooms возвращает ID комнат, в которых ничего не происходило дольше idleAfter,
+// включая уже удалённые Redis по TTL. Уборщик обрабатывает каждую в очереди её комнаты.
+func (s *GameServiceImpl) ListIdleRooms(ctx context.Context, idleAfter time.Duration) ([]string, error) {
+	roomIDs, err := s.roomStateRepo.ListIdleRooms(ctx, time.Now().Add(-idleAfter))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list idle rooms: %w", err)
+	}
+	return roomIDs, nil
+}
+
+// CloseIdleRoom закрывает простаивающую комнату из ListIdleRooms. Зависшая партия (in_progress)
+// удаляется, а резервы ставок снимаются, так что игроки получают фишки назад. Комната, которую
+// Redis уже удалил по TTL, убирается из индекса и тоже возвращается, чтобы лобби удалило её
+// из списка. Ожидающие комнаты не трогаются — их удалит TTL; тогда возвращается nil.
+func (s *GameServiceImpl) CloseIdleRoom(ctx context.Context, roomID string) (*model.ClosedRoom, error) {
+	room, err := s.roomStateRepo.GetRoom(ctx, roomID)
+	if errors.Is(err, model.ErrRoomNotFound) {
+		if err := s.roomStateRepo.ForgetRoom(ctx, roomID); err != nil {
+			return nil, err
+		}
+		return &model.ClosedRoom{RoomID: roomID}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load room %s: %w", roomID, err)
+	}
+	if room.Status != "in_progress" {
+		return nil, nil
+	}
+	return s.closeWithRefund(ctx, room)
+}
+
+// SettlePendingGame повторяет расчёт партии, которая осталась в статусе "settling", потому что
+// user-service не ответил вовремя. Возвращает итог партии, если она рассчитана сейчас, чтобы
+// игрокам объявили победителя; событие GameEnd уже опубликовано. Иначе возвращает nil.
+func (s *GameServiceImpl) SettlePendingGame(ctx context.Context, roomID string) (*model.Result, error) {
+	room, err := s.roomStateRepo.GetRoom(ctx, roomID)
+	if errors.Is(err, model.ErrRoomNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load room %s: %w", roomID, err)
+	}
+	if room.Status != "settling" || room.PendingGameEnd == nil {
+		return nil, nil
+	}
+
+	pending := room.PendingGameEnd
+	payout, finished, err := s.settlePendingGame(ctx, room)
+	if err != nil || !finished {
+		return nil, err
+	}
+	result := &model.Result{
+		RoomID:      roomID,
+		GameEnded:   true,
+		Winner:      pending.Winner,
+		Loser:       pending.Loser,
+		FinalScores: pending.FinalScores,
+		FinalHands:  pending.FinalHands,
+		Stood:       pending.Stood,
+	}
+	if err := s.publishGameEnd(ctx, room, result, payout); err != nil {
+		log.Printf("Use Case SettlePendingGame: Failed to publish end of game in room %s: %v", roomID, err)
+	}
+	return result, nil
+}
+
+// CloseAbandonedGame закрывает партию, если в неё не вернулся ни один игрок: комната удаляется,
+// ставки возвращаются. Если кто-то из игроков на месте или игра не идёт, возвращает nil —
+// тогда отсутствующие обрабатываются как обычные отключения.
+func (s *GameServiceImpl) CloseAbandonedGame(ctx context.Context, roomID string, absentIDs []string) (*model.ClosedRoom, error) {
+	room, err := s.roomStateRepo.GetRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	if room.Status != "in_progress" {
+		return nil, nil
+	}
+	absent := make(map[string]bool, len(absentIDs))
+	for _, id := range absentIDs {
+		absent[id] = true
+	}
+	for _, p := range room.Players {
+		if !absent[p.ID] {
+			return nil, nil
+		}
+	}
+	return s.closeWithRefund(ctx, room)
+}
+
+// closeWithRefund сначала удаляет комнату: проверка версии не даст закрыть партию, в которой
+// как раз сейчас сделали ход. Только после этого снимаются резервы ставок.
+func (s *GameServiceImpl) closeWithRefund(ctx context.Context, room *model.Room) (*model.ClosedRoom, error) {
+	allPlayerIDs := playerIDs(room)
+	if err := s.roomStateRepo.DeleteRoom(ctx, room); err != nil {
+		return nil, err
+	}
+	s.releaseBetHolds(ctx, room.GameID, allPlayerIDs)
+	log.Printf("Use Case: Closed game %s in room %s and refunded players %v", room.GameID, room.ID, allPlayerIDs)
+	return &model.ClosedRoom{RoomID: room.ID, PlayerIDs: allPlayerIDs, Refunded: true}, nil
+}
+
+// ForceCloseRoom закрывает комнату по запросу администратора в любом статусе, кроме "settling":
+// итог такой партии уже решён, и комнату удалит её расчёт.
+// Если партия идёт, ставки возвращаются так же, как при закрытии зависшей игры.
+func (s *GameServiceImpl) ForceCloseRoom(ctx context.Context, roomID string) (*model.ClosedRoom, error) {
+	room, err := s.roomStateRepo.GetRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	if room.Status == "settling" {
+		return nil, model.ErrGameSettling
+	}
+	if room.Status == "in_progress" {
+		return s.closeWithRefund(ctx, room)
+	}
+	if err := s.roomStateRepo.DeleteRoom(ctx, room); err != nil {
+		return nil, err
+	}
+	log.Printf("Use Case: Force-closed room %s with players %v", room.ID, playerIDs(room))
+	return &model.ClosedRoom{RoomID: room.ID, PlayerIDs: playerIDs(room)}, nil
+}
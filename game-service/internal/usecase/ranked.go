@@ -8,6 +8,9 @@ import (
 	"strconv"
 )
 
+// rankedBet — ставка в рейтинговых матчах.
+const rankedBet = 2500
+
 type RankedUseCase struct {
 	poolRepo        MatchmakingPoolRepo
 	clientPresenter ClientPresenter
@@ -29,6 +32,10 @@ func (uc *RankedUseCase) FindMatch(userID string) (*model.Match, error) {
 	if err != nil {
 		return nil, err
 	}
+	// Самоисключённые игроки и игроки, достигшие лимитов, не попадают в пул
+	if err := uc.clientPresenter.CheckPlayAllowed(ctx, userIDint, rankedBet); err != nil {
+		return nil, err
+	}
 	userData, err := uc.clientPresenter.GetRating(ctx, userIDint)
 	if err != nil {
 		return nil, fmt.Errorf("could not fetch user rating: %w", err)
@@ -46,11 +53,11 @@ func (uc *RankedUseCase) FindMatch(userID string) (*model.Match, error) {
 		if err != nil {
 			return nil, fmt.Errorf("failed to add user to matchmaking pool: %w", err)
 		}
-		log.Printf("User %s added to matchmaking pool with MMR %d. Waiting for opponent.", userID, userData)
+		log.Printf("User %s added to matchmaking pool with MMR %d. Waiting for opponent.", userID, *userData.Rating)
 		return nil, nil
 	}
 
-	log.Printf("Match found for user %s (MMR %d) with opponent %s (MMR %d)!", userID, userData, opponent.ID, opponent.MMR)
+	log.Printf("Match found for user %s (MMR %d) with opponent %s (MMR %d)!", userID, *userData.Rating, opponent.ID, opponent.MMR)
 
 	// a. Remove both players from the pool
 	if err := uc.poolRepo.RemoveFromPool(ctx, userID, opponent.ID); err != nil {
@@ -59,8 +66,9 @@ func (uc *RankedUseCase) FindMatch(userID string) (*model.Match, error) {
 	}
 
 	createParams := model.CreateRoomParams{
-		Bet:    2500,
+		Bet:    rankedBet,
 		UserID: userID,
+		Ranked: true,
 	}
 	createdRoom, err := uc.roomUsecase.CreateRoom(createParams)
 	if err != nil {
@@ -71,7 +79,7 @@ func (uc *RankedUseCase) FindMatch(userID string) (*model.Match, error) {
 	joinParams := model.JoinRoomParams{
 		RoomID: createdRoom.ID,
 		UserID: opponent.ID,
-		Bet:    2500,
+		Bet:    rankedBet,
 	}
 	finalRoom, err := uc.roomUsecase.JoinRoom(joinParams)
 	if err != nil {
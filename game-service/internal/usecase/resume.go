@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"game_svc/internal/model"
+	"log"
+)
+
+// ListRooms загружает все комнаты, которые есть в Redis. Используется при старте сервиса,
+// чтобы вернуть игроков в партии, пережившие рестарт.
+func (s *GameServiceImpl) ListRooms(ctx context.Context) ([]*model.Room, error) {
+	roomIDs, err := s.roomStateRepo.ListRoomIDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rooms: %w", err)
+	}
+	rooms := make([]*model.Room, 0, len(roomIDs))
+	for _, roomID := range roomIDs {
+		room, err := s.roomStateRepo.GetRoom(ctx, roomID)
+		if errors.Is(err, model.ErrRoomNotFound) {
+			continue
+		}
+		if err != nil {
+			log.Printf("Use Case ListRooms: Failed to load room %s: %v", roomID, err)
+			continue
+		}
+		rooms = append(rooms, room)
+	}
+	return rooms, nil
+}
+
+// GetRoom возвращает текущее состояние комнаты.
+func (s *GameServiceImpl) GetRoom(ctx context.Context, roomID string) (*model.Room, error) {
+	return s.roomStateRepo.GetRoom(ctx, roomID)
+}
+
+// FindPlayerRoom возвращает комнату, в которой сейчас сидит игрок, или пустую строку.
+func (s *GameServiceImpl) FindPlayerRoom(ctx context.Context, userID string) (string, error) {
+	roomID, err := s.roomStateRepo.PlayerRoomID(ctx, userID)
+	if err != nil || roomID == "" {
+		return "", err
+	}
+	room, err := s.roomStateRepo.GetRoom(ctx, roomID)
+	if errors.Is(err, model.ErrRoomNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if findPlayer(room, userID) == nil {
+		return "", nil
+	}
+	return roomID, nil
+}
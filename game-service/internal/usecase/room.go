@@ -7,7 +7,6 @@ import (
 	"game_svc/internal/model"
 	"log"
 	"strconv"
-	"strings"
 
 	"github.com/google/uuid"
 )
@@ -33,7 +32,55 @@ func generateRoomID() string {
 	return uuid.New().String()
 }
 
-var playerSpecificBaseFields = []string{"readyStatus", "scores", "hands", "lastAction", "stood"}
+// playerIDs возвращает ID игроков в порядке входа в комнату.
+func playerIDs(room *model.Room) []string {
+	ids := make([]string, 0, len(room.Players))
+	for _, p := range room.Players {
+		ids = append(ids, p.ID)
+	}
+	return ids
+}
+
+func findPlayer(room *model.Room, playerID string) *model.Player {
+	for _, p := range room.Players {
+		if p.ID == playerID {
+			return p
+		}
+	}
+	return nil
+}
+
+// removePlayer убирает игрока из комнаты и сообщает, был ли он в ней.
+func removePlayer(room *model.Room, playerID string) bool {
+	for i, p := range room.Players {
+		if p.ID == playerID {
+			room.Players = append(room.Players[:i:i], room.Players[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// resetPlayer возвращает игроку состояние, с которого начинается новая игра.
+func resetPlayer(p *model.Player) {
+	p.IsReady = false
+	p.Score = 0
+	p.LastAction = ""
+	p.Hand = []model.Card{}
+	p.Stood = false
+}
+
+// resetRoomForNextGame возвращает комнату в ожидание и сбрасывает состояние оставшихся игроков.
+func resetRoomForNextGame(room *model.Room) {
+	room.Status = "waiting"
+	room.CurrentTurnPlayerID = ""
+	room.Deck = []model.Card{}
+	room.GameID = ""
+	room.PendingGameEnd = nil
+	for _, p := range room.Players {
+		resetPlayer(p)
+	}
+}
 
 // CreateRoom реализует логику создания комнаты.
 func (s *RoomServiceImpl) CreateRoom(params model.CreateRoomParams) (*model.Room, error) {
@@ -44,13 +91,17 @@ func (s *RoomServiceImpl) CreateRoom(params model.CreateRoomParams) (*model.Room
 	if err != nil {
 		return nil, err
 	}
-	//1. Проверка баланса игрока
+	//1. Проверка ограничений игрока и его баланса
+	if err := s.clientPresenter.CheckPlayAllowed(ctx, userIDint, int64(bet)); err != nil {
+		return nil, err
+	}
 	playerBalance, err := s.clientPresenter.Get(ctx, userIDint)
 	if err != nil {
 		log.Printf("Error getting player balance for %s: %v", userID, err)
 		return nil, fmt.Errorf("failed to get player balance: %w", err)
 	}
-	if int(*playerBalance.Balance) < bet {
+	// Фишки, удерживаемые в других играх, поставить нельзя: сравниваем ставку с доступным балансом
+	if *playerBalance.Available < int64(bet) {
 		return nil, errors.New("insufficient funds to create a room")
 	}
 
@@ -74,6 +125,8 @@ func (s *RoomServiceImpl) CreateRoom(params model.CreateRoomParams) (*model.Room
 		Players:             []*model.Player{creatorPlayer},
 		Deck:                []model.Card{},
 		CurrentTurnPlayerID: "",
+		TournamentID:        params.TournamentID,
+		Ranked:              params.Ranked,
 	}
 	err = s.roomStateRepo.SaveRoom(ctx, newRoom)
 
@@ -94,122 +147,56 @@ func (s *RoomServiceImpl) JoinRoom(params model.JoinRoomParams) (*model.Room, er
 	clientBet := params.Bet
 
 	// 1. Get current room state from Redis for validation
-	roomStateMap, err := s.roomStateRepo.GetAllRoomFields(ctx, roomID)
+	room, err := s.roomStateRepo.GetRoom(ctx, roomID)
 	if err != nil {
 		log.Printf("Use Case JoinRoom: Error retrieving room state for room %s: %v", roomID, err)
 		return nil, fmt.Errorf("error retrieving room state: %w", err)
 	}
-	if len(roomStateMap) == 0 {
-		return nil, errors.New("room not found")
-	}
-
-	roomStatus := roomStateMap["status"]
-	roomBetStoredStr, okBet := roomStateMap["bet"]
-	if !okBet {
-		log.Printf("Use Case JoinRoom: Bet not found in Redis for room %s", roomID)
-		return nil, errors.New("room configuration error: bet not set")
-	}
-	currentPlayersStr, okPlayers := roomStateMap["players"]
-	if !okPlayers {
-		log.Printf("Use Case JoinRoom: Players field not found in Redis for room %s", roomID)
-		return nil, errors.New("room configuration error: players not set")
-	}
-
-	roomBetStored, err := strconv.Atoi(roomBetStoredStr)
-	if err != nil {
-		log.Printf("Use Case JoinRoom: Invalid bet format ('%s') in Redis for room %s: %v", roomBetStoredStr, roomID, err)
-		return nil, errors.New("room has invalid bet configuration")
-	}
 
 	// 2. Validations
-	existingPlayerIDs := splitPlayers(currentPlayersStr)
-
-	if len(existingPlayerIDs) >= 2 {
+	if room.Status == "settling" {
+		return nil, model.ErrGameSettling
+	}
+	if len(room.Players) >= 2 {
 		return nil, errors.New("room is full")
 	}
-
-	for _, pID := range existingPlayerIDs {
-		if pID == joiningUserID {
-			return nil, errors.New("player already in this room")
-		}
+	if findPlayer(room, joiningUserID) != nil {
+		return nil, errors.New("player already in this room")
 	}
-
-	if clientBet != roomBetStored {
-		return nil, fmt.Errorf("your bet (%d) does not match the room bet (%d)", clientBet, roomBetStored)
+	if room.TournamentID != params.TournamentID {
+		return nil, errors.New("room is reserved for a tournament match")
+	}
+	if clientBet != room.Bet {
+		return nil, fmt.Errorf("your bet (%d) does not match the room bet (%d)", clientBet, room.Bet)
 	}
 	joiningUserIDint, ok := strconv.ParseInt(joiningUserID, 10, 64)
 	if ok != nil {
-		return nil, fmt.Errorf("could not parse joining user id %s", joiningUserIDint)
+		return nil, fmt.Errorf("could not parse joining user id %s", joiningUserID)
+	}
+	if err := s.clientPresenter.CheckPlayAllowed(ctx, joiningUserIDint, int64(room.Bet)); err != nil {
+		return nil, err
 	}
 	playerBalance, err := s.clientPresenter.Get(ctx, joiningUserIDint)
 	if err != nil {
 		log.Printf("Use Case JoinRoom: Error getting player balance for %s: %v", joiningUserID, err)
 		return nil, fmt.Errorf("failed to get player balance: %w", err)
 	}
-	if int(*playerBalance.Balance) < roomBetStored {
+	if *playerBalance.Available < int64(room.Bet) {
 		return nil, errors.New("insufficient funds to join the room")
 	}
 
-	// 3. Update Redis using the new repository method
-	newPlayerIDsForRedisList := append(existingPlayerIDs, joiningUserID)
-	updatedPlayersStrRedis := strings.Join(newPlayerIDsForRedisList, ",")
-
-	// Call the dedicated repository method
-	if err := s.roomStateRepo.AddJoiningPlayer(ctx, roomID, joiningUserID, updatedPlayersStrRedis); err != nil {
+	// 3. Save the room. The version check rejects the join if someone else joined or left
+	// since we read the room, so two players can't both pass the capacity check above.
+	joiningPlayer := &model.Player{ID: joiningUserID}
+	resetPlayer(joiningPlayer)
+	room.Players = append(room.Players, joiningPlayer)
+	if err := s.roomStateRepo.SaveRoom(ctx, room); err != nil {
 		log.Printf("Use Case JoinRoom: Failed to add player %s to room %s via repository: %v", joiningUserID, roomID, err)
 		return nil, fmt.Errorf("failed to update room state for joining player: %w", err)
 	}
 
-	// 4. Construct and return the updated domain model *model.Room
-	finalPlayersInModel := make([]*model.Player, 0, len(newPlayerIDsForRedisList))
-	for _, pID := range newPlayerIDsForRedisList {
-		player := &model.Player{ID: pID}
-		if pID == joiningUserID {
-			player.IsReady = false
-			player.Score = 0
-			player.LastAction = ""
-			player.Hand = []model.Card{}
-		} else {
-			readyStr := roomStateMap["readyStatus."+pID]
-			player.IsReady = readyStr == "1"
-
-			scoreStr := roomStateMap["scores."+pID]
-			score, _ := strconv.Atoi(scoreStr)
-			player.Score = score
-			player.LastAction = roomStateMap["lastAction."+pID]
-			handStr := roomStateMap["hand."+pID]
-			player.Hand = parseHandString(handStr)
-			// Potentially load Hand and LastAction from roomStateMap too if they exist
-		}
-		finalPlayersInModel = append(finalPlayersInModel, player)
-	}
-
-	updatedRoomModel := &model.Room{
-		ID:                  roomID,
-		Status:              roomStatus,
-		Bet:                 roomBetStored,
-		Players:             finalPlayersInModel,
-		CurrentTurnPlayerID: roomStateMap["turn"],
-		Deck:                []model.Card{},
-	}
-
-	log.Printf("Use Case: User %s joined room %s. Total players now: %d", joiningUserID, roomID, len(updatedRoomModel.Players))
-	return updatedRoomModel, nil
-}
-
-// Вспомогательная функция splitPlayers остается той же
-func splitPlayers(playersStr string) []string {
-	if playersStr == "" {
-		return []string{}
-	}
-	var result []string
-	parts := strings.Split(playersStr, ",")
-	for _, p := range parts {
-		if p != "" {
-			result = append(result, p)
-		}
-	}
-	return result
+	log.Printf("Use Case: User %s joined room %s. Total players now: %d", joiningUserID, roomID, len(room.Players))
+	return room, nil
 }
 
 func (s *RoomServiceImpl) LeaveRoom(params model.LeaveRoomParams) (updatedRoom *model.Room, wasRoomDeleted bool, err error) {
@@ -220,154 +207,55 @@ func (s *RoomServiceImpl) LeaveRoom(params model.LeaveRoomParams) (updatedRoom *
 	log.Printf("Use Case LeaveRoom: User %s attempting to leave room %s", leavingUserID, roomID)
 
 	// 1. Получаем текущее состояние комнаты из Redis
-	roomStateMap, err := s.roomStateRepo.GetAllRoomFields(ctx, roomID)
+	room, err := s.roomStateRepo.GetRoom(ctx, roomID)
+	if errors.Is(err, model.ErrRoomNotFound) {
+		log.Printf("Use Case LeaveRoom: Room %s not found in Redis for leave.", roomID)
+		return nil, true, err
+	}
 	if err != nil {
 		log.Printf("Use Case LeaveRoom: Error retrieving room state for room %s: %v", roomID, err)
 		return nil, false, fmt.Errorf("error retrieving room state: %w", err)
 	}
-	if len(roomStateMap) == 0 {
-		log.Printf("Use Case LeaveRoom: Room %s not found in Redis for leave.", roomID)
-		return nil, true, errors.New("room not found")
-	}
-
-	currentPlayersStr := roomStateMap["players"]
-	initialPlayerIDs := splitPlayers(currentPlayersStr)
 
-	// 2. Проверяем, есть ли игрок в комнате
-	isPresent := false
-	var remainingPlayerIDsAfterLeave []string
-	for _, pID := range initialPlayerIDs {
-		if pID == leavingUserID {
-			isPresent = true
-		} else {
-			remainingPlayerIDsAfterLeave = append(remainingPlayerIDsAfterLeave, pID)
-		}
+	// 2. Выход из идущей партии — это поражение: его рассчитывает GameUseCase.HandlePlayerDisconnect,
+	// иначе ставки остались бы зарезервированы, а ушедший игрок избежал бы проигрыша
+	if room.Status == "in_progress" && findPlayer(room, leavingUserID) != nil {
+		log.Printf("Use Case LeaveRoom: Player %s is leaving room %s mid-game, the game is forfeited.", leavingUserID, roomID)
+		return nil, false, model.ErrGameInProgress
 	}
 
-	if !isPresent {
-		log.Printf("Use Case LeaveRoom: Player %s not found in room %s players list (%s)", leavingUserID, roomID, currentPlayersStr)
+	// 3. Проверяем, есть ли игрок в комнате
+	if !removePlayer(room, leavingUserID) {
+		log.Printf("Use Case LeaveRoom: Player %s not found in room %s players list (%v)", leavingUserID, roomID, playerIDs(room))
 		return nil, false, errors.New("player not in this room")
 	}
 
-	// 3. Обновляем список игроков в Redis
-	updatedPlayersStrRedis := strings.Join(remainingPlayerIDsAfterLeave, ",")
-	if err := s.roomStateRepo.UpdatePlayerList(ctx, roomID, updatedPlayersStrRedis); err != nil {
-		log.Printf("Use Case LeaveRoom: Failed to update players list in Redis for room %s: %v", roomID, err)
-		return nil, false, fmt.Errorf("failed to update players list in Redis: %w", err)
-	}
-
-	// 4. Удаляем специфичные для ушедшего игрока поля из Redis
-	if err := s.roomStateRepo.DeletePlayerSpecificFields(ctx, roomID, leavingUserID, playerSpecificBaseFields); err != nil {
-		log.Printf("Use Case LeaveRoom: Warning - failed to delete specific fields for player %s in room %s: %v", leavingUserID, roomID, err)
-	}
-
-	// 5. Если после ухода игрока комната стала пустой, удаляем ее полностью из Redis.
-	if len(remainingPlayerIDsAfterLeave) == 0 {
-		log.Printf("Use Case LeaveRoom: Room %s is now empty. Deleting room from Redis.", roomID)
-		if delErr := s.roomStateRepo.DeleteRoom(ctx, roomID); delErr != nil {
-			log.Printf("Use Case LeaveRoom: Failed to delete empty room %s from Redis: %v", roomID, delErr)
-			return nil, false, fmt.Errorf("room is empty but failed to delete from redis: %w", delErr) // Более строгая обработка
+	// 4. Если после ухода игрока комната стала пустой, удаляем ее полностью из Redis.
+	// Комнату с нерассчитанной партией удалит расчёт, когда user-service его подтвердит.
+	settling := room.Status == "settling"
+	if len(room.Players) == 0 && !settling {
+		if err := s.roomStateRepo.DeleteRoom(ctx, room); err != nil {
+			log.Printf("Use Case LeaveRoom: Failed to delete empty room %s from Redis: %v", roomID, err)
+			return nil, false, fmt.Errorf("room is empty but failed to delete from redis: %w", err)
 		}
 		log.Printf("Use Case LeaveRoom: Player %s left room %s, room is now empty and was successfully deleted.", leavingUserID, roomID)
 		return nil, true, nil
 	}
 
-	wasRoomDeleted = false
-
-	var remainingPlayerSingleID string
-	if len(remainingPlayerIDsAfterLeave) == 1 {
-		remainingPlayerSingleID = remainingPlayerIDsAfterLeave[0]
-		log.Printf("Use Case LeaveRoom: One player %s remains in room %s. Resetting their state and room status.", remainingPlayerSingleID, roomID)
-
-		if err := s.roomStateRepo.ResetPlayerState(ctx, roomID, remainingPlayerSingleID); err != nil {
-			log.Printf("Use Case LeaveRoom: Failed to reset state for remaining player %s in room %s: %v", remainingPlayerSingleID, roomID, err)
-		}
-		if err := s.roomStateRepo.SetRoomField(ctx, roomID, "status", "waiting"); err != nil {
-			log.Printf("Use Case LeaveRoom: Failed to set room status to 'waiting' for room %s: %v", roomID, err)
-		}
-		if err := s.roomStateRepo.SetRoomField(ctx, roomID, "turn", ""); err != nil {
-			log.Printf("Use Case LeaveRoom: Failed to reset turn for room %s: %v", roomID, err)
-		}
+	// 5. Если остался один игрок, сбрасываем его состояние и возвращаем комнату в ожидание
+	if len(room.Players) == 1 && !settling {
+		log.Printf("Use Case LeaveRoom: One player %s remains in room %s. Resetting their state and room status.", room.Players[0].ID, roomID)
+		resetPlayer(room.Players[0])
+		room.Status = "waiting"
+		room.CurrentTurnPlayerID = ""
 	}
 
-	// 7. Конструируем обновленную модель model.Room с оставшимися игроками
-	finalPlayersInModel := make([]*model.Player, 0, len(remainingPlayerIDsAfterLeave))
-	for _, pID := range remainingPlayerIDsAfterLeave {
-		player := &model.Player{ID: pID}
-		if len(remainingPlayerIDsAfterLeave) == 1 && pID == remainingPlayerSingleID {
-			player.IsReady = false
-			player.Score = 0
-			player.LastAction = ""
-			player.Hand = []model.Card{}
-		}
-
-		finalPlayersInModel = append(finalPlayersInModel, player)
-	}
-
-	roomBet, _ := strconv.Atoi(roomStateMap["bet"])
-
-	currentStatus := roomStateMap["status"]
-	currentTurn := roomStateMap["turn"]
-	if len(remainingPlayerIDsAfterLeave) == 1 {
-		currentStatus = "waiting"
-		currentTurn = ""
-	}
-
-	resultRoomModel := &model.Room{
-		ID:                  roomID,
-		Status:              currentStatus,
-		Bet:                 roomBet,
-		Players:             finalPlayersInModel,
-		CurrentTurnPlayerID: currentTurn,
-		Deck:                []model.Card{},
+	if err := s.roomStateRepo.SaveRoom(ctx, room); err != nil {
+		log.Printf("Use Case LeaveRoom: Failed to save room %s after player %s left: %v", roomID, leavingUserID, err)
+		return nil, false, fmt.Errorf("failed to update room state for leaving player: %w", err)
 	}
 
 	log.Printf("Use Case LeaveRoom: Player %s left room %s. Remaining players: %d. Status: %s",
-		leavingUserID, roomID, len(resultRoomModel.Players), resultRoomModel.Status)
-	return resultRoomModel, wasRoomDeleted, nil
-}
-
-// parseCardString преобразует строку типа "AS" в model.Card{Value:"A", Suit:"S"}
-func parseCardString(cardStr string) (model.Card, bool) {
-	if len(cardStr) < 2 { // Карта должна иметь хотя бы значение и масть
-		return model.Card{}, false
-	}
-
-	value := ""
-	suit := ""
-
-	// Простой парсер: последняя буква - масть, все остальное - значение
-	// Например, "10H" -> Value "10", Suit "H"
-	// "AS" -> Value "A", Suit "S"
-	suit = string(cardStr[len(cardStr)-1])
-	value = cardStr[:len(cardStr)-1]
-
-	// Простая валидация масти (можно расширить)
-	validSuits := map[string]bool{"H": true, "D": true, "C": true, "S": true}
-	if !validSuits[suit] {
-		return model.Card{}, false
-	}
-
-	// Простая валидация значения (можно расширить)
-	// ... (здесь можно добавить проверку на A, K, Q, J, 2-10)
-
-	return model.Card{Value: value, Suit: suit}, true
-}
-
-// parseHandString преобразует строку из Redis (например, "AS,KD,10H" или "nil") в срез model.Card
-func parseHandString(handStr string) []model.Card {
-	if handStr == "nil" || handStr == "" {
-		return []model.Card{}
-	}
-
-	cardStrings := strings.Split(handStr, ",")
-	hand := make([]model.Card, 0, len(cardStrings))
-
-	for _, cs := range cardStrings {
-		trimmedCS := strings.TrimSpace(cs)
-		if card, ok := parseCardString(trimmedCS); ok {
-			hand = append(hand, card)
-		}
-	}
-	return hand
+		leavingUserID, roomID, len(room.Players), room.Status)
+	return room, false, nil
 }
@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// SessionServiceImpl отвечает за напоминания о длительности игровой сессии.
+type SessionServiceImpl struct {
+	clientPresenter ClientPresenter
+}
+
+// NewSessionService создает новый экземпляр SessionServiceImpl.
+func NewSessionService(presenter ClientPresenter) *SessionServiceImpl {
+	return &SessionServiceImpl{
+		clientPresenter: presenter,
+	}
+}
+
+// ReminderInterval возвращает интервал напоминаний, который игрок выбрал в user-service. 0 — напоминания выключены.
+func (s *SessionServiceImpl) ReminderInterval(userID string) (time.Duration, error) {
+	userIDint, err := strconv.ParseInt(userID, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse user id %s: %w", userID, err)
+	}
+	return s.clientPresenter.GetSessionReminder(context.Background(), userIDint)
+}
@@ -0,0 +1,798 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"game_svc/internal/model"
+	"log"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// tournamentUpdateAttempts — сколько раз update перечитывает турнир при конфликте версий.
+const tournamentUpdateAttempts = 5
+
+// tournamentListLimit — сколько последних турниров отдаёт ListTournaments.
+const tournamentListLimit = 100
+
+var (
+	errAlreadyRegistered = fmt.Errorf("%w: already registered", model.ErrTournamentRegistration)
+	// errTournamentMoved — турнир уже изменили так, что задуманное изменение не нужно.
+	errTournamentMoved = errors.New("tournament already moved on")
+)
+
+// TournamentServiceImpl ведёт турниры: регистрацию с бай-ином, сетку, комнаты матчей,
+// неявки и выплату призового фонда. Ход турниров двигает Tick, который выполняет только
+// узел, держащий аренду планировщика; результаты партий приходят через RecordMatchResult.
+type TournamentServiceImpl struct {
+	repo            TournamentRepository
+	roomStateRepo   RoomStateRepository
+	roomUseCase     RoomUseCase
+	clientPresenter ClientPresenter
+	producer        TournamentEventStorage
+	rules           model.TournamentRules
+	nodeID          string
+}
+
+// NewTournamentService создаёт TournamentServiceImpl. nodeID — узел, от имени которого берётся аренда планировщика.
+func NewTournamentService(
+	repo TournamentRepository,
+	rsr RoomStateRepository,
+	roomUseCase RoomUseCase,
+	presenter ClientPresenter,
+	producer TournamentEventStorage,
+	rules model.TournamentRules,
+	nodeID string,
+) *TournamentServiceImpl {
+	return &TournamentServiceImpl{
+		repo:            repo,
+		roomStateRepo:   rsr,
+		roomUseCase:     roomUseCase,
+		clientPresenter: presenter,
+		producer:        producer,
+		rules:           rules,
+		nodeID:          nodeID,
+	}
+}
+
+// ParsePayoutTable разбирает таблицу выплат вида "2:100;4:70,30;8:50,30,10,10":
+// размер поля и проценты призового фонда по местам. Проценты каждой строки должны давать 100.
+func ParsePayoutTable(s string) (map[int][]int, error) {
+	table := make(map[int][]int)
+	for _, row := range strings.Split(s, ";") {
+		row = strings.TrimSpace(row)
+		if row == "" {
+			continue
+		}
+		sizeStr, sharesStr, ok := strings.Cut(row, ":")
+		if !ok {
+			return nil, fmt.Errorf("payout row %q: expected <players>:<percent>,...", row)
+		}
+		size, err := strconv.Atoi(strings.TrimSpace(sizeStr))
+		if err != nil || size < 2 {
+			return nil, fmt.Errorf("payout row %q: invalid field size", row)
+		}
+		var shares []int
+		sum := 0
+		for _, share := range strings.Split(sharesStr, ",") {
+			pct, err := strconv.Atoi(strings.TrimSpace(share))
+			if err != nil || pct < 0 {
+				return nil, fmt.Errorf("payout row %q: invalid percent %q", row, share)
+			}
+			shares = append(shares, pct)
+			sum += pct
+		}
+		if sum != 100 || len(shares) > size {
+			return nil, fmt.Errorf("payout row %q: shares must sum to 100 and fit the field", row)
+		}
+		table[size] = shares
+	}
+	if len(table) == 0 {
+		return nil, errors.New("payout table is empty")
+	}
+	return table, nil
+}
+
+// payoutsFor выбирает строку таблицы для наибольшего поля, не превышающего players.
+// Если такой нет, весь фонд получает победитель.
+func (s *TournamentServiceImpl) payoutsFor(players int) []int {
+	best := 0
+	for size := range s.rules.Payouts {
+		if size <= players && size > best {
+			best = size
+		}
+	}
+	if best == 0 {
+		return []int{100}
+	}
+	return append([]int(nil), s.rules.Payouts[best]...)
+}
+
+// CreateTournament создаёт турнир с открытой регистрацией.
+func (s *TournamentServiceImpl) CreateTournament(ctx context.Context, params model.CreateTournamentParams) (*model.Tournament, error) {
+	now := time.Now()
+	t := &model.Tournament{
+		ID:                   uuid.New().String(),
+		Name:                 params.Name,
+		Format:               params.Format,
+		Status:               model.TournamentStatusRegistering,
+		BuyIn:                params.BuyIn,
+		MinPlayers:           params.MinPlayers,
+		MaxPlayers:           params.MaxPlayers,
+		RegistrationOpensAt:  params.RegistrationOpensAt,
+		RegistrationClosesAt: params.RegistrationClosesAt,
+		Players:              []string{},
+		CreatedAt:            now,
+	}
+	if t.RegistrationOpensAt.IsZero() {
+		t.RegistrationOpensAt = now
+	}
+	if t.BuyIn < 0 || t.MaxPlayers < 2 {
+		return nil, fmt.Errorf("%w: buy-in must not be negative and a tournament needs at least 2 seats", model.ErrInvalidTournament)
+	}
+	switch t.Format {
+	case model.TournamentFormatSitAndGo:
+		if t.MaxPlayers != 4 && t.MaxPlayers != 8 {
+			return nil, fmt.Errorf("%w: sit-and-go is played by 4 or 8 players", model.ErrInvalidTournament)
+		}
+		t.MinPlayers = t.MaxPlayers
+		t.RegistrationClosesAt = time.Time{}
+	case model.TournamentFormatSingleElimination:
+		if t.MinPlayers < 2 {
+			t.MinPlayers = 2
+		}
+		if t.MinPlayers > t.MaxPlayers {
+			return nil, fmt.Errorf("%w: min players exceeds max players", model.ErrInvalidTournament)
+		}
+		if !t.RegistrationClosesAt.After(t.RegistrationOpensAt) || !t.RegistrationClosesAt.After(now) {
+			return nil, fmt.Errorf("%w: registration must close in the future, after it opens", model.ErrInvalidTournament)
+		}
+		// Первый бай-ин резервируется, как только открывается регистрация, и должен дожить
+		// до выплаты: как и у sit-and-go, на саму игру остаётся вторая половина срока резерва
+		firstHoldAt := t.RegistrationOpensAt
+		if firstHoldAt.Before(now) {
+			firstHoldAt = now
+		}
+		if t.BuyIn > 0 && t.RegistrationClosesAt.Sub(firstHoldAt) > s.rules.HoldTTL/2 {
+			return nil, fmt.Errorf("%w: registration must close within %s of opening so buy-ins stay held until the payout",
+				model.ErrInvalidTournament, s.rules.HoldTTL/2)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown format %q", model.ErrInvalidTournament, t.Format)
+	}
+	if t.Name == "" {
+		t.Name = fmt.Sprintf("Tournament %s", t.ID[:8])
+	}
+
+	if err := s.repo.SaveTournament(ctx, t); err != nil {
+		return nil, err
+	}
+	log.Printf("Use Case: Tournament %s (%s, %s) created, buy-in %d, up to %d players", t.ID, t.Name, t.Format, t.BuyIn, t.MaxPlayers)
+	return t, nil
+}
+
+// ListTournaments возвращает последние турниры; status оставляет только турниры в этом статусе.
+func (s *TournamentServiceImpl) ListTournaments(ctx context.Context, status string) ([]*model.Tournament, error) {
+	tournaments, err := s.repo.ListTournaments(ctx, tournamentListLimit)
+	if err != nil {
+		return nil, err
+	}
+	if status == "" {
+		return tournaments, nil
+	}
+	filtered := make([]*model.Tournament, 0, len(tournaments))
+	for _, t := range tournaments {
+		if t.Status == status {
+			filtered = append(filtered, t)
+		}
+	}
+	return filtered, nil
+}
+
+func (s *TournamentServiceImpl) GetTournament(ctx context.Context, tournamentID string) (*model.Tournament, error) {
+	return s.repo.GetTournament(ctx, tournamentID)
+}
+
+// checkRegistrationOpen проверяет, что в турнир сейчас можно записаться.
+func checkRegistrationOpen(t *model.Tournament, now time.Time) error {
+	if t.Status != model.TournamentStatusRegistering {
+		return fmt.Errorf("%w: tournament is %s", model.ErrTournamentRegistration, t.Status)
+	}
+	if now.Before(t.RegistrationOpensAt) {
+		return fmt.Errorf("%w: registration opens at %s", model.ErrTournamentRegistration, t.RegistrationOpensAt.UTC().Format(time.RFC3339))
+	}
+	if !t.RegistrationClosesAt.IsZero() && !now.Before(t.RegistrationClosesAt) {
+		return fmt.Errorf("%w: registration is closed", model.ErrTournamentRegistration)
+	}
+	if len(t.Players) >= t.MaxPlayers {
+		return fmt.Errorf("%w: tournament is full", model.ErrTournamentRegistration)
+	}
+	return nil
+}
+
+// Register записывает игрока в турнир. Бай-ин резервируется в user-service до расчёта турнира;
+// если записать игрока не удалось, резерв снимается.
+func (s *TournamentServiceImpl) Register(ctx context.Context, tournamentID, userID string) (*model.Tournament, error) {
+	userIDint, err := strconv.ParseInt(userID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse user id %s: %w", userID, err)
+	}
+	t, err := s.repo.GetTournament(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRegistrationOpen(t, time.Now()); err != nil {
+		return nil, err
+	}
+	if t.IsRegistered(userID) {
+		return nil, errAlreadyRegistered
+	}
+
+	if t.BuyIn > 0 {
+		hold := model.ChipHold{UserID: userIDint, Amount: t.BuyIn, Reference: t.HoldReference(), TTL: s.rules.HoldTTL}
+		if err := s.clientPresenter.PlaceHold(ctx, hold); err != nil {
+			return nil, fmt.Errorf("failed to hold buy-in: %w", err)
+		}
+	} else if err := s.clientPresenter.CheckPlayAllowed(ctx, userIDint, 0); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.update(ctx, tournamentID, func(t *model.Tournament) error {
+		if err := checkRegistrationOpen(t, time.Now()); err != nil {
+			return err
+		}
+		if t.IsRegistered(userID) {
+			return errAlreadyRegistered
+		}
+		t.Players = append(t.Players, userID)
+		return nil
+	})
+	if err != nil {
+		// Повторная регистрация тем же игроком опирается на тот же резерв, его снимать нельзя
+		if t.BuyIn > 0 && !errors.Is(err, errAlreadyRegistered) {
+			s.releaseBuyIns(ctx, t, []string{userID})
+		}
+		return nil, err
+	}
+	log.Printf("Use Case: User %s registered for tournament %s (%d/%d)", userID, tournamentID, len(updated.Players), updated.MaxPlayers)
+	return updated, nil
+}
+
+// Unregister снимает игрока с турнира, пока идёт регистрация, и возвращает ему бай-ин.
+func (s *TournamentServiceImpl) Unregister(ctx context.Context, tournamentID, userID string) (*model.Tournament, error) {
+	updated, err := s.update(ctx, tournamentID, func(t *model.Tournament) error {
+		if t.Status != model.TournamentStatusRegistering {
+			return fmt.Errorf("%w: tournament is %s", model.ErrTournamentRegistration, t.Status)
+		}
+		for i, p := range t.Players {
+			if p == userID {
+				t.Players = append(t.Players[:i:i], t.Players[i+1:]...)
+				return nil
+			}
+		}
+		return fmt.Errorf("%w: not registered", model.ErrTournamentRegistration)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if updated.BuyIn > 0 {
+		s.releaseBuyIns(ctx, updated, []string{userID})
+	}
+	log.Printf("Use Case: User %s unregistered from tournament %s", userID, tournamentID)
+	return updated, nil
+}
+
+// RecordMatchResult принимает итог партии, сыгранной в комнате турнирного матча. Ничья
+// (пустой winnerID или "0") не решает матч: игроки переигрывают, срок неявки отсчитывается заново.
+func (s *TournamentServiceImpl) RecordMatchResult(ctx context.Context, tournamentID, roomID, winnerID string) error {
+	_, err := s.update(ctx, tournamentID, func(t *model.Tournament) error {
+		m := currentMatchByRoom(t, roomID)
+		if m == nil || m.WinnerID != "" {
+			return errTournamentMoved
+		}
+		if winnerID == "" || winnerID == "0" {
+			m.Deadline = time.Now().Add(s.rules.NoShowTimeout)
+			return nil
+		}
+		if winnerID != m.PlayerIDs[0] && winnerID != m.PlayerIDs[1] {
+			return errTournamentMoved
+		}
+		m.WinnerID = winnerID
+		return nil
+	})
+	if errors.Is(err, errTournamentMoved) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if winnerID != "" && winnerID != "0" {
+		log.Printf("Use Case: Player %s won the match in room %s of tournament %s", winnerID, roomID, tournamentID)
+	}
+	return nil
+}
+
+// PendingMatch возвращает нерешённый матч с комнатой, в котором сейчас должен играть пользователь, или nil.
+func (s *TournamentServiceImpl) PendingMatch(ctx context.Context, userID string) (*model.TournamentMatchStart, error) {
+	tournaments, err := s.repo.ListActiveTournaments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tournaments {
+		if t.Status != model.TournamentStatusRunning || len(t.Rounds) == 0 {
+			continue
+		}
+		for _, m := range t.Rounds[len(t.Rounds)-1] {
+			if m.WinnerID == "" && m.RoomID != "" && (m.PlayerIDs[0] == userID || m.PlayerIDs[1] == userID) {
+				return &model.TournamentMatchStart{
+					TournamentID: t.ID,
+					Round:        len(t.Rounds),
+					RoomID:       m.RoomID,
+					PlayerIDs:    []string{m.PlayerIDs[0], m.PlayerIDs[1]},
+					Deadline:     m.Deadline,
+				}, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// Tick двигает все активные турниры: стартует или отменяет турниры с закрытой регистрацией,
+// создаёт комнаты матчей, засчитывает неявки, закрывает комнаты сыгранных матчей, строит
+// следующий раунд и рассчитывает завершённые турниры. На узле без аренды ничего не делает.
+func (s *TournamentServiceImpl) Tick(ctx context.Context) (*model.TournamentTick, error) {
+	tick := &model.TournamentTick{}
+	leader, err := s.repo.AcquireSchedulerLease(ctx, s.nodeID, s.rules.SchedulerLease)
+	if err != nil {
+		return nil, err
+	}
+	if !leader {
+		return tick, nil
+	}
+
+	tournaments, err := s.repo.ListActiveTournaments(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active tournaments: %w", err)
+	}
+	s.ensureSitAndGos(ctx, tournaments)
+
+	now := time.Now()
+	for _, t := range tournaments {
+		var err error
+		switch t.Status {
+		case model.TournamentStatusRegistering:
+			err = s.startOrCancel(ctx, t, now, tick)
+		case model.TournamentStatusRunning:
+			err = s.advance(ctx, t, now, tick)
+		}
+		if err != nil {
+			log.Printf("Use Case Tick: Tournament %s: %v", t.ID, err)
+		}
+	}
+	return tick, nil
+}
+
+// ensureSitAndGos держит открытым один sit-and-go каждого настроенного размера.
+func (s *TournamentServiceImpl) ensureSitAndGos(ctx context.Context, active []*model.Tournament) {
+	for _, size := range s.rules.SitAndGoSizes {
+		open := false
+		for _, t := range active {
+			if t.Format == model.TournamentFormatSitAndGo && t.Status == model.TournamentStatusRegistering && t.MaxPlayers == size {
+				open = true
+				break
+			}
+		}
+		if open {
+			continue
+		}
+		_, err := s.CreateTournament(ctx, model.CreateTournamentParams{
+			Name:       fmt.Sprintf("Sit & Go %d", size),
+			Format:     model.TournamentFormatSitAndGo,
+			BuyIn:      s.rules.SitAndGoBuyIn,
+			MaxPlayers: size,
+		})
+		if err != nil {
+			log.Printf("Use Case Tick: Failed to open a %d-player sit-and-go: %v", size, err)
+		}
+	}
+}
+
+// startOrCancel стартует sit-and-go с полным составом и турнир с закрытой регистрацией.
+// Турнир, набравший меньше MinPlayers, отменяется, бай-ины возвращаются; sit-and-go
+// отменяется так, если не набрал состав за половину срока резерва бай-ина.
+func (s *TournamentServiceImpl) startOrCancel(ctx context.Context, t *model.Tournament, now time.Time, tick *model.TournamentTick) error {
+	full := len(t.Players) >= t.MaxPlayers
+	closed := !t.RegistrationClosesAt.IsZero() && !now.Before(t.RegistrationClosesAt)
+	if t.Format == model.TournamentFormatSitAndGo && now.Sub(t.CreatedAt) >= s.rules.HoldTTL/2 {
+		closed = true
+	}
+	if !full && !closed {
+		return nil
+	}
+
+	if len(t.Players) < t.MinPlayers {
+		t.Status = model.TournamentStatusCancelled
+		t.FinishedAt = now
+		if err := s.repo.SaveTournament(ctx, t); err != nil {
+			return fmt.Errorf("failed to cancel: %w", err)
+		}
+		if t.BuyIn > 0 {
+			s.releaseBuyIns(ctx, t, t.Players)
+		}
+		tick.Cancelled = append(tick.Cancelled, t)
+		log.Printf("Use Case: Tournament %s cancelled, only %d of %d required players registered", t.ID, len(t.Players), t.MinPlayers)
+		return nil
+	}
+
+	// Посев случайный: порядок регистрации не даёт преимущества
+	rand.Shuffle(len(t.Players), func(i, j int) { t.Players[i], t.Players[j] = t.Players[j], t.Players[i] })
+	t.Payouts = s.payoutsFor(len(t.Players))
+	t.Rounds = [][]*model.TournamentMatch{firstRound(t.Players)}
+	t.Status = model.TournamentStatusRunning
+	t.StartedAt = now
+	if err := s.repo.SaveTournament(ctx, t); err != nil {
+		return fmt.Errorf("failed to start: %w", err)
+	}
+	log.Printf("Use Case: Tournament %s started with %d players", t.ID, len(t.Players))
+	return nil
+}
+
+// firstRound рассаживает игроков в сетку размером в ближайшую степень двойки.
+// Недостающие места — проходы без игры, они достаются первым по посеву.
+func firstRound(players []string) []*model.TournamentMatch {
+	size := 2
+	for size < len(players) {
+		size *= 2
+	}
+	seat := func(i int) string {
+		if i < len(players) {
+			return players[i]
+		}
+		return ""
+	}
+
+	matches := make([]*model.TournamentMatch, 0, size/2)
+	for i := 0; i < size/2; i++ {
+		m := &model.TournamentMatch{PlayerIDs: [2]string{seat(i), seat(size - 1 - i)}}
+		if m.PlayerIDs[1] == "" {
+			m.WinnerID = m.PlayerIDs[0]
+		}
+		matches = append(matches, m)
+	}
+	return matches
+}
+
+// advance двигает идущий турнир на один шаг.
+func (s *TournamentServiceImpl) advance(ctx context.Context, t *model.Tournament, now time.Time, tick *model.TournamentTick) error {
+	roundIdx := len(t.Rounds) - 1
+	round := t.Rounds[roundIdx]
+
+	decided := true
+	for i, m := range round {
+		var err error
+		switch {
+		case m.WinnerID != "" && m.RoomID != "":
+			err = s.closeMatchRoom(ctx, t.ID, roundIdx, i, tick)
+		case m.WinnerID != "":
+		case m.RoomID == "":
+			decided = false
+			err = s.openMatchRoom(ctx, t.ID, roundIdx, i, m, now, tick)
+		case now.After(m.Deadline):
+			decided = false
+			err = s.resolveNoShow(ctx, t.ID, roundIdx, i, m)
+		default:
+			decided = false
+		}
+		if err != nil {
+			log.Printf("Use Case Tick: Tournament %s, round %d, match %d: %v", t.ID, roundIdx+1, i+1, err)
+		}
+	}
+	if !decided {
+		return nil
+	}
+
+	if len(round) == 1 {
+		return s.finish(ctx, t.ID, tick)
+	}
+	_, err := s.update(ctx, t.ID, func(t *model.Tournament) error {
+		if len(t.Rounds) != roundIdx+1 {
+			return errTournamentMoved
+		}
+		prev := t.Rounds[roundIdx]
+		next := make([]*model.TournamentMatch, 0, len(prev)/2)
+		for i := 0; i+1 < len(prev); i += 2 {
+			next = append(next, &model.TournamentMatch{PlayerIDs: [2]string{prev[i].WinnerID, prev[i+1].WinnerID}})
+		}
+		t.Rounds = append(t.Rounds, next)
+		return nil
+	})
+	if err != nil && !errors.Is(err, errTournamentMoved) {
+		return fmt.Errorf("failed to start round %d: %w", roundIdx+2, err)
+	}
+	return nil
+}
+
+// openMatchRoom создаёт комнату матча через RoomUseCase и сажает в неё обоих игроков.
+// Игрок, которому играть запрещено, проигрывает матч без игры.
+func (s *TournamentServiceImpl) openMatchRoom(ctx context.Context, tournamentID string, roundIdx, matchIdx int, m *model.TournamentMatch, now time.Time, tick *model.TournamentTick) error {
+	p1, p2 := m.PlayerIDs[0], m.PlayerIDs[1]
+	room, err := s.roomUseCase.CreateRoom(model.CreateRoomParams{UserID: p1, Bet: 0, TournamentID: tournamentID})
+	if errors.Is(err, model.ErrPlayRestricted) {
+		return s.setWinner(ctx, tournamentID, roundIdx, matchIdx, p2)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create room: %w", err)
+	}
+	_, err = s.roomUseCase.JoinRoom(model.JoinRoomParams{UserID: p2, RoomID: room.ID, Bet: 0, TournamentID: tournamentID})
+	if err != nil {
+		s.deleteRoom(ctx, room.ID)
+		if errors.Is(err, model.ErrPlayRestricted) {
+			return s.setWinner(ctx, tournamentID, roundIdx, matchIdx, p1)
+		}
+		return fmt.Errorf("failed to seat player %s: %w", p2, err)
+	}
+
+	deadline := now.Add(s.rules.NoShowTimeout)
+	_, err = s.update(ctx, tournamentID, func(t *model.Tournament) error {
+		m := matchAt(t, roundIdx, matchIdx)
+		if m == nil || m.RoomID != "" || m.WinnerID != "" {
+			return errTournamentMoved
+		}
+		m.RoomID = room.ID
+		m.Deadline = deadline
+		return nil
+	})
+	if err != nil {
+		s.deleteRoom(ctx, room.ID)
+		return err
+	}
+	tick.StartedMatches = append(tick.StartedMatches, model.TournamentMatchStart{
+		TournamentID: tournamentID,
+		Round:        roundIdx + 1,
+		RoomID:       room.ID,
+		PlayerIDs:    []string{p1, p2},
+		Deadline:     deadline,
+	})
+	return nil
+}
+
+// resolveNoShow решает матч, партия которого не началась в срок. Побеждает игрок, нажавший
+// «готов»; если таких нет или готовы оба — тот, кто остался в комнате; если и так не решить —
+// первый по посеву.
+func (s *TournamentServiceImpl) resolveNoShow(ctx context.Context, tournamentID string, roundIdx, matchIdx int, m *model.TournamentMatch) error {
+	room, err := s.roomStateRepo.GetRoom(ctx, m.RoomID)
+	if err != nil && !errors.Is(err, model.ErrRoomNotFound) {
+		return err
+	}
+	if err == nil && (room.Status == "in_progress" || room.Status == "settling") {
+		return nil // партия идёт или рассчитывается, результат придёт через RecordMatchResult
+	}
+
+	var present, ready []string
+	if room != nil {
+		for _, id := range m.PlayerIDs {
+			if p := findPlayer(room, id); p != nil {
+				present = append(present, id)
+				if p.IsReady {
+					ready = append(ready, id)
+				}
+			}
+		}
+	}
+	winner := m.PlayerIDs[0]
+	switch {
+	case len(ready) == 1:
+		winner = ready[0]
+	case len(present) == 1:
+		winner = present[0]
+	}
+	log.Printf("Use Case: Match in room %s of tournament %s was not played in time, %s advances", m.RoomID, tournamentID, winner)
+	return s.setWinner(ctx, tournamentID, roundIdx, matchIdx, winner)
+}
+
+// setWinner присуждает матч без игры.
+func (s *TournamentServiceImpl) setWinner(ctx context.Context, tournamentID string, roundIdx, matchIdx int, winnerID string) error {
+	_, err := s.update(ctx, tournamentID, func(t *model.Tournament) error {
+		m := matchAt(t, roundIdx, matchIdx)
+		if m == nil || m.WinnerID != "" {
+			return errTournamentMoved
+		}
+		m.WinnerID = winnerID
+		m.Forfeit = true
+		return nil
+	})
+	if errors.Is(err, errTournamentMoved) {
+		return nil
+	}
+	return err
+}
+
+// closeMatchRoom удаляет комнату решённого матча и отвязывает её от матча.
+func (s *TournamentServiceImpl) closeMatchRoom(ctx context.Context, tournamentID string, roundIdx, matchIdx int, tick *model.TournamentTick) error {
+	var roomID string
+	_, err := s.update(ctx, tournamentID, func(t *model.Tournament) error {
+		m := matchAt(t, roundIdx, matchIdx)
+		if m == nil || m.RoomID == "" {
+			return errTournamentMoved
+		}
+		roomID = m.RoomID
+		m.RoomID = ""
+		return nil
+	})
+	if errors.Is(err, errTournamentMoved) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	closed := model.ClosedRoom{RoomID: roomID}
+	if room, err := s.roomStateRepo.GetRoom(ctx, roomID); err == nil {
+		closed.PlayerIDs = playerIDs(room)
+		if err := s.roomStateRepo.DeleteRoom(ctx, room); err != nil {
+			log.Printf("Use Case Tick: Failed to delete room %s of a finished match: %v", roomID, err)
+		}
+	}
+	tick.ClosedRooms = append(tick.ClosedRooms, closed)
+	return nil
+}
+
+func (s *TournamentServiceImpl) deleteRoom(ctx context.Context, roomID string) {
+	room, err := s.roomStateRepo.GetRoom(ctx, roomID)
+	if err == nil {
+		err = s.roomStateRepo.DeleteRoom(ctx, room)
+	}
+	if err != nil && !errors.Is(err, model.ErrRoomNotFound) {
+		log.Printf("Use Case: Failed to delete tournament room %s: %v", roomID, err)
+	}
+}
+
+// finish рассчитывает турнир одной операцией в user-service и публикует TournamentResult.
+// Расчёт идемпотентен по ссылке резерва, поэтому повтор после сбоя ничего не выплатит дважды.
+func (s *TournamentServiceImpl) finish(ctx context.Context, tournamentID string, tick *model.TournamentTick) error {
+	t, err := s.repo.GetTournament(ctx, tournamentID)
+	if err != nil {
+		return err
+	}
+	standings := computeStandings(t)
+
+	if t.BuyIn > 0 {
+		settlement := model.Settlement{GameID: t.HoldReference(), Deltas: make([]model.PlayerDelta, 0, len(standings))}
+		for _, st := range standings {
+			userID, err := strconv.ParseInt(st.PlayerID, 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse player id %s: %w", st.PlayerID, err)
+			}
+			settlement.Deltas = append(settlement.Deltas, model.PlayerDelta{UserID: userID, Delta: st.Prize - t.BuyIn})
+		}
+		if _, err := s.clientPresenter.SettleMatch(ctx, settlement); err != nil {
+			return fmt.Errorf("failed to pay out: %w", err)
+		}
+	}
+
+	finished, err := s.update(ctx, tournamentID, func(t *model.Tournament) error {
+		if t.Status != model.TournamentStatusRunning {
+			return errTournamentMoved
+		}
+		t.Status = model.TournamentStatusFinished
+		t.Standings = standings
+		t.FinishedAt = time.Now()
+		return nil
+	})
+	if errors.Is(err, errTournamentMoved) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log.Printf("Use Case: Tournament %s finished, %s wins %d", finished.ID, standings[0].PlayerID, standings[0].Prize)
+
+	if err := s.producer.PushTournamentResult(ctx, finished); err != nil {
+		log.Printf("Use Case: Failed to publish result of tournament %s: %v", finished.ID, err)
+	}
+	tick.Finished = append(tick.Finished, finished)
+	return nil
+}
+
+// computeStandings расставляет игроков по местам: победитель, финалист, затем проигравшие
+// каждого раунда от последнего к первому. Выбывшие в одном раунде делят место и поровну
+// делят призы этих мест; остаток от деления достаётся победителю.
+func computeStandings(t *model.Tournament) []model.TournamentStanding {
+	pool := t.PrizePool()
+	prizeFor := func(place int) int64 {
+		if place > len(t.Payouts) {
+			return 0
+		}
+		return pool * int64(t.Payouts[place-1]) / 100
+	}
+
+	final := t.Rounds[len(t.Rounds)-1][0]
+	standings := []model.TournamentStanding{{PlayerID: final.WinnerID, Place: 1, Prize: prizeFor(1)}}
+	for r := len(t.Rounds) - 1; r >= 0; r-- {
+		var losers []string
+		for _, m := range t.Rounds[r] {
+			for _, p := range m.PlayerIDs {
+				if p != "" && p != m.WinnerID {
+					losers = append(losers, p)
+				}
+			}
+		}
+		sort.Strings(losers)
+		place := len(standings) + 1
+		var groupPrize int64
+		for i := range losers {
+			groupPrize += prizeFor(place + i)
+		}
+		for _, p := range losers {
+			standings = append(standings, model.TournamentStanding{PlayerID: p, Place: place, Prize: groupPrize / int64(len(losers))})
+		}
+	}
+
+	var paid int64
+	for _, st := range standings {
+		paid += st.Prize
+	}
+	standings[0].Prize += pool - paid
+	return standings
+}
+
+func matchAt(t *model.Tournament, roundIdx, matchIdx int) *model.TournamentMatch {
+	if t.Status != model.TournamentStatusRunning || roundIdx >= len(t.Rounds) || matchIdx >= len(t.Rounds[roundIdx]) {
+		return nil
+	}
+	return t.Rounds[roundIdx][matchIdx]
+}
+
+func currentMatchByRoom(t *model.Tournament, roomID string) *model.TournamentMatch {
+	if t.Status != model.TournamentStatusRunning || len(t.Rounds) == 0 {
+		return nil
+	}
+	for _, m := range t.Rounds[len(t.Rounds)-1] {
+		if m.RoomID == roomID {
+			return m
+		}
+	}
+	return nil
+}
+
+// releaseBuyIns снимает резервы бай-инов игроков. Снятие уже снятого резерва ничего не делает.
+func (s *TournamentServiceImpl) releaseBuyIns(ctx context.Context, t *model.Tournament, playerIDs []string) {
+	for _, pID := range playerIDs {
+		pIDint, err := strconv.ParseInt(pID, 10, 64)
+		if err != nil {
+			continue
+		}
+		if err := s.clientPresenter.ReleaseHold(ctx, pIDint, t.HoldReference()); err != nil {
+			log.Printf("Use Case: Failed to release buy-in of player %s for tournament %s: %v", pID, t.ID, err)
+		}
+	}
+}
+
+// update перечитывает турнир и применяет change, пока запись не пройдёт проверку версии.
+// change не должен иметь побочных эффектов: при конфликте он вызывается заново.
+func (s *TournamentServiceImpl) update(ctx context.Context, tournamentID string, change func(t *model.Tournament) error) (*model.Tournament, error) {
+	for attempt := 0; attempt < tournamentUpdateAttempts; attempt++ {
+		t, err := s.repo.GetTournament(ctx, tournamentID)
+		if err != nil {
+			return nil, err
+		}
+		if err := change(t); err != nil {
+			return nil, err
+		}
+		err = s.repo.SaveTournament(ctx, t)
+		if errors.Is(err, model.ErrTournamentStateConflict) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return t, nil
+	}
+	return nil, fmt.Errorf("tournament %s: %w", tournamentID, model.ErrTournamentStateConflict)
+}
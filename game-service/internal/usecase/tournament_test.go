@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"game_svc/internal/model"
+)
+
+func TestParsePayoutTable(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    map[int][]int
+		wantErr bool
+	}{
+		{name: "single row", in: "2:100", want: map[int][]int{2: {100}}},
+		{name: "several rows with spaces", in: " 2:100 ; 4: 70, 30;", want: map[int][]int{2: {100}, 4: {70, 30}}},
+		{name: "empty", in: " ; ", wantErr: true},
+		{name: "missing colon", in: "4-70,30", wantErr: true},
+		{name: "field too small", in: "1:100", wantErr: true},
+		{name: "shares don't sum to 100", in: "4:70,20", wantErr: true},
+		{name: "more places than players", in: "2:50,30,20", wantErr: true},
+		{name: "negative share", in: "4:110,-10", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePayoutTable(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParsePayoutTable(%q) = %v, want error", tt.in, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParsePayoutTable(%q): %v", tt.in, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParsePayoutTable(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFirstRoundGivesByesToTopSeeds(t *testing.T) {
+	tests := []struct {
+		players int
+		want    [][2]string // пары первого раунда; "" — проход без игры
+	}{
+		{players: 2, want: [][2]string{{"1", "2"}}},
+		{players: 3, want: [][2]string{{"1", ""}, {"2", "3"}}},
+		{players: 5, want: [][2]string{{"1", ""}, {"2", ""}, {"3", ""}, {"4", "5"}}},
+		{players: 8, want: [][2]string{{"1", "8"}, {"2", "7"}, {"3", "6"}, {"4", "5"}}},
+	}
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.players), func(t *testing.T) {
+			matches := firstRound(seededPlayers(tt.players))
+			if len(matches) != len(tt.want) {
+				t.Fatalf("got %d matches, want %d", len(matches), len(tt.want))
+			}
+			for i, m := range matches {
+				if m.PlayerIDs != tt.want[i] {
+					t.Errorf("match %d: got %v, want %v", i+1, m.PlayerIDs, tt.want[i])
+				}
+				wantWinner := ""
+				if m.PlayerIDs[1] == "" {
+					wantWinner = m.PlayerIDs[0]
+				}
+				if m.WinnerID != wantWinner {
+					t.Errorf("match %d: winner %q, want %q", i+1, m.WinnerID, wantWinner)
+				}
+			}
+		})
+	}
+}
+
+func TestComputeStandingsPaysOutThePrizePool(t *testing.T) {
+	tests := []struct {
+		name    string
+		payouts string
+		players int
+		buyIn   int64
+		want    []model.TournamentStanding
+	}{
+		{
+			// Для трёх игроков нет строки таблицы, весь фонд получает победитель
+			name: "3 players", payouts: "4:70,30", players: 3, buyIn: 100,
+			want: []model.TournamentStanding{
+				{PlayerID: "1", Place: 1, Prize: 300},
+				{PlayerID: "2", Place: 2, Prize: 0},
+				{PlayerID: "3", Place: 3, Prize: 0},
+			},
+		},
+		{
+			name: "5 players with byes", payouts: "4:70,30", players: 5, buyIn: 100,
+			want: []model.TournamentStanding{
+				{PlayerID: "1", Place: 1, Prize: 350},
+				{PlayerID: "3", Place: 2, Prize: 150},
+				{PlayerID: "2", Place: 3, Prize: 0},
+				{PlayerID: "4", Place: 3, Prize: 0},
+				{PlayerID: "5", Place: 5, Prize: 0},
+			},
+		},
+		{
+			// 808 * 70% и 808 * 30% округляются вниз, остаток в одну фишку достаётся победителю
+			name: "8 players with a remainder", payouts: "4:70,30", players: 8, buyIn: 101,
+			want: []model.TournamentStanding{
+				{PlayerID: "1", Place: 1, Prize: 566},
+				{PlayerID: "3", Place: 2, Prize: 242},
+				{PlayerID: "2", Place: 3, Prize: 0},
+				{PlayerID: "4", Place: 3, Prize: 0},
+				{PlayerID: "5", Place: 5, Prize: 0},
+				{PlayerID: "6", Place: 5, Prize: 0},
+				{PlayerID: "7", Place: 5, Prize: 0},
+				{PlayerID: "8", Place: 5, Prize: 0},
+			},
+		},
+		{
+			// Проигравшие в полуфинале делят призы третьего и четвёртого мест поровну
+			name: "4 players sharing a place", payouts: "4:60,20,15,5", players: 4, buyIn: 100,
+			want: []model.TournamentStanding{
+				{PlayerID: "1", Place: 1, Prize: 240},
+				{PlayerID: "2", Place: 2, Prize: 80},
+				{PlayerID: "3", Place: 3, Prize: 40},
+				{PlayerID: "4", Place: 3, Prize: 40},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table, err := ParsePayoutTable(tt.payouts)
+			if err != nil {
+				t.Fatalf("ParsePayoutTable(%q): %v", tt.payouts, err)
+			}
+			s := &TournamentServiceImpl{rules: model.TournamentRules{Payouts: table}}
+			tour := &model.Tournament{BuyIn: tt.buyIn, Players: seededPlayers(tt.players)}
+			tour.Payouts = s.payoutsFor(len(tour.Players))
+			tour.Rounds = playBracket(tour.Players)
+
+			got := computeStandings(tour)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("standings:\n got %+v\nwant %+v", got, tt.want)
+			}
+			var paid int64
+			for _, st := range got {
+				paid += st.Prize
+			}
+			if paid != tour.PrizePool() {
+				t.Errorf("paid %d, prize pool is %d", paid, tour.PrizePool())
+			}
+		})
+	}
+}
+
+// seededPlayers возвращает игроков "1".."n" в порядке посева.
+func seededPlayers(n int) []string {
+	players := make([]string, n)
+	for i := range players {
+		players[i] = strconv.Itoa(i + 1)
+	}
+	return players
+}
+
+// playBracket разыгрывает сетку так же, как advance, отдавая каждый матч игроку выше по посеву.
+func playBracket(players []string) [][]*model.TournamentMatch {
+	rounds := [][]*model.TournamentMatch{firstRound(players)}
+	for {
+		round := rounds[len(rounds)-1]
+		for _, m := range round {
+			if m.WinnerID == "" {
+				m.WinnerID = m.PlayerIDs[0]
+			}
+		}
+		if len(round) == 1 {
+			return rounds
+		}
+		next := make([]*model.TournamentMatch, 0, len(round)/2)
+		for i := 0; i+1 < len(round); i += 2 {
+			next = append(next, &model.TournamentMatch{PlayerIDs: [2]string{round[i].WinnerID, round[i+1].WinnerID}})
+		}
+		rounds = append(rounds, next)
+	}
+}
@@ -2,6 +2,8 @@ package ws
 
 import (
 	"log"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -34,19 +36,83 @@ type Client struct {
 	// UserID пользователя, связанного с этим клиентом.
 	UserID string
 
-	// RoomID комнаты, в которой находится клиент.
-	RoomID string
+	// roomID комнаты, в которой находится клиент. Меняет его только хаб (SetClientRoom),
+	// а читают обработчики команд без мьютекса хаба, поэтому значение атомарное.
+	roomID atomic.Pointer[string]
+
+	// ProtocolVersion — версия протокола, согласованная при подключении (ProtocolLegacy, ProtocolV1).
+	ProtocolVersion int
+	// Codec кодирует сообщения согласованного подпротокола; nil — прежний JSON-формат.
+	Codec Codec
+
+	// RemoteIP — адрес, под который соединение заняло место в лимите Hub.AcquireConnection.
+	RemoteIP string
+
+	// Done закрывается, когда соединение с клиентом завершено.
+	Done chan struct{}
+
+	// droppedInRow — сколько сообщений подряд не поместилось в Send. Меняется под мьютексом хаба.
+	droppedInRow int
+	closeOnce    sync.Once
+	// readOnly выставляется хабом для дополнительных соединений при политике SessionPolicyMultiView.
+	readOnly atomic.Bool
+	// limiter — лимиты команд этого соединения. У клиента одной команды вместо него
+	// используется sharedLimiter — общие лимиты всех команд пользователя без соединения.
+	limiter       commandLimiter
+	sharedLimiter *commandLimiter
+	// closeMessage — кадр закрытия, который WritePump отправит после закрытия Send. Пустой — без кода.
+	closeMessage []byte
+	// streamClosed задан у клиента-потока без Conn (NewStreamClient): его закрытие завершает StreamPump.
+	streamClosed chan struct{}
+	// detached — клиент одной команды без соединения (NewCommandClient), в хабе не регистрируется.
+	detached bool
+}
+
+// RemoteAddr возвращает адрес соединения для логов; у клиента без WebSocket — адрес, переданный при создании.
+func (c *Client) RemoteAddr() string {
+	if c.Conn == nil {
+		return c.RemoteIP
+	}
+	return c.Conn.RemoteAddr().String()
+}
+
+// RoomID возвращает комнату, в которой находится клиент; пустая строка — клиент не в комнате.
+func (c *Client) RoomID() string {
+	if roomID := c.roomID.Load(); roomID != nil {
+		return *roomID
+	}
+	return ""
+}
+
+func (c *Client) setRoomID(roomID string) {
+	c.roomID.Store(&roomID)
+}
+
+// closeConn закрывает соединение один раз; ReadPump (или StreamPump) после этого завершится и отключит клиента.
+func (c *Client) closeConn() {
+	c.closeOnce.Do(func() {
+		if c.Conn == nil {
+			if c.streamClosed != nil {
+				close(c.streamClosed)
+			}
+			return
+		}
+		if err := c.Conn.Close(); err != nil {
+			log.Printf("Error closing connection for client %s: %v", c.UserID, err)
+		}
+	})
 }
 
 // ReadPump считывает сообщения от WebSocket соединения и передает их в хаб.
 // Запускается в отдельной горутине для каждого соединения.
 func (c *Client) ReadPump() {
 	defer func() {
+		close(c.Done)
 		c.Hub.Unregister <- c
 		if err := c.Conn.Close(); err != nil {
 			log.Printf("Error closing connection in ReadPump for client %s: %v", c.UserID, err)
 		}
-		log.Printf("ReadPump stopped for client %s (UserID: %s)", c.Conn.RemoteAddr(), c.UserID)
+		log.Printf("ReadPump stopped for client %s (UserID: %s)", c.RemoteAddr(), c.UserID)
 	}()
 	c.Conn.SetReadLimit(maxMessageSize)
 	if err := c.Conn.SetReadDeadline(time.Now().Add(pongWait)); err != nil {
@@ -66,27 +132,36 @@ func (c *Client) ReadPump() {
 		_, message, err := c.Conn.ReadMessage()
 		if err != nil {
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
-				log.Printf("Unexpected close error for client %s (UserID: %s): %v", c.Conn.RemoteAddr(), c.UserID, err)
+				log.Printf("Unexpected close error for client %s (UserID: %s): %v", c.RemoteAddr(), c.UserID, err)
 			} else {
-				log.Printf("Read error for client %s (UserID: %s): %v", c.Conn.RemoteAddr(), c.UserID, err)
+				log.Printf("Read error for client %s (UserID: %s): %v", c.RemoteAddr(), c.UserID, err)
 			}
 			break // Выход из цикла при ошибке чтения или закрытии соединения
 		}
 
+		if c.Codec != nil {
+			if message, err = c.Codec.Decode(message); err != nil {
+				log.Printf("Dropping undecodable message from client %s (UserID: %s): %v", c.RemoteAddr(), c.UserID, err)
+				continue
+			}
+		}
+
 		// Создаем RawMessage для передачи в хаб
 		// Игровая логика (парсер JSON и т.д.) будет вызываться обработчиком в хабе
 		rawMessage := &RawMessage{
 			Client:  c,
 			Payload: message,
 		}
-		// Отправляем сообщение в канал Broadcast хаба
-		// Важно: убедитесь, что канал Broadcast хаба читается, иначе здесь будет блокировка
-		select {
-		case c.Hub.Broadcast <- rawMessage:
-		default:
-			log.Printf("Hub broadcast channel full, dropping message from client %s", c.UserID)
-			// Можно рассмотреть вариант закрытия соединения, если хаб не справляется
+		if reason := c.admit(message); reason != "" {
+			c.Hub.reject(rawMessage, reason)
+			if c.recordViolation() {
+				break
+			}
+			continue
 		}
+		// Отправляем сообщение в канал Broadcast хаба. Если хаб не успевает, ждёт только этот клиент:
+		// его следующие кадры не читаются, а сообщения других клиентов не теряются.
+		c.Hub.Broadcast <- rawMessage
 	}
 }
 
@@ -99,7 +174,7 @@ func (c *Client) WritePump() {
 		if err := c.Conn.Close(); err != nil {
 			log.Printf("Error closing connection in WritePump for client %s: %v", c.UserID, err)
 		}
-		log.Printf("WritePump stopped for client %s (UserID: %s)", c.Conn.RemoteAddr(), c.UserID)
+		log.Printf("WritePump stopped for client %s (UserID: %s)", c.RemoteAddr(), c.UserID)
 	}()
 
 	for {
@@ -111,15 +186,19 @@ func (c *Client) WritePump() {
 			}
 			if !ok {
 				// Канал Send был закрыт хабом.
-				log.Printf("Client %s (UserID: %s) send channel closed.", c.Conn.RemoteAddr(), c.UserID)
-				if err := c.Conn.WriteMessage(websocket.CloseMessage, []byte{}); err != nil {
+				log.Printf("Client %s (UserID: %s) send channel closed.", c.RemoteAddr(), c.UserID)
+				closeMessage := c.closeMessage
+				if closeMessage == nil {
+					closeMessage = []byte{}
+				}
+				if err := c.Conn.WriteMessage(websocket.CloseMessage, closeMessage); err != nil {
 					log.Printf("Error writing close message for client %s: %v", c.UserID, err)
 				}
 				return
 			}
 
-			if err := c.Conn.WriteMessage(websocket.TextMessage, message); err != nil {
-				log.Printf("Error writing message to client %s (UserID: %s): %v", c.Conn.RemoteAddr(), c.UserID, err)
+			if err := c.Conn.WriteMessage(c.frameType(), message); err != nil {
+				log.Printf("Error writing message to client %s (UserID: %s): %v", c.RemoteAddr(), c.UserID, err)
 				return
 			}
 
@@ -129,7 +208,7 @@ func (c *Client) WritePump() {
 				return
 			}
 			if err := c.Conn.WriteMessage(websocket.PingMessage, nil); err != nil {
-				log.Printf("Error sending ping to client %s (UserID: %s): %v", c.Conn.RemoteAddr(), c.UserID, err)
+				log.Printf("Error sending ping to client %s (UserID: %s): %v", c.RemoteAddr(), c.UserID, err)
 				return // Выход при ошибке отправки ping
 			}
 		}
@@ -0,0 +1,128 @@
+package ws
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// dialTestConn поднимает WebSocket-сервер и возвращает серверную сторону настоящего соединения.
+func dialTestConn(t *testing.T) (server *websocket.Conn, client *websocket.Conn) {
+	t.Helper()
+	conns := make(chan *websocket.Conn, 1)
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("upgrade: %v", err)
+			return
+		}
+		conns <- conn
+	}))
+	t.Cleanup(srv.Close)
+
+	client, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+	select {
+	case server = <-conns:
+	case <-time.After(5 * time.Second):
+		t.Fatal("server side of the connection was not accepted")
+	}
+	t.Cleanup(func() { server.Close() })
+	return server, client
+}
+
+func TestClientRemoteAddr(t *testing.T) {
+	serverConn, clientConn := dialTestConn(t)
+	c := &Client{Conn: serverConn, UserID: "1"}
+
+	if got, want := c.RemoteAddr(), clientConn.LocalAddr().String(); got != want {
+		t.Fatalf("RemoteAddr() = %q, want %q", got, want)
+	}
+
+	stream := &Client{RemoteIP: "10.0.0.1"}
+	if got := stream.RemoteAddr(); got != "10.0.0.1" {
+		t.Fatalf("RemoteAddr() without a connection = %q, want %q", got, "10.0.0.1")
+	}
+}
+
+func TestHubRegistersRealConnection(t *testing.T) {
+	serverConn, _ := dialTestConn(t)
+	hub := NewHub(func(*RawMessage) {}, nil)
+	go hub.Run()
+
+	c := &Client{Hub: hub, Conn: serverConn, Send: make(chan []byte, 1), UserID: "1", Done: make(chan struct{})}
+	hub.Register <- c
+	deadline := time.Now().Add(5 * time.Second)
+	for hub.GetClientCount() != 1 {
+		if time.Now().After(deadline) {
+			t.Fatal("client was not registered")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	hub.Unregister <- c
+}
+
+// Комнату клиента меняет хаб, а обработчики команд читают её без мьютекса хаба; под -race
+// тест ловит гонку между ними для зарегистрированного клиента и для команды без соединения.
+func TestClientRoomChangesWhileHandlersReadIt(t *testing.T) {
+	hub := NewHub(func(*RawMessage) {}, nil)
+	go hub.Run()
+	registered := registerTestClient(t, hub, "1")
+	command := NewCommandClient(hub, "2", "", nil)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			_ = registered.RoomID()
+			_ = command.RoomID()
+			_ = hub.mailboxKey(&RawMessage{Client: registered})
+		}
+	}()
+	for i := 0; i < 1000; i++ {
+		hub.SetClientRoom(registered, "room-"+string(rune('a'+i%2)))
+		hub.SetClientRoom(command, "room-c")
+	}
+	<-done
+
+	if got := registered.RoomID(); got != "room-b" {
+		t.Fatalf("RoomID() = %q, want %q", got, "room-b")
+	}
+	if got := command.RoomID(); got != "room-c" {
+		t.Fatalf("RoomID() of a command client = %q, want %q", got, "room-c")
+	}
+}
+
+// Каждая REST-команда приходит новым клиентом без соединения, поэтому лимиты считаются на пользователя:
+// вторая команда сверх burst отклоняется, даже если её принёс другой клиент того же пользователя.
+func TestSubmitLimitsCommandsPerUser(t *testing.T) {
+	hub := NewHub(func(*RawMessage) {}, nil)
+	hub.Limits = Limits{MessageRate: Rate{PerSecond: 0.001, Burst: 1}}
+	var rejected []string
+	hub.RejectHandler = func(msg *RawMessage, reason string) {
+		rejected = append(rejected, msg.Client.UserID+":"+reason)
+	}
+
+	submit := func(userID string) {
+		hub.Submit(&RawMessage{Client: NewCommandClient(hub, userID, "", nil), Payload: []byte(`{"type":"hit"}`)})
+	}
+	submit("1")
+	submit("1")
+	submit("2")
+
+	want := []string{"1:" + RejectRateLimited}
+	if len(rejected) != len(want) || rejected[0] != want[0] {
+		t.Fatalf("rejected %v, want %v", rejected, want)
+	}
+	if got := len(hub.Broadcast); got != 2 {
+		t.Fatalf("%d commands reached the hub, want 2", got)
+	}
+}
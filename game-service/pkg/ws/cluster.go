@@ -0,0 +1,263 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+)
+
+// Bus разносит сообщения хаба между узлами game-service (например, через NATS).
+type Bus interface {
+	Publish(subject string, data []byte) error
+	Subscribe(subject string, handler func(data []byte)) error
+}
+
+// Presence хранит, к какому узлу подключен пользователь. Записи ограничены по времени:
+// узел продлевает их через Refresh, и пользователи упавшего узла пропадают из сети сами.
+type Presence interface {
+	SetNode(ctx context.Context, userID, nodeID string) error
+	// Refresh продлевает записи пользователей узла nodeID. Запись, которую уже занял
+	// другой узел, не меняется.
+	Refresh(ctx context.Context, nodeID string, userIDs []string) error
+	// RemoveNode удаляет запись, только если пользователь всё ещё числится за nodeID:
+	// он мог уже переподключиться к другому узлу.
+	RemoveNode(ctx context.Context, userID, nodeID string) error
+	// NodeOf возвращает узел пользователя или пустую строку, если он не в сети.
+	NodeOf(ctx context.Context, userID string) (string, error)
+	// CountOnline возвращает число пользователей в сети на всех узлах.
+	CountOnline(ctx context.Context) (int64, error)
+}
+
+// Виды сообщений между узлами.
+const (
+	clusterKindRoom    = "room"    // рассылка по комнате
+	clusterKindAll     = "all"     // рассылка всем
+	clusterKindUser    = "user"    // сообщение одному пользователю
+	clusterKindAttach  = "attach"  // перевести пользователя в комнату
+	clusterKindDetach  = "detach"  // вывести пользователя из комнаты, если он всё ещё в ней
+	clusterKindReplace = "replace" // пользователь подключился к другому узлу, закрыть его соединения здесь
+	clusterKindKick    = "kick"    // администратор отключил пользователя
+)
+
+// clusterEnvelope — сообщение, которым обмениваются хабы разных узлов.
+type clusterEnvelope struct {
+	Origin string `json:"origin"`
+	Kind   string `json:"kind"`
+	RoomID string `json:"room_id,omitempty"`
+	UserID string `json:"user_id,omitempty"`
+	Data   []byte `json:"data,omitempty"`
+}
+
+type cluster struct {
+	nodeID        string
+	subjectPrefix string
+	bus           Bus
+	presence      Presence
+}
+
+func (c *cluster) broadcastSubject() string {
+	return c.subjectPrefix + ".broadcast"
+}
+
+func (c *cluster) nodeSubject(nodeID string) string {
+	return c.subjectPrefix + ".node." + nodeID
+}
+
+// EnableCluster подключает хаб к остальным узлам: рассылки по комнатам и всем уходят в общий
+// subject, а сообщения конкретному пользователю — в subject узла, к которому он подключен.
+// Вызывается до Run.
+func (h *Hub) EnableCluster(nodeID, subjectPrefix string, bus Bus, presence Presence) error {
+	c := &cluster{nodeID: nodeID, subjectPrefix: subjectPrefix, bus: bus, presence: presence}
+	if err := bus.Subscribe(c.broadcastSubject(), h.handleClusterMessage); err != nil {
+		return fmt.Errorf("subscribe to %s: %w", c.broadcastSubject(), err)
+	}
+	if err := bus.Subscribe(c.nodeSubject(nodeID), h.handleClusterMessage); err != nil {
+		return fmt.Errorf("subscribe to %s: %w", c.nodeSubject(nodeID), err)
+	}
+	h.cluster = c
+	log.Printf("Hub: Cluster mode enabled, node %s", nodeID)
+	return nil
+}
+
+func (h *Hub) handleClusterMessage(data []byte) {
+	var env clusterEnvelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		log.Printf("Hub: Invalid cluster message: %v", err)
+		return
+	}
+	if env.Origin == h.cluster.nodeID {
+		return
+	}
+	switch env.Kind {
+	case clusterKindRoom:
+		h.deliverToRoom(env.RoomID, env.Data)
+	case clusterKindAll:
+		h.deliverToAll(env.Data)
+	case clusterKindUser:
+		if client, ok := h.GetClientByUserID(env.UserID); ok {
+			h.BroadcastToClient(client, env.Data)
+		}
+	case clusterKindAttach:
+		h.setLocalUserRoom(env.UserID, "", env.RoomID)
+	case clusterKindDetach:
+		h.setLocalUserRoom(env.UserID, env.RoomID, "")
+	case clusterKindReplace:
+		h.replaceLocalSession(env.UserID, env.Origin)
+	case clusterKindKick:
+		h.kickLocalUser(env.UserID, env.Data)
+	}
+}
+
+// publish отправляет сообщение другим узлам. Без кластера ничего не делает.
+func (h *Hub) publish(subject string, env clusterEnvelope) {
+	env.Origin = h.cluster.nodeID
+	data, err := json.Marshal(env)
+	if err != nil {
+		log.Printf("Hub: Failed to marshal cluster message: %v", err)
+		return
+	}
+	if err := h.cluster.bus.Publish(subject, data); err != nil {
+		log.Printf("Hub: Failed to publish cluster message to %s: %v", subject, err)
+	}
+}
+
+// publishToUser отправляет сообщение узлу, к которому подключен пользователь.
+// Возвращает false, если пользователь не в сети ни на одном узле.
+func (h *Hub) publishToUser(env clusterEnvelope) bool {
+	if h.cluster == nil {
+		return false
+	}
+	nodeID, err := h.cluster.presence.NodeOf(context.Background(), env.UserID)
+	if err != nil {
+		log.Printf("Hub: Failed to look up node of user %s: %v", env.UserID, err)
+		return false
+	}
+	if nodeID == "" || nodeID == h.cluster.nodeID {
+		return false
+	}
+	h.publish(h.cluster.nodeSubject(nodeID), env)
+	return true
+}
+
+// IsUserOnline сообщает, подключен ли пользователь к этому или другому узлу.
+func (h *Hub) IsUserOnline(userID string) bool {
+	if _, ok := h.GetClientByUserID(userID); ok {
+		return true
+	}
+	if h.cluster == nil {
+		return false
+	}
+	nodeID, err := h.cluster.presence.NodeOf(context.Background(), userID)
+	if err != nil {
+		log.Printf("Hub: Failed to look up node of user %s: %v", userID, err)
+		return false
+	}
+	return nodeID != ""
+}
+
+// OnlineCount возвращает число пользователей в сети: на всех узлах, если хаб в кластере, иначе на этом.
+func (h *Hub) OnlineCount(ctx context.Context) (int64, error) {
+	if h.cluster == nil {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		return int64(len(h.sessions)), nil
+	}
+	return h.cluster.presence.CountOnline(ctx)
+}
+
+// SendToUser отправляет сообщение пользователю, на каком бы узле он ни был.
+func (h *Hub) SendToUser(userID string, message []byte) {
+	if client, ok := h.GetClientByUserID(userID); ok {
+		h.BroadcastToClient(client, message)
+		return
+	}
+	h.publishToUser(clusterEnvelope{Kind: clusterKindUser, UserID: userID, Data: message})
+}
+
+// SetUserRoom переводит пользователя в комнату roomID, на каком бы узле он ни был подключен.
+func (h *Hub) SetUserRoom(userID, roomID string) {
+	if h.setLocalUserRoom(userID, "", roomID) {
+		return
+	}
+	h.publishToUser(clusterEnvelope{Kind: clusterKindAttach, UserID: userID, RoomID: roomID})
+}
+
+// ClearUserRoom выводит пользователя из комнаты roomID, если он всё ещё в ней.
+func (h *Hub) ClearUserRoom(userID, roomID string) {
+	if h.setLocalUserRoom(userID, roomID, "") {
+		return
+	}
+	h.publishToUser(clusterEnvelope{Kind: clusterKindDetach, UserID: userID, RoomID: roomID})
+}
+
+// LocalUserRoom возвращает комнату пользователя, если он подключен к этому узлу.
+func (h *Hub) LocalUserRoom(userID string) (string, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	client, ok := h.clientsByUserID[userID]
+	if !ok {
+		return "", false
+	}
+	return client.RoomID(), true
+}
+
+// setLocalUserRoom меняет комнату локального клиента. Если fromRoomID не пуст, комната меняется,
+// только если клиент сейчас в ней. Возвращает false, если клиент подключен не к этому узлу.
+func (h *Hub) setLocalUserRoom(userID, fromRoomID, toRoomID string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	client, ok := h.clientsByUserID[userID]
+	if ok && (fromRoomID == "" || client.RoomID() == fromRoomID) {
+		h.setClientRoomLocked(client, toRoomID)
+	}
+	return ok
+}
+
+func (h *Hub) setPresence(userID string) {
+	if h.cluster == nil {
+		return
+	}
+	if err := h.cluster.presence.SetNode(context.Background(), userID, h.cluster.nodeID); err != nil {
+		log.Printf("Hub: Failed to record presence of user %s: %v", userID, err)
+	}
+}
+
+func (h *Hub) clearPresence(userID string) {
+	if h.cluster == nil {
+		return
+	}
+	if err := h.cluster.presence.RemoveNode(context.Background(), userID, h.cluster.nodeID); err != nil {
+		log.Printf("Hub: Failed to clear presence of user %s: %v", userID, err)
+	}
+}
+
+// RunPresenceHeartbeat продлевает записи о присутствии пользователей этого узла каждые interval,
+// пока не отменён ctx. Без кластера ничего не делает.
+func (h *Hub) RunPresenceHeartbeat(ctx context.Context, interval time.Duration) {
+	if h.cluster == nil {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			h.mu.Lock()
+			userIDs := make([]string, 0, len(h.sessions))
+			for userID := range h.sessions {
+				userIDs = append(userIDs, userID)
+			}
+			h.mu.Unlock()
+			if len(userIDs) == 0 {
+				continue
+			}
+			if err := h.cluster.presence.Refresh(ctx, h.cluster.nodeID, userIDs); err != nil {
+				log.Printf("Hub: Failed to refresh presence of %d users: %v", len(userIDs), err)
+			}
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package ws
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memBus — шина в памяти: Publish синхронно вызывает всех подписчиков subject.
+type memBus struct {
+	mu       sync.Mutex
+	handlers map[string][]func([]byte)
+}
+
+func newMemBus() *memBus {
+	return &memBus{handlers: make(map[string][]func([]byte))}
+}
+
+func (b *memBus) Publish(subject string, data []byte) error {
+	b.mu.Lock()
+	handlers := append([]func([]byte){}, b.handlers[subject]...)
+	b.mu.Unlock()
+	for _, handler := range handlers {
+		handler(data)
+	}
+	return nil
+}
+
+func (b *memBus) Subscribe(subject string, handler func([]byte)) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[subject] = append(b.handlers[subject], handler)
+	return nil
+}
+
+// memPresence — Presence в памяти без срока жизни записей.
+type memPresence struct {
+	mu    sync.Mutex
+	nodes map[string]string
+}
+
+func newMemPresence() *memPresence {
+	return &memPresence{nodes: make(map[string]string)}
+}
+
+func (p *memPresence) SetNode(_ context.Context, userID, nodeID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.nodes[userID] = nodeID
+	return nil
+}
+
+func (p *memPresence) Refresh(_ context.Context, nodeID string, userIDs []string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, userID := range userIDs {
+		if current, ok := p.nodes[userID]; !ok || current == nodeID {
+			p.nodes[userID] = nodeID
+		}
+	}
+	return nil
+}
+
+func (p *memPresence) RemoveNode(_ context.Context, userID, nodeID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.nodes[userID] == nodeID {
+		delete(p.nodes, userID)
+	}
+	return nil
+}
+
+func (p *memPresence) NodeOf(_ context.Context, userID string) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.nodes[userID], nil
+}
+
+func (p *memPresence) CountOnline(context.Context) (int64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return int64(len(p.nodes)), nil
+}
+
+// newTestCluster запускает два хаба, связанных общими шиной и Presence.
+func newTestCluster(t *testing.T) (a, b *Hub, presence *memPresence) {
+	t.Helper()
+	bus, presence := newMemBus(), newMemPresence()
+	a, b = NewHub(func(*RawMessage) {}, nil), NewHub(func(*RawMessage) {}, nil)
+	if err := a.EnableCluster("node-a", "test.ws", bus, presence); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.EnableCluster("node-b", "test.ws", bus, presence); err != nil {
+		t.Fatal(err)
+	}
+	go a.Run()
+	go b.Run()
+	return a, b, presence
+}
+
+// registerTestClient подключает к хабу клиента без соединения и ждёт, пока хаб его зарегистрирует.
+func registerTestClient(t *testing.T, h *Hub, userID string) *Client {
+	t.Helper()
+	c := NewStreamClient(h, userID, "127.0.0.1", nil)
+	h.Register <- c
+	waitFor(t, "registration of user "+userID, func() bool {
+		client, ok := h.GetClientByUserID(userID)
+		return ok && client == c
+	})
+	return c
+}
+
+func waitFor(t testing.TB, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func expectMessage(t *testing.T, c *Client, want string) {
+	t.Helper()
+	select {
+	case got := <-c.Send:
+		if string(got) != want {
+			t.Fatalf("user %s got %q, want %q", c.UserID, got, want)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("user %s got no message, want %q", c.UserID, want)
+	}
+}
+
+func expectNoMessage(t *testing.T, c *Client) {
+	t.Helper()
+	select {
+	case got := <-c.Send:
+		t.Fatalf("user %s got unexpected %q", c.UserID, got)
+	default:
+	}
+}
+
+func TestClusterRoomBroadcastReachesOtherNode(t *testing.T) {
+	a, b, _ := newTestCluster(t)
+	alice := registerTestClient(t, a, "1")
+	bob := registerTestClient(t, b, "2")
+	carol := registerTestClient(t, b, "3")
+	a.SetClientRoom(alice, "room-1")
+	b.SetClientRoom(bob, "room-1")
+
+	a.BroadcastToRoom("room-1", []byte(`{"type":"turn"}`))
+
+	expectMessage(t, alice, `{"type":"turn"}`)
+	expectMessage(t, bob, `{"type":"turn"}`)
+	expectNoMessage(t, carol)
+}
+
+func TestClusterSendToUserOnOtherNode(t *testing.T) {
+	a, b, presence := newTestCluster(t)
+	bob := registerTestClient(t, b, "2")
+	if node, _ := presence.NodeOf(context.Background(), "2"); node != "node-b" {
+		t.Fatalf("presence of user 2 = %q, want node-b", node)
+	}
+	if !a.IsUserOnline("2") {
+		t.Fatal("user 2 on node-b is not online for node-a")
+	}
+
+	a.SendToUser("2", []byte(`{"type":"notice"}`))
+
+	expectMessage(t, bob, `{"type":"notice"}`)
+}
+
+func TestClusterMatchmakingAttachesUserOnOtherNode(t *testing.T) {
+	a, b, _ := newTestCluster(t)
+	alice := registerTestClient(t, a, "1")
+	bob := registerTestClient(t, b, "2")
+
+	// Так матчмейкинг узла A сажает найденного соперника в созданную комнату
+	a.SetClientRoom(alice, "ranked-1")
+	a.SetUserRoom("2", "ranked-1")
+
+	if roomID, ok := b.LocalUserRoom("2"); !ok || roomID != "ranked-1" {
+		t.Fatalf("room of user 2 on node-b = %q (online: %t), want ranked-1", roomID, ok)
+	}
+	a.BroadcastToRoom("ranked-1", []byte(`{"type":"game_start"}`))
+	expectMessage(t, alice, `{"type":"game_start"}`)
+	expectMessage(t, bob, `{"type":"game_start"}`)
+
+	a.ClearUserRoom("2", "ranked-1")
+	if roomID, _ := b.LocalUserRoom("2"); roomID != "" {
+		t.Fatalf("room of user 2 on node-b = %q after detach, want none", roomID)
+	}
+}
@@ -3,6 +3,8 @@ package ws
 import (
 	"log"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 // MessageHandlerFunc - это тип функции, которая будет обрабатывать входящие RawMessage.
@@ -10,14 +12,27 @@ import (
 type MessageHandlerFunc func(msg *RawMessage)
 type OnDisconnectHandlerFunc func(client *Client)
 
+// DefaultMaxConsecutiveDrops — сколько сообщений подряд можно не доставить медленному клиенту,
+// прежде чем хаб разорвёт с ним соединение.
+const DefaultMaxConsecutiveDrops = 16
+
 // Hub управляет набором активных клиентов и рассылает им сообщения.
 // Этот Hub является общим и не знает о специфических игровых комнатах или логике игры.
 // Управление комнатами и игровая логика будут обрабатываться вышестоящими сервисами (use cases),
 // которые получат сообщения через MessageHandler.
+//
+// Политика для медленных клиентов: если буфер отправки клиента заполнен, сообщение ему
+// отбрасывается (остальные получатели не ждут). Если подряд отброшено больше
+// MaxConsecutiveDrops сообщений, соединение закрывается, и клиент проходит обычный путь
+// отключения через Unregister и OnDisconnectHandler. Счётчики доступны через Metrics.
 type Hub struct {
 	// Зарегистрированные клиенты. Ключ - указатель на Client, значение - bool (для использования как set).
-	clients         map[*Client]bool
+	clients map[*Client]bool
+	// clientsByUserID — активное соединение пользователя, sessions — все его соединения в порядке подключения.
 	clientsByUserID map[string]*Client
+	sessions        map[string][]*Client
+	// rooms — индекс roomID -> клиенты, согласованный с Client.RoomID(). Меняется только через SetClientRoom.
+	rooms map[string]map[*Client]struct{}
 	// Канал для входящих "сырых" сообщений от клиентов.
 	// Эти сообщения будут переданы в MessageHandler.
 	Broadcast chan *RawMessage
@@ -28,16 +43,60 @@ type Hub struct {
 	// Канал для отмены регистрации клиентов.
 	Unregister chan *Client
 
-	// Mutex для защиты доступа к карте clients.
+	// Mutex для защиты доступа к картам clients, clientsByUserID и rooms.
 	mu sync.Mutex
 
 	// messageHandler - функция, которая будет вызвана для обработки сообщений.
 	MessageHandler MessageHandlerFunc
 
 	OnDisconnectHandler OnDisconnectHandlerFunc
+
+	// MailboxKey возвращает комнату, к которой относится сообщение, если её нельзя взять из
+	// Client.RoomID() (например, join_room). Пустая строка — комната клиента.
+	MailboxKey func(msg *RawMessage) string
+	mailboxes  *mailboxes
+
+	// Limits — лимиты команд и соединений, RejectHandler отвечает клиенту на отклонённую команду.
+	Limits        Limits
+	RejectHandler func(msg *RawMessage, reason string)
+	connsByUser   map[string]int
+	connsByIP     map[string]int
+	// commandLimiters — общие лимиты команд без соединения по пользователям, см. Submit.
+	commandLimiters        map[string]*commandLimiter
+	commandLimitersSweptAt time.Time
+
+	// MaxConsecutiveDrops — порог отброшенных подряд сообщений, после которого медленный клиент отключается.
+	MaxConsecutiveDrops int
+
+	// SessionPolicy определяет, что делать со вторым соединением того же пользователя.
+	SessionPolicy SessionPolicy
+	// SessionReplacedNotice получает соединение, вытесненное новым (SessionPolicyTakeover).
+	SessionReplacedNotice []byte
+	// SessionActivatedNotice получает соединение, которому перешло активное место (SessionPolicyMultiView).
+	SessionActivatedNotice []byte
+
+	// cluster задан, если хаб работает вместе с другими узлами (см. EnableCluster).
+	cluster *cluster
+
+	metrics hubMetrics
+	// closing выставляется CloseAll: отключения после него не считаются уходом игроков.
+	closing atomic.Bool
 }
 
+type hubMetrics struct {
+	sent                    atomic.Int64
+	dropped                 atomic.Int64
+	slowConsumerDisconnects atomic.Int64
+	rateLimited             atomic.Int64
+	inFlightRejected        atomic.Int64
+	connectionsRejected     atomic.Int64
+	abuseDisconnects        atomic.Int64
+}
+
+// GetClientByUserID возвращает клиента, подключенного к этому узлу.
 func (h *Hub) GetClientByUserID(userID string) (*Client, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
 	client, ok := h.clientsByUserID[userID]
 	return client, ok
 }
@@ -58,8 +117,16 @@ func NewHub(handler MessageHandlerFunc, onDisconnectHandler OnDisconnectHandlerF
 		Unregister:          make(chan *Client),
 		clients:             make(map[*Client]bool),
 		clientsByUserID:     make(map[string]*Client),
+		sessions:            make(map[string][]*Client),
+		mailboxes:           newMailboxes(),
+		connsByUser:         make(map[string]int),
+		connsByIP:           make(map[string]int),
+		commandLimiters:     make(map[string]*commandLimiter),
+		rooms:               make(map[string]map[*Client]struct{}),
 		MessageHandler:      handler,
 		OnDisconnectHandler: onDisconnectHandler,
+		MaxConsecutiveDrops: DefaultMaxConsecutiveDrops,
+		SessionPolicy:       SessionPolicyTakeover,
 	}
 }
 
@@ -70,79 +137,189 @@ func (h *Hub) Run() {
 		select {
 		case client := <-h.Register:
 			h.mu.Lock()
+			h.attachSessionLocked(client)
 			h.clients[client] = true
-			h.clientsByUserID[client.UserID] = client
+			// Клиент мог получить комнату ещё до регистрации (например, при возврате после рестарта)
+			h.addToRoomLocked(client)
 			h.mu.Unlock()
-			log.Printf("Hub: Client registered: UserID %s, RemoteAddr: %s", client.UserID, client.Conn.RemoteAddr().String())
+			h.claimPresence(client.UserID)
+			log.Printf("Hub: Client registered: UserID %s, RemoteAddr: %s, read-only: %t", client.UserID, client.RemoteAddr(), client.IsReadOnly())
 
 		case client := <-h.Unregister: // Клиент отключается (либо сам, либо из-за ошибки в ReadPump/WritePump)
 			h.mu.Lock()
-			if _, ok := h.clients[client]; ok {
-				delete(h.clients, client)
-				delete(h.clientsByUserID, client.UserID)
-				close(client.Send) // Важно закрыть канал, чтобы WritePump завершился
-				log.Printf("Hub: Client unregistered: UserID %s, RoomID: %s, RemoteAddr: %s", client.UserID, client.RoomID, client.Conn.RemoteAddr().String())
-
-				// Вызываем коллбэк OnDisconnectHandler, если он установлен
-				if h.OnDisconnectHandler != nil { // <<<< НОВАЯ ЛОГИКА
-					// Запускаем в горутине, чтобы не блокировать цикл хаба,
-					// если обработчик дисконнекта долгий.
-					go h.OnDisconnectHandler(client)
+			_, registered := h.clients[client]
+			wasActive := h.clientsByUserID[client.UserID] == client
+			var promoted *Client
+			if registered {
+				promoted = h.removeClientLocked(client)
+				log.Printf("Hub: Client unregistered: UserID %s, RoomID: %s, RemoteAddr: %s", client.UserID, client.RoomID(), client.RemoteAddr())
+
+				switch {
+				case promoted != nil:
+					// Игрок остался на связи через другое соединение
+					if h.SessionActivatedNotice != nil {
+						if data, err := promoted.Encode(h.SessionActivatedNotice); err == nil {
+							h.sendLocked(promoted, data)
+						}
+					}
+				case wasActive && h.OnDisconnectHandler != nil && !h.closing.Load():
+					// Отключение обрабатывается в очереди комнаты клиента, по порядку с командами
+					// этой комнаты, и не блокирует цикл хаба.
+					h.mailboxes.dispatch(h.clientMailboxKeyLocked(client), func() { h.OnDisconnectHandler(client) })
 				}
 			}
+			_, stillOnline := h.sessions[client.UserID]
 			h.mu.Unlock()
+			if registered && !stillOnline {
+				h.clearPresence(client.UserID)
+			}
 
 		case rawMsg := <-h.Broadcast:
 			if h.MessageHandler != nil {
-				// Обработка сообщения в новой горутине, чтобы не блокировать хаб
-				go h.MessageHandler(rawMsg)
+				// Сообщения одной комнаты обрабатываются по очереди, разных комнат — параллельно,
+				// не блокируя хаб. Очередь клиента ограничена Limits.MaxInFlight.
+				h.mailboxes.dispatch(h.mailboxKey(rawMsg), func() { h.handleMessage(rawMsg) })
 			} else {
+				rawMsg.Client.commandLimiter().inFlight.Add(-1)
 				log.Printf("Hub: No message handler configured for message from client %s.", rawMsg.Client.UserID)
 			}
 		}
 	}
 }
 
-// BroadcastToAll отправляет сообщение всем подключенным клиентам.
+func (h *Hub) handleMessage(msg *RawMessage) {
+	defer msg.Client.commandLimiter().inFlight.Add(-1)
+	h.MessageHandler(msg)
+}
+
+// removeClientLocked убирает клиента из всех индексов и закрывает его канал отправки,
+// чтобы WritePump завершился. Возвращает соединение, к которому перешло активное место, если такое есть.
+// Вызывается под h.mu.
+func (h *Hub) removeClientLocked(client *Client) *Client {
+	delete(h.clients, client)
+	promoted := h.detachSessionLocked(client)
+	h.removeFromRoomLocked(client)
+	h.releaseConnectionLocked(client.UserID, client.RemoteIP)
+	close(client.Send)
+	return promoted
+}
+
+func (h *Hub) addToRoomLocked(client *Client) {
+	if client.RoomID() == "" {
+		return
+	}
+	members, ok := h.rooms[client.RoomID()]
+	if !ok {
+		members = make(map[*Client]struct{})
+		h.rooms[client.RoomID()] = members
+	}
+	members[client] = struct{}{}
+}
+
+func (h *Hub) removeFromRoomLocked(client *Client) {
+	members, ok := h.rooms[client.RoomID()]
+	if !ok {
+		return
+	}
+	delete(members, client)
+	if len(members) == 0 {
+		delete(h.rooms, client.RoomID())
+	}
+}
+
+// SetClientRoom переводит клиента в комнату roomID (пустая строка — выводит из комнаты)
+// и обновляет индекс комнат. Комнату клиента нужно менять только через этот метод.
+func (h *Hub) SetClientRoom(client *Client, roomID string) {
+	if client.detached {
+		// У команды без соединения своей записи в хабе нет: меняется комната пользователя на любом узле
+		client.setRoomID(roomID)
+		h.SetUserRoom(client.UserID, roomID)
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.setClientRoomLocked(client, roomID)
+}
+
+func (h *Hub) setClientRoomLocked(client *Client, roomID string) {
+	if _, ok := h.clients[client]; !ok {
+		// Ещё не зарегистрирован или уже отключен: индекс обновит Register
+		client.setRoomID(roomID)
+		return
+	}
+	// Все соединения пользователя смотрят на одну и ту же комнату
+	for _, c := range h.sessions[client.UserID] {
+		h.removeFromRoomLocked(c)
+		c.setRoomID(roomID)
+		h.addToRoomLocked(c)
+	}
+}
+
+// sendLocked кладёт сообщение в буфер клиента, применяя политику медленных клиентов. Вызывается под h.mu.
+func (h *Hub) sendLocked(client *Client, message []byte) bool {
+	select {
+	case client.Send <- message:
+		client.droppedInRow = 0
+		h.metrics.sent.Add(1)
+		return true
+	default:
+	}
+
+	client.droppedInRow++
+	h.metrics.dropped.Add(1)
+	// Пока ReadPump не отключил клиента, рассылки продолжают его пропускать: разрыв считается один раз
+	if client.droppedInRow == h.MaxConsecutiveDrops+1 {
+		log.Printf("Hub: Client %s (UserID: %s) dropped %d messages in a row, disconnecting slow consumer.", client.RemoteAddr(), client.UserID, client.droppedInRow)
+		h.metrics.slowConsumerDisconnects.Add(1)
+		// Закрываем соединение, а не удаляем клиента из хаба: ReadPump получит ошибку, отправит
+		// клиента в Unregister, и отключение обработается так же, как обычный обрыв связи.
+		client.closeConn()
+	}
+	return false
+}
+
+// BroadcastToAll отправляет сообщение всем подключенным клиентам, в кластере — на всех узлах.
 func (h *Hub) BroadcastToAll(message []byte) {
+	h.deliverToAll(message)
+	if h.cluster != nil {
+		h.publish(h.cluster.broadcastSubject(), clusterEnvelope{Kind: clusterKindAll, Data: message})
+	}
+}
+
+func (h *Hub) deliverToAll(message []byte) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 	count := 0
+	enc := newEncodeCache(message)
 	for client := range h.clients {
-		select {
-		case client.Send <- message:
+		if data, ok := enc.forClient(client); ok && h.sendLocked(client, data) {
 			count++
-		default: // Если буфер отправки клиента заполнен, пропускаем или удаляем клиента
-			log.Printf("Client %s (UserID: %s) send buffer full or closed, removing client during BroadcastToAll.", client.Conn.RemoteAddr(), client.UserID)
-			close(client.Send)
-			delete(h.clients, client)
 		}
 	}
 	log.Printf("BroadcastToAll: Message sent to %d clients.", count)
 }
 
-// BroadcastToRoom отправляет сообщение всем клиентам в указанной комнате.
+// BroadcastToRoom отправляет сообщение всем клиентам в указанной комнате, в кластере — на всех узлах.
 // Требует, чтобы у Client был установлен RoomID.
 func (h *Hub) BroadcastToRoom(roomID string, message []byte) {
 	if roomID == "" {
 		log.Println("BroadcastToRoom: Attempted to broadcast to empty roomID. Message not sent.")
 		return
 	}
+	h.deliverToRoom(roomID, message)
+	if h.cluster != nil {
+		h.publish(h.cluster.broadcastSubject(), clusterEnvelope{Kind: clusterKindRoom, RoomID: roomID, Data: message})
+	}
+}
+
+func (h *Hub) deliverToRoom(roomID string, message []byte) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 	count := 0
-	log.Printf("Attempting to broadcast to room '%s', message: %s", roomID, string(message))
-	for client := range h.clients {
-		if client.RoomID == roomID {
-			select {
-			case client.Send <- message:
-				count++
-				log.Printf("Sent message to client %s (UserID: %s) in room %s", client.Conn.RemoteAddr(), client.UserID, roomID)
-			default:
-				log.Printf("Client %s (UserID: %s) in room %s send buffer full or closed, removing client.", client.Conn.RemoteAddr(), client.UserID, roomID)
-				close(client.Send)
-				delete(h.clients, client)
-			}
+	enc := newEncodeCache(message)
+	for client := range h.rooms[roomID] {
+		if data, ok := enc.forClient(client); ok && h.sendLocked(client, data) {
+			count++
 		}
 	}
 	if count > 0 {
@@ -158,22 +335,56 @@ func (h *Hub) BroadcastToClient(targetClient *Client, message []byte) {
 		log.Println("BroadcastToClient: targetClient is nil.")
 		return
 	}
+	if targetClient.detached {
+		h.SendToUser(targetClient.UserID, message)
+		return
+	}
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
 	// Проверяем, что клиент все еще зарегистрирован
-	if _, ok := h.clients[targetClient]; ok {
-		select {
-		case targetClient.Send <- message:
-			log.Printf("Sent direct message to client %s (UserID: %s)", targetClient.Conn.RemoteAddr(), targetClient.UserID)
-		default:
-			log.Printf("Target client %s (UserID: %s) send buffer full or closed, removing client.", targetClient.Conn.RemoteAddr(), targetClient.UserID)
-			close(targetClient.Send)
-			delete(h.clients, targetClient)
-		}
-	} else {
-		log.Printf("Target client %s (UserID: %s) not found or already unregistered for direct message.", targetClient.Conn.RemoteAddr(), targetClient.UserID)
+	if _, ok := h.clients[targetClient]; !ok {
+		log.Printf("Target client %s (UserID: %s) not found or already unregistered for direct message.", targetClient.RemoteAddr(), targetClient.UserID)
+		return
 	}
+	data, err := targetClient.Encode(message)
+	if err != nil {
+		log.Printf("BroadcastToClient: Failed to encode message for client %s: %v", targetClient.UserID, err)
+		return
+	}
+	h.sendLocked(targetClient, data)
+}
+
+// BroadcastToLocal отправляет сообщение всем клиентам этого узла, не затрагивая остальные узлы.
+func (h *Hub) BroadcastToLocal(message []byte) {
+	h.deliverToAll(message)
+}
+
+// LocalRoomIDs возвращает комнаты, в которых есть клиенты этого узла.
+func (h *Hub) LocalRoomIDs() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	roomIDs := make([]string, 0, len(h.rooms))
+	for roomID := range h.rooms {
+		roomIDs = append(roomIDs, roomID)
+	}
+	return roomIDs
+}
+
+// CloseAll закрывает все соединения узла с кодом code. OnDisconnectHandler для них не вызывается:
+// так узел уходит на рестарт, не засчитывая игрокам поражение, а игры продолжатся после переподключения.
+func (h *Hub) CloseAll(code int, reason string) {
+	h.closing.Store(true)
+	h.mu.Lock()
+	clients := make([]*Client, 0, len(h.clients))
+	for client := range h.clients {
+		clients = append(clients, client)
+	}
+	h.mu.Unlock()
+	for _, client := range clients {
+		client.closeWith(code, reason)
+	}
+	log.Printf("Hub: Closed %d connections (%d %s)", len(clients), code, reason)
 }
 
 // GetClientCount возвращает количество подключенных клиентов.
@@ -182,3 +393,23 @@ func (h *Hub) GetClientCount() int {
 	defer h.mu.Unlock()
 	return len(h.clients)
 }
+
+// Metrics возвращает счётчики хаба: подключенные клиенты и комнаты, доставленные
+// и отброшенные сообщения, отключения медленных клиентов и нарушения лимитов.
+func (h *Hub) Metrics() map[string]int64 {
+	h.mu.Lock()
+	clients, rooms := len(h.clients), len(h.rooms)
+	h.mu.Unlock()
+	return map[string]int64{
+		"clients":                    int64(clients),
+		"rooms":                      int64(rooms),
+		"active_mailboxes":           int64(h.mailboxes.active()),
+		"messages_sent":              h.metrics.sent.Load(),
+		"messages_dropped":           h.metrics.dropped.Load(),
+		"slow_consumer_disconnects":  h.metrics.slowConsumerDisconnects.Load(),
+		"commands_rate_limited":      h.metrics.rateLimited.Load(),
+		"commands_in_flight_limited": h.metrics.inFlightRejected.Load(),
+		"connections_rejected":       h.metrics.connectionsRejected.Load(),
+		"abuse_disconnects":          h.metrics.abuseDisconnects.Load(),
+	}
+}
@@ -0,0 +1,100 @@
+package ws
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"strconv"
+	"testing"
+)
+
+// Бенчмарки рассылки в комнату на 10 000 клиентов-потоков. Каждый клиент работает через
+// StreamPump, как настоящий поток: быстрые сразу забирают сообщения, медленные зависают
+// на записи, пока хаб не закроет их соединение.
+//
+//	go test -run '^$' -bench BroadcastToRoom -benchmem ./pkg/ws/
+
+const (
+	benchRoomClients = 10000
+	benchRoomID      = "bench-room"
+)
+
+var benchMessage = []byte(`{"type":"player_hit","content":{"roomID":"bench-room","playerID":"1","card":{"value":"10","suit":"H"},"score":17}}`)
+
+// newBenchRoom регистрирует в хабе benchRoomClients клиентов в одной комнате; каждый
+// slowEvery-й из них — медленный (0 — медленных нет). Возвращает хаб и медленных клиентов.
+func newBenchRoom(b *testing.B, slowEvery int) (*Hub, []*Client) {
+	b.Helper()
+	logOutput := log.Writer()
+	log.SetOutput(io.Discard)
+	b.Cleanup(func() { log.SetOutput(logOutput) })
+
+	hub := NewHub(func(*RawMessage) {}, nil)
+	go hub.Run()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var clients, slow []*Client
+	for i := 0; i < benchRoomClients; i++ {
+		c := NewStreamClient(hub, strconv.Itoa(i), "10.0.0.1", nil)
+		hub.SetClientRoom(c, benchRoomID)
+		write := func([]byte) error { return nil }
+		if slowEvery > 0 && i%slowEvery == 0 {
+			slow = append(slow, c)
+			// Запись зависает, как у клиента с забитым TCP-буфером, и обрывается, когда хаб закрывает соединение
+			write = func([]byte) error {
+				<-c.streamClosed
+				return errors.New("connection closed")
+			}
+		}
+		clients = append(clients, c)
+		hub.Register <- c
+		go c.StreamPump(ctx, write)
+	}
+	waitFor(b, "registration of bench clients", func() bool { return hub.GetClientCount() == benchRoomClients })
+
+	b.Cleanup(func() {
+		cancel()
+		// Медленные клиенты, которых хаб не успел отключить, всё ещё висят на записи
+		for _, c := range clients {
+			c.closeConn()
+		}
+		waitFor(b, "unregistration of bench clients", func() bool { return hub.GetClientCount() == 0 })
+	})
+	return hub, slow
+}
+
+// BenchmarkBroadcastToRoom — рассылка в комнату, где все клиенты успевают забирать сообщения.
+func BenchmarkBroadcastToRoom(b *testing.B) {
+	hub, _ := newBenchRoom(b, 0)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		hub.BroadcastToRoom(benchRoomID, benchMessage)
+	}
+	b.StopTimer()
+	b.ReportMetric(float64(hub.Metrics()["messages_dropped"])/float64(b.N), "drops/op")
+}
+
+// BenchmarkBroadcastToRoomSlowConsumers — каждый десятый клиент не читает. Когда его буфер
+// Send заполнен, хаб отбрасывает сообщения ему, не задерживая остальных, а после
+// MaxConsecutiveDrops отброшенных подряд разрывает соединение, и клиент уходит из комнаты.
+func BenchmarkBroadcastToRoomSlowConsumers(b *testing.B) {
+	hub, slow := newBenchRoom(b, 10)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		hub.BroadcastToRoom(benchRoomID, benchMessage)
+	}
+	b.StopTimer()
+
+	metrics := hub.Metrics()
+	b.ReportMetric(float64(metrics["messages_dropped"])/float64(b.N), "drops/op")
+	b.ReportMetric(float64(metrics["slow_consumer_disconnects"]), "slow-disconnects")
+	// Медленный клиент получает cap(Send) сообщений в буфер, и ещё MaxConsecutiveDrops+1 ему отбрасываются
+	if b.N > cap(slow[0].Send)+hub.MaxConsecutiveDrops && metrics["slow_consumer_disconnects"] != int64(len(slow)) {
+		b.Fatalf("slow_consumer_disconnects = %d after %d broadcasts, want %d", metrics["slow_consumer_disconnects"], b.N, len(slow))
+	}
+}
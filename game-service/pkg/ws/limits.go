@@ -0,0 +1,278 @@
+package ws
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Причины, по которым хаб отклоняет команду клиента, не передавая её в MessageHandler.
+const (
+	RejectRateLimited     = "rate_limited"
+	RejectTooManyInFlight = "too_many_in_flight"
+)
+
+// CloseAbuse — код закрытия для клиента, который раз за разом превышает лимиты.
+const CloseAbuse = websocket.ClosePolicyViolation
+
+// Rate — скорость пополнения и ёмкость token bucket.
+type Rate struct {
+	PerSecond float64
+	Burst     int
+}
+
+// ParseRate разбирает лимит вида "0.5/3": 0.5 команды в секунду, не больше 3 подряд.
+func ParseRate(s string) (Rate, error) {
+	perSecond, burst, ok := strings.Cut(s, "/")
+	if !ok {
+		return Rate{}, fmt.Errorf("rate %q: want <per second>/<burst>", s)
+	}
+	r := Rate{}
+	var err error
+	if r.PerSecond, err = strconv.ParseFloat(perSecond, 64); err != nil {
+		return Rate{}, fmt.Errorf("rate %q: %w", s, err)
+	}
+	if r.Burst, err = strconv.Atoi(burst); err != nil {
+		return Rate{}, fmt.Errorf("rate %q: %w", s, err)
+	}
+	return r, nil
+}
+
+// Limits — ограничения на одно соединение и на число соединений. Нулевое значение поля — без ограничения.
+type Limits struct {
+	// MessageRate — лимит команд каждого типа для одного соединения, TypeRates переопределяет его по типам.
+	MessageRate Rate
+	TypeRates   map[string]Rate
+	// MaxInFlight — сколько команд одного соединения может обрабатываться одновременно.
+	MaxInFlight int
+	// MaxConnsPerUser и MaxConnsPerIP ограничивают число соединений на этом узле.
+	MaxConnsPerUser int
+	MaxConnsPerIP   int
+	// После MaxViolations отклонённых команд за ViolationWindow соединение закрывается с кодом CloseAbuse.
+	MaxViolations   int
+	ViolationWindow time.Duration
+}
+
+func (l Limits) rateFor(msgType string) Rate {
+	if r, ok := l.TypeRates[msgType]; ok {
+		return r
+	}
+	return l.MessageRate
+}
+
+// tokenBucket не потокобезопасен: его защищает мьютекс commandLimiter.
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func (b *tokenBucket) allow(r Rate, now time.Time) bool {
+	if b.last.IsZero() {
+		b.tokens = float64(r.Burst)
+	} else {
+		b.tokens += now.Sub(b.last).Seconds() * r.PerSecond
+		if b.tokens > float64(r.Burst) {
+			b.tokens = float64(r.Burst)
+		}
+	}
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// commandLimiter — состояние лимитов команд: token bucket на каждый тип, команды в обработке
+// и счётчик отклонённых команд. У WebSocket-соединения оно своё, а команды без соединения
+// (REST через api-gateway) делят одно состояние на пользователя, см. Hub.Submit.
+type commandLimiter struct {
+	mu              sync.Mutex
+	buckets         map[string]*tokenBucket
+	inFlight        atomic.Int64
+	violations      int
+	violationsSince time.Time
+	// lastUsed — когда хаб последний раз выдал общее состояние команде; меняется под мьютексом хаба.
+	lastUsed time.Time
+}
+
+// commandLimiterIdle — через сколько без команд общее состояние пользователя забывается.
+// К этому моменту его token bucket-ы уже полны, так что забыть его — то же, что сохранить.
+const commandLimiterIdle = time.Minute
+
+// commandLimiter возвращает лимиты, которым подчиняются команды клиента.
+func (c *Client) commandLimiter() *commandLimiter {
+	if c.sharedLimiter != nil {
+		return c.sharedLimiter
+	}
+	return &c.limiter
+}
+
+// admit решает, пропустить ли команду в хаб. Возвращает причину отказа или пустую строку.
+func (c *Client) admit(message []byte) string {
+	return c.commandLimiter().admit(c.Hub.Limits, message)
+}
+
+func (l *commandLimiter) admit(limits Limits, message []byte) string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if limits.MessageRate.PerSecond > 0 || len(limits.TypeRates) > 0 {
+		var head struct {
+			Type string `json:"type"`
+		}
+		// Неразборчивое сообщение отклонит обработчик; для лимита оно считается типом ""
+		_ = json.Unmarshal(message, &head)
+		if r := limits.rateFor(head.Type); r.PerSecond > 0 {
+			if l.buckets == nil {
+				l.buckets = make(map[string]*tokenBucket)
+			}
+			bucket, ok := l.buckets[head.Type]
+			if !ok {
+				bucket = &tokenBucket{}
+				l.buckets[head.Type] = bucket
+			}
+			if !bucket.allow(r, time.Now()) {
+				return RejectRateLimited
+			}
+		}
+	}
+	if limits.MaxInFlight > 0 && l.inFlight.Load() >= int64(limits.MaxInFlight) {
+		return RejectTooManyInFlight
+	}
+	l.inFlight.Add(1)
+	return ""
+}
+
+// recordViolation считает отклонённую команду. Возвращает число отклонённых команд
+// за ViolationWindow, если оно превысило MaxViolations, иначе 0.
+func (l *commandLimiter) recordViolation(limits Limits) int {
+	if limits.MaxViolations <= 0 {
+		return 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	if now.Sub(l.violationsSince) > limits.ViolationWindow {
+		l.violationsSince = now
+		l.violations = 0
+	}
+	l.violations++
+	if l.violations <= limits.MaxViolations {
+		return 0
+	}
+	return l.violations
+}
+
+// recordViolation считает отклонённые команды и закрывает соединение нарушителя.
+// Вызывается только из ReadPump; возвращает true, если соединение закрыто.
+func (c *Client) recordViolation() bool {
+	violations := c.commandLimiter().recordViolation(c.Hub.Limits)
+	if violations == 0 {
+		return false
+	}
+	log.Printf("Closing client %s (UserID: %s): %d rejected commands within %s", c.RemoteAddr(), c.UserID, violations, c.Hub.Limits.ViolationWindow)
+	c.Hub.metrics.abuseDisconnects.Add(1)
+	c.closeWith(CloseAbuse, "rate limit exceeded")
+	return true
+}
+
+// userCommandLimiter возвращает общие лимиты команд пользователя без соединения.
+// Заодно раз в commandLimiterIdle забывает состояния пользователей, давно не присылавших команд.
+func (h *Hub) userCommandLimiter(userID string) *commandLimiter {
+	idle := max(commandLimiterIdle, h.Limits.ViolationWindow)
+	now := time.Now()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if now.Sub(h.commandLimitersSweptAt) > idle {
+		for id, l := range h.commandLimiters {
+			if l.inFlight.Load() == 0 && now.Sub(l.lastUsed) > idle {
+				delete(h.commandLimiters, id)
+			}
+		}
+		h.commandLimitersSweptAt = now
+	}
+	l, ok := h.commandLimiters[userID]
+	if !ok {
+		l = &commandLimiter{}
+		h.commandLimiters[userID] = l
+	}
+	l.lastUsed = now
+	return l
+}
+
+// closeAbusiveUser закрывает соединения пользователя на этом узле, когда его команды без
+// соединения раз за разом превышают лимиты. Сами команды отклоняются, пока лимит не восстановится.
+func (h *Hub) closeAbusiveUser(userID string, violations int) {
+	h.mu.Lock()
+	sessions := append([]*Client(nil), h.sessions[userID]...)
+	h.mu.Unlock()
+	log.Printf("Closing %d connections of UserID %s: %d rejected commands within %s", len(sessions), userID, violations, h.Limits.ViolationWindow)
+	h.metrics.abuseDisconnects.Add(1)
+	for _, client := range sessions {
+		client.closeWith(CloseAbuse, "rate limit exceeded")
+	}
+}
+
+// closeWith отправляет кадр закрытия с кодом и закрывает соединение.
+func (c *Client) closeWith(code int, reason string) {
+	if c.Conn == nil {
+		c.closeConn()
+		return
+	}
+	msg := websocket.FormatCloseMessage(code, reason)
+	if err := c.Conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(writeWait)); err != nil {
+		log.Printf("Error writing close message for client %s: %v", c.UserID, err)
+	}
+	c.closeConn()
+}
+
+// reject учитывает отклонённую команду и передаёт её RejectHandler, чтобы клиент получил ответ.
+func (h *Hub) reject(msg *RawMessage, reason string) {
+	if reason == RejectRateLimited {
+		h.metrics.rateLimited.Add(1)
+	} else {
+		h.metrics.inFlightRejected.Add(1)
+	}
+	if h.RejectHandler != nil {
+		h.RejectHandler(msg, reason)
+	}
+}
+
+// AcquireConnection резервирует место под новое соединение пользователя с адреса ip.
+// Возвращает false, если превышен лимит на пользователя или на адрес. Зарезервированное место
+// освобождается при отключении зарегистрированного клиента или через ReleaseConnection.
+func (h *Hub) AcquireConnection(userID, ip string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if (h.Limits.MaxConnsPerUser > 0 && h.connsByUser[userID] >= h.Limits.MaxConnsPerUser) ||
+		(h.Limits.MaxConnsPerIP > 0 && h.connsByIP[ip] >= h.Limits.MaxConnsPerIP) {
+		h.metrics.connectionsRejected.Add(1)
+		return false
+	}
+	h.connsByUser[userID]++
+	h.connsByIP[ip]++
+	return true
+}
+
+// ReleaseConnection освобождает место, если соединение так и не было зарегистрировано.
+func (h *Hub) ReleaseConnection(userID, ip string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.releaseConnectionLocked(userID, ip)
+}
+
+func (h *Hub) releaseConnectionLocked(userID, ip string) {
+	if h.connsByUser[userID]--; h.connsByUser[userID] <= 0 {
+		delete(h.connsByUser, userID)
+	}
+	if h.connsByIP[ip]--; h.connsByIP[ip] <= 0 {
+		delete(h.connsByIP, ip)
+	}
+}
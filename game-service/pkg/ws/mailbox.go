@@ -0,0 +1,88 @@
+package ws
+
+import "sync"
+
+// mailboxes выполняет задачи с одинаковым ключом строго по очереди, а с разными — параллельно.
+// Ключ — обычно комната: команды игроков, отключения и фоновые изменения одной комнаты
+// не могут работать с её состоянием одновременно.
+// Горутина-обработчик живёт, пока в её очереди есть задачи, простаивающих горутин нет.
+type mailboxes struct {
+	mu     sync.Mutex
+	queues map[string][]func()
+}
+
+func newMailboxes() *mailboxes {
+	return &mailboxes{queues: make(map[string][]func())}
+}
+
+// dispatch ставит задачу в очередь ключа и, если очередь была пуста, запускает её обработку.
+func (m *mailboxes) dispatch(key string, task func()) {
+	m.mu.Lock()
+	queue, active := m.queues[key]
+	m.queues[key] = append(queue, task)
+	m.mu.Unlock()
+	if !active {
+		go m.drain(key)
+	}
+}
+
+func (m *mailboxes) drain(key string) {
+	for {
+		m.mu.Lock()
+		queue := m.queues[key]
+		if len(queue) == 0 {
+			delete(m.queues, key)
+			m.mu.Unlock()
+			return
+		}
+		task := queue[0]
+		queue[0] = nil
+		m.queues[key] = queue[1:]
+		m.mu.Unlock()
+
+		task()
+	}
+}
+
+func (m *mailboxes) active() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.queues)
+}
+
+func roomMailboxKey(roomID string) string {
+	return "room:" + roomID
+}
+
+// mailboxKey выбирает очередь для сообщения: ключ от MailboxKey, иначе комната клиента,
+// а вне комнаты — сам пользователь.
+func (h *Hub) mailboxKey(msg *RawMessage) string {
+	if h.MailboxKey != nil {
+		if key := h.MailboxKey(msg); key != "" {
+			return roomMailboxKey(key)
+		}
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.clientMailboxKeyLocked(msg.Client)
+}
+
+// clientMailboxKeyLocked — очередь комнаты клиента, а вне комнаты — его пользователя. Вызывается под h.mu.
+func (h *Hub) clientMailboxKeyLocked(client *Client) string {
+	if roomID := client.RoomID(); roomID != "" {
+		return roomMailboxKey(roomID)
+	}
+	return "user:" + client.UserID
+}
+
+// RunInRoom выполняет task в очереди комнаты roomID, по порядку с командами её игроков.
+// Через неё идут все изменения комнаты не по команде игрока: отключения, уборка, действия
+// администратора, турнирное расписание. Возвращаемый канал закрывается, когда task выполнена.
+func (h *Hub) RunInRoom(roomID string, task func()) <-chan struct{} {
+	done := make(chan struct{})
+	h.mailboxes.dispatch(roomMailboxKey(roomID), func() {
+		defer close(done)
+		task()
+	})
+	return done
+}
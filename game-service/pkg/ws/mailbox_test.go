@@ -0,0 +1,139 @@
+package ws
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMailboxRunsRoomMessagesInOrder(t *testing.T) {
+	const perRoom = 200
+	rooms := []string{"room-1", "room-2", "room-3"}
+
+	var (
+		mu      sync.Mutex
+		got     = make(map[string][]int)
+		running = make(map[string]*atomic.Int32)
+		done    sync.WaitGroup
+	)
+	for _, roomID := range rooms {
+		running[roomID] = new(atomic.Int32)
+	}
+	done.Add(perRoom * len(rooms))
+
+	hub := NewHub(func(msg *RawMessage) {
+		defer done.Done()
+		roomID := msg.Client.RoomID()
+		if n := running[roomID].Add(1); n != 1 {
+			t.Errorf("%d handlers of %s run at once", n, roomID)
+		}
+		seq, _ := strconv.Atoi(string(msg.Payload))
+		mu.Lock()
+		got[roomID] = append(got[roomID], seq)
+		mu.Unlock()
+		running[roomID].Add(-1)
+	}, nil)
+	go hub.Run()
+
+	clients := make(map[string]*Client, len(rooms))
+	for i, roomID := range rooms {
+		c := registerTestClient(t, hub, strconv.Itoa(i+1))
+		hub.SetClientRoom(c, roomID)
+		clients[roomID] = c
+	}
+	for seq := 0; seq < perRoom; seq++ {
+		for _, roomID := range rooms {
+			hub.Submit(&RawMessage{Client: clients[roomID], Payload: []byte(strconv.Itoa(seq))})
+		}
+	}
+	done.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, roomID := range rooms {
+		if len(got[roomID]) != perRoom {
+			t.Fatalf("%s handled %d messages, want %d", roomID, len(got[roomID]), perRoom)
+		}
+		for i, seq := range got[roomID] {
+			if seq != i {
+				t.Fatalf("%s handled message %d at position %d", roomID, seq, i)
+			}
+		}
+	}
+}
+
+func TestMailboxRunsDifferentRoomsInParallel(t *testing.T) {
+	// Обработчик первой комнаты ждёт, пока начнётся обработчик второй: если бы комнаты шли
+	// по очереди, он бы не дождался.
+	secondStarted := make(chan struct{})
+	firstFinished := make(chan bool, 1)
+	hub := NewHub(func(msg *RawMessage) {
+		switch msg.Client.RoomID() {
+		case "room-1":
+			select {
+			case <-secondStarted:
+				firstFinished <- true
+			case <-time.After(5 * time.Second):
+				firstFinished <- false
+			}
+		case "room-2":
+			close(secondStarted)
+		}
+	}, nil)
+	go hub.Run()
+
+	first := registerTestClient(t, hub, "1")
+	hub.SetClientRoom(first, "room-1")
+	second := registerTestClient(t, hub, "2")
+	hub.SetClientRoom(second, "room-2")
+
+	hub.Submit(&RawMessage{Client: first, Payload: []byte("hit")})
+	hub.Submit(&RawMessage{Client: second, Payload: []byte("hit")})
+
+	if !<-firstFinished {
+		t.Fatal("room-2 was not handled while room-1 was busy")
+	}
+	waitFor(t, "idle mailboxes", func() bool { return hub.mailboxes.active() == 0 })
+}
+
+func TestDisconnectRunsAfterQueuedRoomCommand(t *testing.T) {
+	release := make(chan struct{})
+	var (
+		mu    sync.Mutex
+		order []string
+	)
+	record := func(event string) {
+		mu.Lock()
+		order = append(order, event)
+		mu.Unlock()
+	}
+	disconnected := make(chan struct{})
+	hub := NewHub(func(msg *RawMessage) {
+		<-release
+		record("command")
+	}, func(client *Client) {
+		record("disconnect")
+		close(disconnected)
+	})
+	go hub.Run()
+
+	c := registerTestClient(t, hub, "1")
+	hub.SetClientRoom(c, "room-1")
+	hub.Submit(&RawMessage{Client: c, Payload: []byte("stand")})
+	hub.Unregister <- c
+	waitFor(t, "unregistration", func() bool { return hub.GetClientCount() == 0 })
+	// Администратор закрывает комнату, пока в ней ещё идёт команда
+	closed := hub.RunInRoom("room-1", func() { record("force_close") })
+
+	close(release)
+	<-disconnected
+	<-closed
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(order) != 3 || order[0] != "command" || order[1] != "disconnect" || order[2] != "force_close" {
+		t.Fatalf("room-1 handled %v, want [command disconnect force_close]", order)
+	}
+}
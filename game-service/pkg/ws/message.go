@@ -6,11 +6,14 @@ package ws
 type RawMessage struct {
 	Client  *Client // Клиент, отправивший сообщение
 	Payload []byte  // "Сырые" байты сообщения
+	// Reply получает ответ на команду (ack или error) вместо клиента. Задаётся для команд без соединения.
+	Reply chan<- []byte
 }
 
 // OutboundMessage представляет структурированное сообщение, отправляемое сервером клиенту.
 // Это пример, вы можете определить свои структуры для исходящих сообщений.
 type OutboundMessage struct {
-	Type    string      `json:"type"`    // Тип сообщения (например, "game_update", "error", "player_joined")
-	Content interface{} `json:"content"` // Содержимое сообщения
+	Type      string      `json:"type"`                 // Тип сообщения (например, "game_update", "error", "player_joined")
+	RequestID string      `json:"request_id,omitempty"` // ID команды, на которую это ответ; только для клиентов версии 1 и выше
+	Content   interface{} `json:"content"`              // Содержимое сообщения
 }
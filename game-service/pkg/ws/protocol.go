@@ -0,0 +1,131 @@
+package ws
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/gorilla/websocket"
+)
+
+// Версии протокола. Версия согласуется при подключении через Sec-WebSocket-Protocol:
+// клиент без подпротокола получает прежний формат {"type", "content"} без подтверждений.
+const (
+	ProtocolLegacy = 0
+	ProtocolV1     = 1
+
+	// SubprotocolV1JSON — подпротокол версии 1 с JSON-конвертами.
+	SubprotocolV1JSON = "dueljack.v1.json"
+)
+
+// Envelope — конверт протокола версии 1 в обе стороны. Ответы на команду (ack или error)
+// несут её RequestID; события сервера приходят без него.
+type Envelope struct {
+	V         int             `json:"v"`
+	Type      string          `json:"type"`
+	RequestID string          `json:"request_id,omitempty"`
+	Payload   json.RawMessage `json:"payload,omitempty"`
+}
+
+// Codec переводит сообщения между форматом хаба и форматом подпротокола клиента.
+// Внутри сервера сообщения всегда JSON: исходящие — сериализованный OutboundMessage,
+// входящие — Envelope. Поэтому обработчики не зависят от того, как клиент кодирует сообщения.
+type Codec interface {
+	// Subprotocol — значение Sec-WebSocket-Protocol, которым клиент выбирает кодек.
+	Subprotocol() string
+	// Version — версия протокола, которую клиент указывает в конвертах.
+	Version() int
+	// FrameType — тип кадра WebSocket: websocket.TextMessage или websocket.BinaryMessage.
+	FrameType() int
+	Encode(message []byte) ([]byte, error)
+	Decode(data []byte) ([]byte, error)
+}
+
+// NewClientProtocol возвращает кодек для согласованного подпротокола и версию протокола.
+// Пустой подпротокол или неизвестный — прежний формат без кодека.
+func NewClientProtocol(subprotocol string, codecs ...Codec) (Codec, int) {
+	for _, codec := range codecs {
+		if codec.Subprotocol() == subprotocol {
+			return codec, codec.Version()
+		}
+	}
+	return nil, ProtocolLegacy
+}
+
+// JSONCodec — подпротокол dueljack.v1.json: JSON-конверты Envelope.
+type JSONCodec struct{}
+
+func (JSONCodec) Subprotocol() string { return SubprotocolV1JSON }
+func (JSONCodec) Version() int        { return ProtocolV1 }
+func (JSONCodec) FrameType() int      { return websocket.TextMessage }
+
+func (JSONCodec) Encode(message []byte) ([]byte, error) {
+	out, err := DecodeOutbound(message)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(Envelope{V: ProtocolV1, Type: out.Type, RequestID: out.RequestID, Payload: out.Content})
+}
+
+// Decode ничего не меняет: входящие JSON-конверты уже в формате хаба.
+func (JSONCodec) Decode(data []byte) ([]byte, error) {
+	return data, nil
+}
+
+// DecodedOutbound — сериализованный OutboundMessage с ещё не разобранным содержимым.
+type DecodedOutbound struct {
+	Type      string          `json:"type"`
+	RequestID string          `json:"request_id"`
+	Content   json.RawMessage `json:"content"`
+}
+
+// DecodeOutbound разбирает исходящее сообщение хаба для перекодирования.
+func DecodeOutbound(message []byte) (DecodedOutbound, error) {
+	var out DecodedOutbound
+	if err := json.Unmarshal(message, &out); err != nil {
+		return out, fmt.Errorf("decode outbound message: %w", err)
+	}
+	return out, nil
+}
+
+// Encode переводит сообщение хаба (сериализованный OutboundMessage) в формат протокола клиента.
+func (c *Client) Encode(message []byte) ([]byte, error) {
+	if c.Codec == nil {
+		return message, nil
+	}
+	return c.Codec.Encode(message)
+}
+
+func (c *Client) frameType() int {
+	if c.Codec == nil {
+		return websocket.TextMessage
+	}
+	return c.Codec.FrameType()
+}
+
+// encodeCache кодирует одно сообщение рассылки не больше одного раза на подпротокол.
+type encodeCache struct {
+	message []byte
+	encoded map[string][]byte
+}
+
+func newEncodeCache(message []byte) *encodeCache {
+	return &encodeCache{message: message, encoded: make(map[string][]byte)}
+}
+
+func (e *encodeCache) forClient(client *Client) ([]byte, bool) {
+	key := ""
+	if client.Codec != nil {
+		key = client.Codec.Subprotocol()
+	}
+	if data, ok := e.encoded[key]; ok {
+		return data, data != nil
+	}
+	data, err := client.Encode(e.message)
+	if err != nil {
+		log.Printf("Hub: Failed to encode message for subprotocol %q: %v", key, err)
+		data = nil
+	}
+	e.encoded[key] = data
+	return data, data != nil
+}
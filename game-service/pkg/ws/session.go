@@ -0,0 +1,175 @@
+package ws
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/gorilla/websocket"
+)
+
+// SessionPolicy определяет, что делать, когда пользователь открывает второе соединение
+// (новая вкладка, другое устройство).
+type SessionPolicy string
+
+const (
+	// SessionPolicyTakeover — новое соединение занимает место старого. Старое получает
+	// SessionReplacedNotice и закрывается с кодом CloseSessionReplaced; игроку не засчитывается
+	// поражение, а новое соединение остаётся в той же комнате.
+	SessionPolicyTakeover SessionPolicy = "takeover"
+	// SessionPolicyMultiView — соединений может быть несколько, но действовать в игре может только
+	// одно (активное место). Остальные только смотрят. Если активное соединение закрывается,
+	// место переходит к самому старому из оставшихся, и оно получает SessionActivatedNotice.
+	// В кластере соединения группируются в пределах узла.
+	SessionPolicyMultiView SessionPolicy = "multi_view"
+)
+
+// CloseSessionReplaced — код закрытия WebSocket для соединения, вытесненного новой сессией.
+const CloseSessionReplaced = 4001
+
+// CloseKicked — код закрытия WebSocket для соединения игрока, которого отключил администратор.
+const CloseKicked = 4002
+
+// ParseSessionPolicy проверяет значение из конфигурации.
+func ParseSessionPolicy(s string) (SessionPolicy, error) {
+	switch p := SessionPolicy(s); p {
+	case SessionPolicyTakeover, SessionPolicyMultiView:
+		return p, nil
+	default:
+		return "", fmt.Errorf("unknown session policy %q", s)
+	}
+}
+
+// IsReadOnly сообщает, что соединение только для просмотра: активное место занято другим соединением.
+func (c *Client) IsReadOnly() bool {
+	return c.readOnly.Load()
+}
+
+// attachSessionLocked добавляет соединение к сессиям пользователя по политике хаба. Вызывается под h.mu.
+func (h *Hub) attachSessionLocked(client *Client) {
+	prev, hasPrev := h.clientsByUserID[client.UserID]
+	if hasPrev && client.RoomID() == "" {
+		// Новое соединение продолжает с того же места, где было старое
+		client.setRoomID(prev.RoomID())
+	}
+	if hasPrev && h.SessionPolicy == SessionPolicyMultiView {
+		client.readOnly.Store(true)
+	} else {
+		if hasPrev {
+			h.replaceLocked(prev)
+		}
+		h.clientsByUserID[client.UserID] = client
+	}
+	h.sessions[client.UserID] = append(h.sessions[client.UserID], client)
+}
+
+// replaceLocked закрывает соединение, вытесненное новой сессией. Клиент удаляется из хаба сразу,
+// поэтому его последующий Unregister не вызывает OnDisconnectHandler. Вызывается под h.mu.
+func (h *Hub) replaceLocked(client *Client) {
+	log.Printf("Hub: Session of UserID %s replaced, closing connection %s", client.UserID, client.RemoteAddr())
+	if h.SessionReplacedNotice != nil {
+		if data, err := client.Encode(h.SessionReplacedNotice); err == nil {
+			h.sendLocked(client, data)
+		}
+	}
+	client.closeMessage = websocket.FormatCloseMessage(CloseSessionReplaced, "session_replaced")
+	h.removeClientLocked(client)
+}
+
+// detachSessionLocked убирает соединение из сессий пользователя. Если закрылось активное соединение,
+// а другие остались, место переходит к самому старому из них; оно и возвращается. Вызывается под h.mu.
+func (h *Hub) detachSessionLocked(client *Client) *Client {
+	sessions := h.sessions[client.UserID]
+	for i, c := range sessions {
+		if c == client {
+			sessions = append(sessions[:i:i], sessions[i+1:]...)
+			break
+		}
+	}
+	if len(sessions) == 0 {
+		delete(h.sessions, client.UserID)
+	} else {
+		h.sessions[client.UserID] = sessions
+	}
+
+	if h.clientsByUserID[client.UserID] != client {
+		return nil
+	}
+	delete(h.clientsByUserID, client.UserID)
+	if len(sessions) == 0 {
+		return nil
+	}
+	next := sessions[0]
+	next.readOnly.Store(false)
+	h.clientsByUserID[client.UserID] = next
+	log.Printf("Hub: Active seat of UserID %s moved to connection %s", next.UserID, next.RemoteAddr())
+	return next
+}
+
+// claimPresence записывает пользователя за этим узлом. При политике takeover соединение
+// пользователя на другом узле закрывается так же, как локальное.
+func (h *Hub) claimPresence(userID string) {
+	if h.cluster == nil {
+		return
+	}
+	if h.SessionPolicy == SessionPolicyTakeover {
+		prevNode, err := h.cluster.presence.NodeOf(context.Background(), userID)
+		if err != nil {
+			log.Printf("Hub: Failed to look up node of user %s: %v", userID, err)
+		} else if prevNode != "" && prevNode != h.cluster.nodeID {
+			h.publish(h.cluster.nodeSubject(prevNode), clusterEnvelope{Kind: clusterKindReplace, UserID: userID})
+		}
+	}
+	h.setPresence(userID)
+}
+
+// replaceLocalSession закрывает локальные соединения пользователя, который подключился к узлу nodeID,
+// и передаёт туда его комнату, чтобы новое соединение продолжило игру.
+func (h *Hub) replaceLocalSession(userID, nodeID string) {
+	h.mu.Lock()
+	var roomID string
+	if client, ok := h.clientsByUserID[userID]; ok {
+		roomID = client.RoomID()
+	}
+	for _, client := range append([]*Client(nil), h.sessions[userID]...) {
+		h.replaceLocked(client)
+	}
+	h.mu.Unlock()
+
+	if roomID != "" {
+		h.publish(h.cluster.nodeSubject(nodeID), clusterEnvelope{Kind: clusterKindAttach, UserID: userID, RoomID: roomID})
+	}
+}
+
+// KickUser закрывает все соединения пользователя, на каком бы узле он ни был: каждое получает
+// notice и закрывается с кодом CloseKicked. Клиенты удаляются из хаба сразу, поэтому
+// OnDisconnectHandler не вызывается — выход из комнаты остаётся вызывающему.
+// Возвращает false, если пользователь не в сети.
+func (h *Hub) KickUser(userID string, notice []byte) bool {
+	if h.kickLocalUser(userID, notice) {
+		return true
+	}
+	return h.publishToUser(clusterEnvelope{Kind: clusterKindKick, UserID: userID, Data: notice})
+}
+
+func (h *Hub) kickLocalUser(userID string, notice []byte) bool {
+	h.mu.Lock()
+	sessions := append([]*Client(nil), h.sessions[userID]...)
+	for _, client := range sessions {
+		log.Printf("Hub: Kicking UserID %s, closing connection %s", userID, client.RemoteAddr())
+		if notice != nil {
+			if data, err := client.Encode(notice); err == nil {
+				h.sendLocked(client, data)
+			}
+		}
+		client.closeMessage = websocket.FormatCloseMessage(CloseKicked, "kicked")
+		h.removeClientLocked(client)
+	}
+	h.mu.Unlock()
+
+	if len(sessions) == 0 {
+		return false
+	}
+	h.clearPresence(userID)
+	return true
+}
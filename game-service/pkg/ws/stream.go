@@ -0,0 +1,91 @@
+package ws
+
+import (
+	"context"
+	"log"
+)
+
+// Клиенты без WebSocket — для сетей, где WebSocket заблокирован. События такой игрок получает
+// потоком (SSE через api-gateway), а команды шлёт отдельными запросами. Обработчики и рассылки
+// у них те же, что у WebSocket-клиентов, поэтому игроки на разных транспортах играют друг с другом.
+
+// NewStreamClient создаёт клиента-поток: хаб доставляет ему сообщения так же, как WebSocket-соединению,
+// а забирает их StreamPump. Регистрируется через Register, как обычный клиент; команд хаб от него не читает.
+func NewStreamClient(hub *Hub, userID, remoteIP string, codec Codec) *Client {
+	c := &Client{
+		Hub:          hub,
+		Send:         make(chan []byte, 256),
+		UserID:       userID,
+		RemoteIP:     remoteIP,
+		Codec:        codec,
+		Done:         make(chan struct{}),
+		streamClosed: make(chan struct{}),
+	}
+	if codec != nil {
+		c.ProtocolVersion = codec.Version()
+	}
+	return c
+}
+
+// StreamPump передаёт сообщения клиента-потока в write, пока не отменён ctx, хаб не закрыл
+// поток или write не вернул ошибку, а затем отключает клиента. Заменяет ReadPump и WritePump.
+func (c *Client) StreamPump(ctx context.Context, write func(message []byte) error) {
+	defer func() {
+		close(c.Done)
+		c.Hub.Unregister <- c
+		log.Printf("StreamPump stopped for client %s (UserID: %s)", c.RemoteAddr(), c.UserID)
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-c.streamClosed:
+			return
+		case message, ok := <-c.Send:
+			if !ok {
+				// Канал Send был закрыт хабом.
+				return
+			}
+			if err := write(message); err != nil {
+				log.Printf("Error writing message to stream client %s (UserID: %s): %v", c.RemoteAddr(), c.UserID, err)
+				return
+			}
+		}
+	}
+}
+
+// NewCommandClient создаёт клиента для одной команды, пришедшей без соединения. В хабе он
+// не регистрируется: ответ на команду получает RawMessage.Reply, переход в комнату расходится
+// через SetUserRoom, а сообщения ему — через SendToUser на поток или WebSocket пользователя.
+// Лимиты команд у всех таких клиентов пользователя общие.
+func NewCommandClient(hub *Hub, userID, roomID string, codec Codec) *Client {
+	c := &Client{
+		Hub:           hub,
+		Send:          make(chan []byte, 1),
+		UserID:        userID,
+		Codec:         codec,
+		Done:          make(chan struct{}),
+		detached:      true,
+		sharedLimiter: hub.userCommandLimiter(userID),
+	}
+	c.setRoomID(roomID)
+	if codec != nil {
+		c.ProtocolVersion = codec.Version()
+	}
+	return c
+}
+
+// Submit ставит команду клиента без соединения в ту же очередь комнаты, что и команды WebSocket-клиентов.
+// Команда проходит те же лимиты, что и команды из ReadPump, только считаются они на пользователя:
+// отклонённую команду получает RejectHandler, а после MaxViolations отказов закрываются соединения пользователя.
+func (h *Hub) Submit(msg *RawMessage) {
+	if reason := msg.Client.admit(msg.Payload); reason != "" {
+		h.reject(msg, reason)
+		if violations := msg.Client.commandLimiter().recordViolation(h.Limits); violations > 0 {
+			h.closeAbusiveUser(msg.Client.UserID, violations)
+		}
+		return
+	}
+	h.Broadcast <- msg
+}
@@ -1,6 +1,8 @@
 package config
 
 import (
+	_ "embed"
+	"os"
 	"time"
 
 	"github.com/caarlos0/env/v10"
@@ -10,17 +12,21 @@ import (
 
 type (
 	Config struct {
-		Mongo  mongo.Config
-		Server Server
-		Nats   Nats
-		Redis  Redis
-		Cache  Cache
+		Mongo        mongo.Config
+		Server       Server
+		Nats         Nats
+		Redis        Redis
+		Cache        Cache
+		League       League
+		Achievements Achievements
+		Missions     Missions
 
 		Version string `env:"VERSION"`
 	}
 
 	Server struct {
 		GRPCServer GRPCServer
+		GRPCClient GRPCClient
 	}
 
 	GRPCServer struct {
@@ -30,11 +36,18 @@ type (
 		MaxConnectionAgeGrace time.Duration `env:"GRPC_MAX_CONNECTION_AGE_GRACE" envDefault:"10s"`
 	}
 
+	GRPCClient struct {
+		// UserServiceURL is used to credit mission rewards
+		UserServiceURL string `env:"GRPC_USER_SERVICE_URL,required" envDefault:"0.0.0.0:8082"`
+	}
+
 	// Nats configuration for main application
 	Nats struct {
 		Hosts  []string `env:"NATS_HOSTS,notEmpty" envSeparator:"," envDefault:"localhost:4222"`
 		NKey   string   `env:"NATS_NKEY,notEmpty"`
 		IsTest bool     `env:"NATS_IS_TEST,notEmpty" envDefault:"true"`
+		// AchievementUnlockedSubject receives an AchievementUnlocked for every unlock
+		AchievementUnlockedSubject string `env:"NATS_ACHIEVEMENT_UNLOCKED_SUBJECT" envDefault:"statistics.events.achievement_unlocked"`
 	}
 
 	// Redis configuration for main application
@@ -52,8 +65,58 @@ type (
 
 		CMSVariableRefreshTime time.Duration `env:"CLIENT_REFRESH_TIME" envDefault:"1m"`
 	}
+
+	// League configures weekly leagues ranked by chips won in the week
+	League struct {
+		// Divisions are listed from the lowest to the highest; new players start in the lowest
+		Divisions     []string `env:"LEAGUE_DIVISIONS" envSeparator:"," envDefault:"bronze,silver,gold,platinum,diamond"`
+		GroupSize     int      `env:"LEAGUE_GROUP_SIZE" envDefault:"30"`
+		PromoteCount  int      `env:"LEAGUE_PROMOTE_COUNT" envDefault:"5"`
+		RelegateCount int      `env:"LEAGUE_RELEGATE_COUNT" envDefault:"5"`
+		// RolloverCheckInterval is how often the service checks whether last week still has to be closed
+		RolloverCheckInterval time.Duration `env:"LEAGUE_ROLLOVER_CHECK_INTERVAL" envDefault:"1m"`
+		// Retention is how long the standings of a finished week are kept
+		Retention time.Duration `env:"LEAGUE_RETENTION" envDefault:"672h"`
+	}
+
+	// Achievements configures the achievement rules
+	Achievements struct {
+		// RulesFile is a JSON file with the rules; the built-in achievements.json is used when empty
+		RulesFile string `env:"ACHIEVEMENTS_RULES_FILE"`
+	}
+
+	// Missions configures daily and weekly missions
+	Missions struct {
+		// File is a JSON file with the mission pool; the built-in missions.json is used when empty
+		File string `env:"MISSIONS_FILE"`
+		// DailyCount and WeeklyCount missions of the pool are active in a period, 0 activates all
+		DailyCount  int `env:"MISSIONS_DAILY_COUNT" envDefault:"3"`
+		WeeklyCount int `env:"MISSIONS_WEEKLY_COUNT" envDefault:"2"`
+	}
 )
 
+//go:embed achievements.json
+var defaultAchievementRules []byte
+
+// Rules returns the JSON achievement rules from RulesFile or the built-in ones.
+func (a Achievements) Rules() ([]byte, error) {
+	if a.RulesFile == "" {
+		return defaultAchievementRules, nil
+	}
+	return os.ReadFile(a.RulesFile)
+}
+
+//go:embed missions.json
+var defaultMissions []byte
+
+// Pool returns the JSON mission pool from File or the built-in one.
+func (m Missions) Pool() ([]byte, error) {
+	if m.File == "" {
+		return defaultMissions, nil
+	}
+	return os.ReadFile(m.File)
+}
+
 func New() (*Config, error) {
 	var cfg Config
 	err := env.Parse(&cfg)
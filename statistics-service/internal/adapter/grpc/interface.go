@@ -5,3 +5,15 @@ import "statistics/internal/adapter/grpc/server/frontend"
 type StatisticUsecase interface {
 	frontend.StatisticsUseCase
 }
+
+type LeagueUsecase interface {
+	frontend.LeagueUseCase
+}
+
+type AchievementUsecase interface {
+	frontend.AchievementUseCase
+}
+
+type MissionUsecase interface {
+	frontend.MissionUseCase
+}
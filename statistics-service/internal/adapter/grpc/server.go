@@ -15,20 +15,29 @@ import (
 )
 
 type API struct {
-	s            *grpc.Server
-	cfg          config.GRPCServer
-	addr         string
-	statsUsecase StatisticUsecase
+	s                  *grpc.Server
+	cfg                config.GRPCServer
+	addr               string
+	statsUsecase       StatisticUsecase
+	leagueUsecase      LeagueUsecase
+	achievementUsecase AchievementUsecase
+	missionUsecase     MissionUsecase
 }
 
 func New(
 	cfg config.GRPCServer,
 	statsUsecase StatisticUsecase,
+	leagueUsecase LeagueUsecase,
+	achievementUsecase AchievementUsecase,
+	missionUsecase MissionUsecase,
 ) *API {
 	return &API{
-		cfg:          cfg,
-		addr:         fmt.Sprintf("0.0.0.0:%d", cfg.Port),
-		statsUsecase: statsUsecase,
+		cfg:                cfg,
+		addr:               fmt.Sprintf("0.0.0.0:%d", cfg.Port),
+		statsUsecase:       statsUsecase,
+		leagueUsecase:      leagueUsecase,
+		achievementUsecase: achievementUsecase,
+		missionUsecase:     missionUsecase,
 	}
 }
 
@@ -67,7 +76,7 @@ func (a *API) run(ctx context.Context) error {
 	a.s = grpc.NewServer(a.setOptions(ctx)...)
 
 	// Register services
-	statisticsproto.RegisterStatisticsServiceServer(a.s, frontend.NewStatisticsServer(a.statsUsecase))
+	statisticsproto.RegisterStatisticsServiceServer(a.s, frontend.NewStatisticsServer(a.statsUsecase, a.leagueUsecase, a.achievementUsecase, a.missionUsecase))
 
 	reflection.Register(a.s)
 
@@ -13,6 +13,8 @@ func FromModelGeneralGameStatsToProto(stats model.GeneralGameStats) *statisticsv
 			TotalUsers:       stats.TotalUsers,
 			TotalGamesPlayed: stats.TotalGamesPlayed,
 			TotalBetAmount:   stats.TotalBetAmount,
+			TotalRake:        stats.TotalRake,
+			TotalJackpotPaid: stats.TotalJackpotPaid,
 			LastUpdatedAt:    timestamppb.New(stats.LastUpdatedAt),
 		},
 	}
@@ -22,19 +24,20 @@ func FromModelGeneralGameStatsToProto(stats model.GeneralGameStats) *statisticsv
 func FromModelUserGameStatsToProto(stats model.UserGameStats) *statisticsv1.GetUserGameStatsResponse {
 	return &statisticsv1.GetUserGameStatsResponse{
 		Stats: &statisticsv1.UserGameStats{
-			UserId:           stats.UserID,
-			GamesPlayed:      stats.GamesPlayed,
-			GamesWon:         stats.GamesWon,
-			GamesLost:        stats.GamesLost,
-			GamesDrawn:       stats.GamesDrawn,
-			TotalBet:         stats.TotalBet,
-			TotalWinnings:    stats.TotalWinnings,
-			TotalLosses:      stats.TotalLosses,
-			WinRate:          stats.WinRate,  // float64 maps to double
-			LossRate:         stats.LossRate, // float64 maps to double
-			WinStreak:        stats.WinStreak,
-			LossStreak:       stats.LossStreak,
-			LastGamePlayedAt: timestamppb.New(stats.LastGamePlayedAt),
+			UserId:            stats.UserID,
+			GamesPlayed:       stats.GamesPlayed,
+			GamesWon:          stats.GamesWon,
+			GamesLost:         stats.GamesLost,
+			GamesDrawn:        stats.GamesDrawn,
+			TotalBet:          stats.TotalBet,
+			TotalWinnings:     stats.TotalWinnings,
+			TotalLosses:       stats.TotalLosses,
+			WinRate:           stats.WinRate,  // float64 maps to double
+			LossRate:          stats.LossRate, // float64 maps to double
+			WinStreak:         stats.WinStreak,
+			LossStreak:        stats.LossStreak,
+			LastGamePlayedAt:  timestamppb.New(stats.LastGamePlayedAt),
+			RankedGamesPlayed: stats.RankedGamesPlayed,
 		},
 	}
 }
@@ -57,3 +60,76 @@ func FromModelLeaderboardToProto(lb model.Leaderboard) *statisticsv1.GetLeaderbo
 		},
 	}
 }
+
+// FromModelLeagueGroupToProto maps the player's league group to the proto response.
+func FromModelLeagueGroupToProto(g model.LeagueGroup) *statisticsv1.GetUserLeagueResponse {
+	standings := make([]*statisticsv1.LeagueStanding, len(g.Standings))
+	for i, s := range g.Standings {
+		standings[i] = &statisticsv1.LeagueStanding{
+			UserId: s.UserID,
+			Score:  s.Score,
+			Rank:   int32(s.Rank),
+			Zone:   s.Zone,
+		}
+	}
+	return &statisticsv1.GetUserLeagueResponse{
+		League: &statisticsv1.LeagueGroup{
+			Week:          g.Week,
+			Division:      int32(g.Division),
+			DivisionName:  g.DivisionName,
+			Group:         int32(g.Group),
+			Joined:        g.Joined,
+			Standings:     standings,
+			EndsAt:        timestamppb.New(g.EndsAt),
+			PromoteCount:  int32(g.PromoteCount),
+			RelegateCount: int32(g.RelegateCount),
+		},
+	}
+}
+
+func FromModelUserAchievementsToProto(achievements []model.UserAchievement) *statisticsv1.GetUserAchievementsResponse {
+	protoAchievements := make([]*statisticsv1.Achievement, len(achievements))
+	for i, a := range achievements {
+		protoAchievements[i] = &statisticsv1.Achievement{
+			Id:          a.AchievementID,
+			Name:        a.Name,
+			Description: a.Description,
+			Unlocked:    a.Unlocked,
+		}
+		if a.Unlocked {
+			protoAchievements[i].UnlockedAt = timestamppb.New(a.UnlockedAt)
+		}
+	}
+	return &statisticsv1.GetUserAchievementsResponse{Achievements: protoAchievements}
+}
+
+func FromModelUserMissionToProto(m model.UserMission) *statisticsv1.Mission {
+	return &statisticsv1.Mission{
+		Id:          m.ID,
+		Name:        m.Name,
+		Description: m.Description,
+		Period:      m.Period,
+		Progress:    m.Progress,
+		Target:      m.Target,
+		Reward:      m.Reward,
+		Completed:   m.Completed,
+		Claimed:     m.Claimed,
+		EndsAt:      timestamppb.New(m.EndsAt),
+	}
+}
+
+func FromModelUserMissionsToProto(missions []model.UserMission) *statisticsv1.GetUserMissionsResponse {
+	protoMissions := make([]*statisticsv1.Mission, len(missions))
+	for i, m := range missions {
+		protoMissions[i] = FromModelUserMissionToProto(m)
+	}
+	return &statisticsv1.GetUserMissionsResponse{Missions: protoMissions}
+}
+
+func FromModelMissionClaimToProto(claim model.MissionClaim) *statisticsv1.ClaimMissionRewardResponse {
+	return &statisticsv1.ClaimMissionRewardResponse{
+		Mission: FromModelUserMissionToProto(claim.Mission),
+		Amount:  claim.Amount,
+		Balance: claim.Balance,
+	}
+}
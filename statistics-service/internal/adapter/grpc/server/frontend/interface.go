@@ -10,3 +10,16 @@ type StatisticsUseCase interface {
 	GetUserGameStats(ctx context.Context, userID int64) (model.UserGameStats, error)
 	GetLeaderboard(ctx context.Context, leaderboardType string, limit int) (model.Leaderboard, error)
 }
+
+type LeagueUseCase interface {
+	GetUserLeague(ctx context.Context, userID int64) (model.LeagueGroup, error)
+}
+
+type AchievementUseCase interface {
+	GetUserAchievements(ctx context.Context, userID int64) ([]model.UserAchievement, error)
+}
+
+type MissionUseCase interface {
+	GetUserMissions(ctx context.Context, userID int64) ([]model.UserMission, error)
+	ClaimMissionReward(ctx context.Context, userID int64, missionID string) (model.MissionClaim, error)
+}
@@ -247,6 +247,9 @@ type GameResult struct {
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	Player1       *PlayerGameResult      `protobuf:"bytes,6,opt,name=player1,proto3" json:"player1,omitempty"`
 	Player2       *PlayerGameResult      `protobuf:"bytes,7,opt,name=player2,proto3" json:"player2,omitempty"`
+	Rake          int64                  `protobuf:"varint,8,opt,name=rake,proto3" json:"rake,omitempty"`
+	JackpotPayout int64                  `protobuf:"varint,9,opt,name=jackpot_payout,json=jackpotPayout,proto3" json:"jackpot_payout,omitempty"`
+	Ranked        bool                   `protobuf:"varint,10,opt,name=ranked,proto3" json:"ranked,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -330,11 +333,33 @@ func (x *GameResult) GetPlayer2() *PlayerGameResult {
 	return nil
 }
 
+func (x *GameResult) GetRake() int64 {
+	if x != nil {
+		return x.Rake
+	}
+	return 0
+}
+
+func (x *GameResult) GetJackpotPayout() int64 {
+	if x != nil {
+		return x.JackpotPayout
+	}
+	return 0
+}
+
+func (x *GameResult) GetRanked() bool {
+	if x != nil {
+		return x.Ranked
+	}
+	return false
+}
+
 type PlayerGameResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      int64                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	FinalScore    int32                  `protobuf:"varint,2,opt,name=final_score,json=finalScore,proto3" json:"final_score,omitempty"`
 	FinalHand     []string               `protobuf:"bytes,3,rep,name=final_hand,json=finalHand,proto3" json:"final_hand,omitempty"`
+	Stood         bool                   `protobuf:"varint,4,opt,name=stood,proto3" json:"stood,omitempty"` // the player stood before the game ended
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -390,6 +415,98 @@ func (x *PlayerGameResult) GetFinalHand() []string {
 	return nil
 }
 
+func (x *PlayerGameResult) GetStood() bool {
+	if x != nil {
+		return x.Stood
+	}
+	return false
+}
+
+// AchievementUnlocked is published by statistics-service when a player unlocks an achievement.
+type AchievementUnlocked struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AchievementId string                 `protobuf:"bytes,2,opt,name=achievement_id,json=achievementId,proto3" json:"achievement_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	RoomId        string                 `protobuf:"bytes,5,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UnlockedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=unlocked_at,json=unlockedAt,proto3" json:"unlocked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AchievementUnlocked) Reset() {
+	*x = AchievementUnlocked{}
+	mi := &file_events_statistics_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AchievementUnlocked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AchievementUnlocked) ProtoMessage() {}
+
+func (x *AchievementUnlocked) ProtoReflect() protoreflect.Message {
+	mi := &file_events_statistics_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AchievementUnlocked.ProtoReflect.Descriptor instead.
+func (*AchievementUnlocked) Descriptor() ([]byte, []int) {
+	return file_events_statistics_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AchievementUnlocked) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AchievementUnlocked) GetAchievementId() string {
+	if x != nil {
+		return x.AchievementId
+	}
+	return ""
+}
+
+func (x *AchievementUnlocked) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AchievementUnlocked) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AchievementUnlocked) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *AchievementUnlocked) GetUnlockedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnlockedAt
+	}
+	return nil
+}
+
 var File_events_statistics_proto protoreflect.FileDescriptor
 
 const file_events_statistics_proto_rawDesc = "" +
@@ -411,7 +528,7 @@ const file_events_statistics_proto_rawDesc = "" +
 	"\vUserUpdated\x12$\n" +
 	"\x04user\x18\x01 \x01(\v2\x10.events_svc.UserR\x04user\"\x1d\n" +
 	"\vUserDeleted\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\x9a\x02\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xed\x02\n" +
 	"\n" +
 	"GameResult\x12\x17\n" +
 	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1b\n" +
@@ -421,13 +538,26 @@ const file_events_statistics_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x126\n" +
 	"\aplayer1\x18\x06 \x01(\v2\x1c.events_svc.PlayerGameResultR\aplayer1\x126\n" +
-	"\aplayer2\x18\a \x01(\v2\x1c.events_svc.PlayerGameResultR\aplayer2\"o\n" +
+	"\aplayer2\x18\a \x01(\v2\x1c.events_svc.PlayerGameResultR\aplayer2\x12\x12\n" +
+	"\x04rake\x18\b \x01(\x03R\x04rake\x12%\n" +
+	"\x0ejackpot_payout\x18\t \x01(\x03R\rjackpotPayout\x12\x16\n" +
+	"\x06ranked\x18\n" +
+	" \x01(\bR\x06ranked\"\x85\x01\n" +
 	"\x10PlayerGameResult\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\x03R\bplayerId\x12\x1f\n" +
 	"\vfinal_score\x18\x02 \x01(\x05R\n" +
 	"finalScore\x12\x1d\n" +
 	"\n" +
-	"final_hand\x18\x03 \x03(\tR\tfinalHandBAZ?auth-service/internal/adapter/grpc/server/frontend/proto/eventsb\x06proto3"
+	"final_hand\x18\x03 \x03(\tR\tfinalHand\x12\x14\n" +
+	"\x05stood\x18\x04 \x01(\bR\x05stood\"\xe1\x01\n" +
+	"\x13AchievementUnlocked\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12%\n" +
+	"\x0eachievement_id\x18\x02 \x01(\tR\rachievementId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x17\n" +
+	"\aroom_id\x18\x05 \x01(\tR\x06roomId\x12;\n" +
+	"\vunlocked_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"unlockedAtBAZ?auth-service/internal/adapter/grpc/server/frontend/proto/eventsb\x06proto3"
 
 var (
 	file_events_statistics_proto_rawDescOnce sync.Once
@@ -441,7 +571,7 @@ func file_events_statistics_proto_rawDescGZIP() []byte {
 	return file_events_statistics_proto_rawDescData
 }
 
-var file_events_statistics_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_events_statistics_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_events_statistics_proto_goTypes = []any{
 	(*User)(nil),                  // 0: events_svc.User
 	(*UserCreated)(nil),           // 1: events_svc.UserCreated
@@ -449,21 +579,23 @@ var file_events_statistics_proto_goTypes = []any{
 	(*UserDeleted)(nil),           // 3: events_svc.UserDeleted
 	(*GameResult)(nil),            // 4: events_svc.GameResult
 	(*PlayerGameResult)(nil),      // 5: events_svc.PlayerGameResult
-	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(*AchievementUnlocked)(nil),   // 6: events_svc.AchievementUnlocked
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
 }
 var file_events_statistics_proto_depIdxs = []int32{
-	6, // 0: events_svc.User.created_at:type_name -> google.protobuf.Timestamp
-	6, // 1: events_svc.User.updated_at:type_name -> google.protobuf.Timestamp
+	7, // 0: events_svc.User.created_at:type_name -> google.protobuf.Timestamp
+	7, // 1: events_svc.User.updated_at:type_name -> google.protobuf.Timestamp
 	0, // 2: events_svc.UserCreated.user:type_name -> events_svc.User
 	0, // 3: events_svc.UserUpdated.user:type_name -> events_svc.User
-	6, // 4: events_svc.GameResult.created_at:type_name -> google.protobuf.Timestamp
+	7, // 4: events_svc.GameResult.created_at:type_name -> google.protobuf.Timestamp
 	5, // 5: events_svc.GameResult.player1:type_name -> events_svc.PlayerGameResult
 	5, // 6: events_svc.GameResult.player2:type_name -> events_svc.PlayerGameResult
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	7, // 7: events_svc.AchievementUnlocked.unlocked_at:type_name -> google.protobuf.Timestamp
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_events_statistics_proto_init() }
@@ -477,7 +609,7 @@ func file_events_statistics_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_statistics_proto_rawDesc), len(file_events_statistics_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -22,6 +22,10 @@ const (
 	StatisticsService_GetGeneralGameStats_FullMethodName = "/statistics.StatisticsService/GetGeneralGameStats"
 	StatisticsService_GetUserGameStats_FullMethodName    = "/statistics.StatisticsService/GetUserGameStats"
 	StatisticsService_GetLeaderboard_FullMethodName      = "/statistics.StatisticsService/GetLeaderboard"
+	StatisticsService_GetUserLeague_FullMethodName       = "/statistics.StatisticsService/GetUserLeague"
+	StatisticsService_GetUserAchievements_FullMethodName = "/statistics.StatisticsService/GetUserAchievements"
+	StatisticsService_GetUserMissions_FullMethodName     = "/statistics.StatisticsService/GetUserMissions"
+	StatisticsService_ClaimMissionReward_FullMethodName  = "/statistics.StatisticsService/ClaimMissionReward"
 )
 
 // StatisticsServiceClient is the client API for StatisticsService service.
@@ -33,6 +37,12 @@ type StatisticsServiceClient interface {
 	GetGeneralGameStats(ctx context.Context, in *GetGeneralGameStatsRequest, opts ...grpc.CallOption) (*GetGeneralGameStatsResponse, error)
 	GetUserGameStats(ctx context.Context, in *GetUserGameStatsRequest, opts ...grpc.CallOption) (*GetUserGameStatsResponse, error)
 	GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error)
+	GetUserLeague(ctx context.Context, in *GetUserLeagueRequest, opts ...grpc.CallOption) (*GetUserLeagueResponse, error)
+	GetUserAchievements(ctx context.Context, in *GetUserAchievementsRequest, opts ...grpc.CallOption) (*GetUserAchievementsResponse, error)
+	GetUserMissions(ctx context.Context, in *GetUserMissionsRequest, opts ...grpc.CallOption) (*GetUserMissionsResponse, error)
+	// Credits the reward of a completed mission; NOT_FOUND if it isn't active, FAILED_PRECONDITION
+	// if it isn't completed and ALREADY_EXISTS if it was claimed
+	ClaimMissionReward(ctx context.Context, in *ClaimMissionRewardRequest, opts ...grpc.CallOption) (*ClaimMissionRewardResponse, error)
 }
 
 type statisticsServiceClient struct {
@@ -73,6 +83,46 @@ func (c *statisticsServiceClient) GetLeaderboard(ctx context.Context, in *GetLea
 	return out, nil
 }
 
+func (c *statisticsServiceClient) GetUserLeague(ctx context.Context, in *GetUserLeagueRequest, opts ...grpc.CallOption) (*GetUserLeagueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserLeagueResponse)
+	err := c.cc.Invoke(ctx, StatisticsService_GetUserLeague_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statisticsServiceClient) GetUserAchievements(ctx context.Context, in *GetUserAchievementsRequest, opts ...grpc.CallOption) (*GetUserAchievementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserAchievementsResponse)
+	err := c.cc.Invoke(ctx, StatisticsService_GetUserAchievements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statisticsServiceClient) GetUserMissions(ctx context.Context, in *GetUserMissionsRequest, opts ...grpc.CallOption) (*GetUserMissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserMissionsResponse)
+	err := c.cc.Invoke(ctx, StatisticsService_GetUserMissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statisticsServiceClient) ClaimMissionReward(ctx context.Context, in *ClaimMissionRewardRequest, opts ...grpc.CallOption) (*ClaimMissionRewardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimMissionRewardResponse)
+	err := c.cc.Invoke(ctx, StatisticsService_ClaimMissionReward_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StatisticsServiceServer is the server API for StatisticsService service.
 // All implementations must embed UnimplementedStatisticsServiceServer
 // for forward compatibility.
@@ -82,6 +132,12 @@ type StatisticsServiceServer interface {
 	GetGeneralGameStats(context.Context, *GetGeneralGameStatsRequest) (*GetGeneralGameStatsResponse, error)
 	GetUserGameStats(context.Context, *GetUserGameStatsRequest) (*GetUserGameStatsResponse, error)
 	GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error)
+	GetUserLeague(context.Context, *GetUserLeagueRequest) (*GetUserLeagueResponse, error)
+	GetUserAchievements(context.Context, *GetUserAchievementsRequest) (*GetUserAchievementsResponse, error)
+	GetUserMissions(context.Context, *GetUserMissionsRequest) (*GetUserMissionsResponse, error)
+	// Credits the reward of a completed mission; NOT_FOUND if it isn't active, FAILED_PRECONDITION
+	// if it isn't completed and ALREADY_EXISTS if it was claimed
+	ClaimMissionReward(context.Context, *ClaimMissionRewardRequest) (*ClaimMissionRewardResponse, error)
 	mustEmbedUnimplementedStatisticsServiceServer()
 }
 
@@ -101,6 +157,18 @@ func (UnimplementedStatisticsServiceServer) GetUserGameStats(context.Context, *G
 func (UnimplementedStatisticsServiceServer) GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboard not implemented")
 }
+func (UnimplementedStatisticsServiceServer) GetUserLeague(context.Context, *GetUserLeagueRequest) (*GetUserLeagueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserLeague not implemented")
+}
+func (UnimplementedStatisticsServiceServer) GetUserAchievements(context.Context, *GetUserAchievementsRequest) (*GetUserAchievementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserAchievements not implemented")
+}
+func (UnimplementedStatisticsServiceServer) GetUserMissions(context.Context, *GetUserMissionsRequest) (*GetUserMissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserMissions not implemented")
+}
+func (UnimplementedStatisticsServiceServer) ClaimMissionReward(context.Context, *ClaimMissionRewardRequest) (*ClaimMissionRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimMissionReward not implemented")
+}
 func (UnimplementedStatisticsServiceServer) mustEmbedUnimplementedStatisticsServiceServer() {}
 func (UnimplementedStatisticsServiceServer) testEmbeddedByValue()                           {}
 
@@ -176,6 +244,78 @@ func _StatisticsService_GetLeaderboard_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StatisticsService_GetUserLeague_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserLeagueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatisticsServiceServer).GetUserLeague(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatisticsService_GetUserLeague_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatisticsServiceServer).GetUserLeague(ctx, req.(*GetUserLeagueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatisticsService_GetUserAchievements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserAchievementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatisticsServiceServer).GetUserAchievements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatisticsService_GetUserAchievements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatisticsServiceServer).GetUserAchievements(ctx, req.(*GetUserAchievementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatisticsService_GetUserMissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserMissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatisticsServiceServer).GetUserMissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatisticsService_GetUserMissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatisticsServiceServer).GetUserMissions(ctx, req.(*GetUserMissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatisticsService_ClaimMissionReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimMissionRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatisticsServiceServer).ClaimMissionReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatisticsService_ClaimMissionReward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatisticsServiceServer).ClaimMissionReward(ctx, req.(*ClaimMissionRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StatisticsService_ServiceDesc is the grpc.ServiceDesc for StatisticsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -195,6 +335,22 @@ var StatisticsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLeaderboard",
 			Handler:    _StatisticsService_GetLeaderboard_Handler,
 		},
+		{
+			MethodName: "GetUserLeague",
+			Handler:    _StatisticsService_GetUserLeague_Handler,
+		},
+		{
+			MethodName: "GetUserAchievements",
+			Handler:    _StatisticsService_GetUserAchievements_Handler,
+		},
+		{
+			MethodName: "GetUserMissions",
+			Handler:    _StatisticsService_GetUserMissions_Handler,
+		},
+		{
+			MethodName: "ClaimMissionReward",
+			Handler:    _StatisticsService_ClaimMissionReward_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
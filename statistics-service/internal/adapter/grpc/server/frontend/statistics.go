@@ -2,22 +2,27 @@ package frontend
 
 import (
 	"context"
+	"errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"log"
 	"statistics/internal/adapter/grpc/server/frontend/dto"
 	statisticsv1 "statistics/internal/adapter/grpc/server/frontend/proto"
+	"statistics/internal/model"
 )
 
 // StatisticsServer implements the gRPC StatisticsService.
 type StatisticsServer struct {
 	statisticsv1.UnimplementedStatisticsServiceServer
-	uc StatisticsUseCase
+	uc            StatisticsUseCase
+	leagueUC      LeagueUseCase
+	achievementUC AchievementUseCase
+	missionUC     MissionUseCase
 }
 
 // NewStatisticsServer creates a new StatisticsServer.
-func NewStatisticsServer(uc StatisticsUseCase) *StatisticsServer {
-	return &StatisticsServer{uc: uc}
+func NewStatisticsServer(uc StatisticsUseCase, leagueUC LeagueUseCase, achievementUC AchievementUseCase, missionUC MissionUseCase) *StatisticsServer {
+	return &StatisticsServer{uc: uc, leagueUC: leagueUC, achievementUC: achievementUC, missionUC: missionUC}
 }
 
 func (s *StatisticsServer) GetGeneralGameStats(ctx context.Context, req *statisticsv1.GetGeneralGameStatsRequest) (*statisticsv1.GetGeneralGameStatsResponse, error) {
@@ -57,3 +62,65 @@ func (s *StatisticsServer) GetLeaderboard(ctx context.Context, req *statisticsv1
 	}
 	return dto.FromModelLeaderboardToProto(domainLeaderboard), nil
 }
+
+func (s *StatisticsServer) GetUserLeague(ctx context.Context, req *statisticsv1.GetUserLeagueRequest) (*statisticsv1.GetUserLeagueResponse, error) {
+	if req.GetUserId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required and cannot be zero")
+	}
+
+	group, err := s.leagueUC.GetUserLeague(ctx, req.GetUserId())
+	if err != nil {
+		log.Printf("gRPC GetUserLeague: Error from use case for UserID %d: %v", req.GetUserId(), err)
+		return nil, status.Errorf(codes.Internal, "failed to get user league: %v", err)
+	}
+	return dto.FromModelLeagueGroupToProto(group), nil
+}
+
+func (s *StatisticsServer) GetUserAchievements(ctx context.Context, req *statisticsv1.GetUserAchievementsRequest) (*statisticsv1.GetUserAchievementsResponse, error) {
+	if req.GetUserId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required and cannot be zero")
+	}
+
+	achievements, err := s.achievementUC.GetUserAchievements(ctx, req.GetUserId())
+	if err != nil {
+		log.Printf("gRPC GetUserAchievements: Error from use case for UserID %d: %v", req.GetUserId(), err)
+		return nil, status.Errorf(codes.Internal, "failed to get user achievements: %v", err)
+	}
+	return dto.FromModelUserAchievementsToProto(achievements), nil
+}
+
+func (s *StatisticsServer) GetUserMissions(ctx context.Context, req *statisticsv1.GetUserMissionsRequest) (*statisticsv1.GetUserMissionsResponse, error) {
+	if req.GetUserId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required and cannot be zero")
+	}
+
+	missions, err := s.missionUC.GetUserMissions(ctx, req.GetUserId())
+	if err != nil {
+		log.Printf("gRPC GetUserMissions: Error from use case for UserID %d: %v", req.GetUserId(), err)
+		return nil, status.Errorf(codes.Internal, "failed to get user missions: %v", err)
+	}
+	return dto.FromModelUserMissionsToProto(missions), nil
+}
+
+func (s *StatisticsServer) ClaimMissionReward(ctx context.Context, req *statisticsv1.ClaimMissionRewardRequest) (*statisticsv1.ClaimMissionRewardResponse, error) {
+	if req.GetUserId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required and cannot be zero")
+	}
+	if req.GetMissionId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "mission_id is required")
+	}
+
+	claim, err := s.missionUC.ClaimMissionReward(ctx, req.GetUserId(), req.GetMissionId())
+	switch {
+	case errors.Is(err, model.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "mission is not active")
+	case errors.Is(err, model.ErrMissionNotCompleted):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, model.ErrMissionAlreadyClaimed):
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	case err != nil:
+		log.Printf("gRPC ClaimMissionReward: Error from use case for UserID %d: %v", req.GetUserId(), err)
+		return nil, status.Errorf(codes.Internal, "failed to claim mission reward: %v", err)
+	}
+	return dto.FromModelMissionClaimToProto(claim), nil
+}
@@ -0,0 +1,32 @@
+package users
+
+import (
+	"context"
+
+	svc "statistics/internal/adapter/grpc/server/frontend/proto/user"
+	"statistics/internal/model"
+)
+
+// Client calls user-service.
+type Client struct {
+	client svc.UserServiceClient
+}
+
+func NewClient(client svc.UserServiceClient) *Client {
+	return &Client{
+		client: client,
+	}
+}
+
+// CreditReward credits a reward once per user and reason.
+func (c *Client) CreditReward(ctx context.Context, userID, amount int64, reason string) (model.RewardCredit, error) {
+	resp, err := c.client.CreditReward(ctx, &svc.CreditRewardRequest{
+		UserId: userID,
+		Amount: amount,
+		Reason: reason,
+	})
+	if err != nil {
+		return model.RewardCredit{}, err
+	}
+	return model.RewardCredit{Balance: resp.Balance, AlreadyCredited: resp.AlreadyCredited}, nil
+}
@@ -0,0 +1,65 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"statistics/internal/adapter/mongo/dao"
+	"statistics/internal/model"
+)
+
+const (
+	userAchievementsCollection = "user_achievements" // One document per unlocked achievement
+)
+
+// AchievementRepoImpl implements usecase.AchievementRepository
+type AchievementRepoImpl struct {
+	db *mongo.Database
+}
+
+func NewAchievementRepository(db *mongo.Database) *AchievementRepoImpl {
+	return &AchievementRepoImpl{db: db}
+}
+
+// Unlock records the achievement; it reports false if the player had already unlocked it,
+// so a redelivered game result doesn't unlock it twice.
+func (r *AchievementRepoImpl) Unlock(ctx context.Context, achievement model.UserAchievement) (bool, error) {
+	coll := r.db.Collection(userAchievementsCollection)
+	filter := bson.M{"user_id": achievement.UserID, "achievement_id": achievement.AchievementID}
+	update := bson.M{
+		"$setOnInsert": bson.M{
+			"room_id":     achievement.RoomID,
+			"unlocked_at": achievement.UnlockedAt,
+		},
+	}
+	opts := options.Update().SetUpsert(true)
+
+	res, err := coll.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return false, fmt.Errorf("mongo: failed to unlock achievement %s for user %d: %w", achievement.AchievementID, achievement.UserID, err)
+	}
+	return res.UpsertedCount == 1, nil
+}
+
+func (r *AchievementRepoImpl) ListUserAchievements(ctx context.Context, userID int64) ([]model.UserAchievement, error) {
+	coll := r.db.Collection(userAchievementsCollection)
+	findOptions := options.Find().SetSort(bson.D{{Key: "unlocked_at", Value: 1}})
+
+	cursor, err := coll.Find(ctx, bson.M{"user_id": userID}, findOptions)
+	if err != nil {
+		return nil, fmt.Errorf("mongo: failed to query achievements of user %d: %w", userID, err)
+	}
+	defer cursor.Close(ctx)
+
+	var resultsDAO []dao.UserAchievementDAO
+	if err = cursor.All(ctx, &resultsDAO); err != nil {
+		return nil, fmt.Errorf("mongo: failed to decode achievements of user %d: %w", userID, err)
+	}
+	achievements := make([]model.UserAchievement, 0, len(resultsDAO))
+	for _, a := range resultsDAO {
+		achievements = append(achievements, dao.ToUserAchievementModel(a))
+	}
+	return achievements, nil
+}
@@ -0,0 +1,25 @@
+package dao
+
+import (
+	"statistics/internal/model"
+	"time"
+)
+
+// UserAchievementDAO represents the BSON structure of an unlocked achievement.
+type UserAchievementDAO struct {
+	UserID        int64     `bson:"user_id"`
+	AchievementID string    `bson:"achievement_id"`
+	RoomID        string    `bson:"room_id,omitempty"`
+	UnlockedAt    time.Time `bson:"unlocked_at"`
+}
+
+// ToUserAchievementModel maps from UserAchievementDAO to model.UserAchievement.
+func ToUserAchievementModel(dao UserAchievementDAO) model.UserAchievement {
+	return model.UserAchievement{
+		UserID:        dao.UserID,
+		AchievementID: dao.AchievementID,
+		Unlocked:      true,
+		UnlockedAt:    dao.UnlockedAt,
+		RoomID:        dao.RoomID,
+	}
+}
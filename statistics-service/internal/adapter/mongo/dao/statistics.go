@@ -13,6 +13,8 @@ type GeneralStatsDAO struct {
 	TotalUsers       int64     `bson:"total_users"`
 	TotalGamesPlayed int64     `bson:"total_games_played"`
 	TotalBetAmount   int64     `bson:"total_bet_amount"`
+	TotalRake        int64     `bson:"total_rake"`
+	TotalJackpotPaid int64     `bson:"total_jackpot_paid"`
 	LastUpdatedAt    time.Time `bson:"last_updated_at"`
 }
 
@@ -29,9 +31,10 @@ type UserGameStatsDAO struct {
 	TotalLosses   int64 `bson:"total_losses"`
 	// WinRate and LossRate are typically calculated, not stored, or updated transactionally.
 	// If you want to store them, add them here with bson tags.
-	WinStreak        int64     `bson:"win_streak"`
-	LossStreak       int64     `bson:"loss_streak"`
-	LastGamePlayedAt time.Time `bson:"last_game_played_at"`
+	WinStreak         int64     `bson:"win_streak"`
+	LossStreak        int64     `bson:"loss_streak"`
+	RankedGamesPlayed int64     `bson:"ranked_games_played"`
+	LastGamePlayedAt  time.Time `bson:"last_game_played_at"`
 }
 
 // --- Mapping Functions ---
@@ -42,6 +45,8 @@ func ToGeneralStatsModel(dao GeneralStatsDAO) model.GeneralGameStats {
 		TotalUsers:       dao.TotalUsers,
 		TotalGamesPlayed: dao.TotalGamesPlayed,
 		TotalBetAmount:   dao.TotalBetAmount,
+		TotalRake:        dao.TotalRake,
+		TotalJackpotPaid: dao.TotalJackpotPaid,
 		LastUpdatedAt:    dao.LastUpdatedAt,
 	}
 }
@@ -49,17 +54,18 @@ func ToGeneralStatsModel(dao GeneralStatsDAO) model.GeneralGameStats {
 // ToUserGameStatsModel maps from UserGameStatsDAO to model.UserGameStats.
 func ToUserGameStatsModel(dao UserGameStatsDAO) model.UserGameStats {
 	stats := model.UserGameStats{
-		UserID:           dao.UserID,
-		GamesPlayed:      dao.GamesPlayed,
-		GamesWon:         dao.GamesWon,
-		GamesLost:        dao.GamesLost,
-		GamesDrawn:       dao.GamesDrawn,
-		TotalBet:         dao.TotalBet,
-		TotalWinnings:    dao.TotalWinnings,
-		TotalLosses:      dao.TotalLosses,
-		WinStreak:        dao.WinStreak,
-		LossStreak:       dao.LossStreak,
-		LastGamePlayedAt: dao.LastGamePlayedAt,
+		UserID:            dao.UserID,
+		GamesPlayed:       dao.GamesPlayed,
+		GamesWon:          dao.GamesWon,
+		GamesLost:         dao.GamesLost,
+		GamesDrawn:        dao.GamesDrawn,
+		TotalBet:          dao.TotalBet,
+		TotalWinnings:     dao.TotalWinnings,
+		TotalLosses:       dao.TotalLosses,
+		WinStreak:         dao.WinStreak,
+		LossStreak:        dao.LossStreak,
+		RankedGamesPlayed: dao.RankedGamesPlayed,
+		LastGamePlayedAt:  dao.LastGamePlayedAt,
 	}
 	// Calculate rates after mapping core fields
 	if stats.GamesPlayed > 0 {
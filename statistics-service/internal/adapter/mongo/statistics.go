@@ -70,6 +70,8 @@ func (r *StatisticsRepoImpl) UpdateStatsForGameResult(ctx context.Context, gameR
 		"$inc": bson.M{
 			"total_games_played": 1,
 			"total_bet_amount":   gameResult.Bet,
+			"total_rake":         gameResult.Rake,
+			"total_jackpot_paid": gameResult.JackpotPayout,
 		},
 		"$set": bson.M{"last_updated_at": time.Now()},
 	}
@@ -117,6 +119,10 @@ func (r *StatisticsRepoImpl) UpdateStatsForGameResult(ctx context.Context, gameR
 		var gamesWonInc, gamesLostInc, gamesDrawnInc int64
 		var winningsInc, lossesInc int64
 		var playerBetAmount int64 = gameResult.Bet
+		var rankedInc int64
+		if gameResult.Ranked {
+			rankedInc = 1
+		}
 
 		currentWinStreak := currentUserStatsDAO.WinStreak
 		currentLossStreak := currentUserStatsDAO.LossStreak
@@ -143,13 +149,14 @@ func (r *StatisticsRepoImpl) UpdateStatsForGameResult(ctx context.Context, gameR
 
 		userUpdate := bson.M{
 			"$inc": bson.M{
-				"games_played":   1,
-				"games_won":      gamesWonInc,
-				"games_lost":     gamesLostInc,
-				"games_drawn":    gamesDrawnInc,
-				"total_bet":      playerBetAmount,
-				"total_winnings": winningsInc,
-				"total_losses":   lossesInc,
+				"games_played":        1,
+				"games_won":           gamesWonInc,
+				"games_lost":          gamesLostInc,
+				"games_drawn":         gamesDrawnInc,
+				"total_bet":           playerBetAmount,
+				"total_winnings":      winningsInc,
+				"total_losses":        lossesInc,
+				"ranked_games_played": rankedInc,
 			},
 			"$set": bson.M{
 				"last_game_played_at": gameResult.CreatedAt,
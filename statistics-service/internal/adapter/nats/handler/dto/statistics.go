@@ -62,6 +62,7 @@ func ToGameResultEventData(msgData []byte) (*model.GameResultEventData, error) {
 			PlayerID:   protoEvent.Player1.PlayerId,
 			FinalScore: protoEvent.Player1.FinalScore,
 			FinalHand:  protoEvent.Player1.FinalHand, // Already []string in proto
+			Stood:      protoEvent.Player1.Stood,
 		}
 	} else {
 		log.Println("Warning: GameResult event received with nil Player1 data")
@@ -73,19 +74,23 @@ func ToGameResultEventData(msgData []byte) (*model.GameResultEventData, error) {
 			PlayerID:   protoEvent.Player2.PlayerId,
 			FinalScore: protoEvent.Player2.FinalScore,
 			FinalHand:  protoEvent.Player2.FinalHand, // Already []string in proto
+			Stood:      protoEvent.Player2.Stood,
 		}
 	} else {
 		log.Println("Warning: GameResult event received with nil Player2 data")
 	}
 
 	domainEventData := &model.GameResultEventData{
-		RoomID:    protoEvent.RoomId,
-		WinnerID:  protoEvent.WinnerId,
-		LoserID:   protoEvent.LoserId,
-		Bet:       protoEvent.Bet,
-		CreatedAt: protoEvent.CreatedAt.AsTime(),
-		Player1:   p1Data,
-		Player2:   p2Data,
+		RoomID:        protoEvent.RoomId,
+		WinnerID:      protoEvent.WinnerId,
+		LoserID:       protoEvent.LoserId,
+		Bet:           protoEvent.Bet,
+		CreatedAt:     protoEvent.CreatedAt.AsTime(),
+		Player1:       p1Data,
+		Player2:       p2Data,
+		Rake:          protoEvent.Rake,
+		JackpotPayout: protoEvent.JackpotPayout,
+		Ranked:        protoEvent.Ranked,
 	}
 
 	return domainEventData, nil
@@ -10,3 +10,11 @@ type StatisticsEventConsumer interface {
 	HandleUserDeleted(ctx context.Context, eventData model.UserDeletedEventData) error
 	HandleGameResult(ctx context.Context, eventData model.GameResultEventData) error
 }
+
+type LeagueEventConsumer interface {
+	HandleGameResult(ctx context.Context, eventData model.GameResultEventData) error
+}
+
+type MissionEventConsumer interface {
+	HandleGameResult(ctx context.Context, eventData model.GameResultEventData) error
+}
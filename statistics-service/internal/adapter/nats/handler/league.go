@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"context"
+	"log"
+
+	"github.com/nats-io/nats.go"
+	"statistics/internal/adapter/nats/handler/dto"
+)
+
+// LeagueHandler feeds game results into the weekly leagues.
+type LeagueHandler struct {
+	leagueUsecase LeagueEventConsumer
+}
+
+func NewLeagueHandler(uc LeagueEventConsumer) *LeagueHandler {
+	return &LeagueHandler{leagueUsecase: uc}
+}
+
+// HandleNATSGameResult processes GameResult events from NATS for the leagues.
+func (h *LeagueHandler) HandleNATSGameResult(ctx context.Context, msg *nats.Msg) error {
+	eventData, err := dto.ToGameResultEventData(msg.Data)
+	if err != nil {
+		log.Printf("NATS League Handler: Failed to map GameResult event data: %v. Msg Data: %s", err, string(msg.Data))
+		return err
+	}
+
+	if err := h.leagueUsecase.HandleGameResult(ctx, *eventData); err != nil {
+		log.Printf("NATS League Handler: Failed to process GameResult event in use case for RoomID %s: %v", eventData.RoomID, err)
+		return err
+	}
+	return nil
+}
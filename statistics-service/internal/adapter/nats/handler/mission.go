@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"context"
+	"log"
+
+	"github.com/nats-io/nats.go"
+	"statistics/internal/adapter/nats/handler/dto"
+)
+
+// MissionHandler counts game results towards daily and weekly missions.
+type MissionHandler struct {
+	missionUsecase MissionEventConsumer
+}
+
+func NewMissionHandler(uc MissionEventConsumer) *MissionHandler {
+	return &MissionHandler{missionUsecase: uc}
+}
+
+// HandleNATSGameResult processes GameResult events from NATS for the missions.
+func (h *MissionHandler) HandleNATSGameResult(ctx context.Context, msg *nats.Msg) error {
+	eventData, err := dto.ToGameResultEventData(msg.Data)
+	if err != nil {
+		log.Printf("NATS Mission Handler: Failed to map GameResult event data: %v. Msg Data: %s", err, string(msg.Data))
+		return err
+	}
+
+	if err := h.missionUsecase.HandleGameResult(ctx, *eventData); err != nil {
+		log.Printf("NATS Mission Handler: Failed to process GameResult event in use case for RoomID %s: %v", eventData.RoomID, err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package producer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"statistics/internal/adapter/nats/producer/dto"
+	"statistics/internal/model"
+	"statistics/pkg/nats"
+)
+
+const PushTimeout = time.Second * 30
+
+type AchievementEvent struct {
+	natsClient *nats.Client
+	subject    string
+}
+
+func NewAchievementEvent(natsClient *nats.Client, subject string) *AchievementEvent {
+	return &AchievementEvent{
+		natsClient: natsClient,
+		subject:    subject,
+	}
+}
+
+// PushAchievementUnlocked publishes an AchievementUnlocked event, e.g. for notifications.
+func (c *AchievementEvent) PushAchievementUnlocked(ctx context.Context, achievement model.UserAchievement) error {
+	ctx, cancel := context.WithTimeout(ctx, PushTimeout)
+	defer cancel()
+
+	data, err := proto.Marshal(dto.FromUserAchievement(achievement))
+	if err != nil {
+		return fmt.Errorf("proto.Marshal: %w", err)
+	}
+
+	if err := c.natsClient.Conn.Publish(c.subject, data); err != nil {
+		return fmt.Errorf("publish AchievementUnlocked: %w", err)
+	}
+	log.Printf("AchievementUnlocked event for UserID %d, achievement %s pushed", achievement.UserID, achievement.AchievementID)
+	return nil
+}
@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+	eventsproto "statistics/internal/adapter/grpc/server/frontend/proto/events"
+	"statistics/internal/model"
+)
+
+// FromUserAchievement maps an unlocked achievement to the AchievementUnlocked event.
+func FromUserAchievement(a model.UserAchievement) *eventsproto.AchievementUnlocked {
+	return &eventsproto.AchievementUnlocked{
+		UserId:        a.UserID,
+		AchievementId: a.AchievementID,
+		Name:          a.Name,
+		Description:   a.Description,
+		RoomId:        a.RoomID,
+		UnlockedAt:    timestamppb.New(a.UnlockedAt),
+	}
+}
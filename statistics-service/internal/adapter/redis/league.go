@@ -0,0 +1,252 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+	"statistics/internal/model"
+	"statistics/pkg/redis"
+)
+
+// League keys:
+//   - league:divisions — hash userID -> division index, the division a player starts the next week in
+//   - league:<week>:members — hash userID -> "<division>:<group>" for the players of the week
+//   - league:<week>:fill:<division> — hash {group, size} of the group being filled
+//   - league:<week>:groups — set of "<division>:<group>" of the week
+//   - league:<week>:group:<division>:<group> — sorted set userID -> chips won
+//   - league:<week>:rollover — "running" while the week is being closed, then "done"
+const (
+	leagueDivisionsKey    = "league:divisions"
+	leagueRolloverRunning = "running"
+	leagueRolloverDone    = "done"
+)
+
+type LeagueRepositoryImpl struct {
+	client *redis.Client
+}
+
+func NewLeagueRepository(client *redis.Client) *LeagueRepositoryImpl {
+	return &LeagueRepositoryImpl{client: client}
+}
+
+func leagueMembersKey(week string) string {
+	return fmt.Sprintf("league:%s:members", week)
+}
+
+func leagueFillKey(week string, division int) string {
+	return fmt.Sprintf("league:%s:fill:%d", week, division)
+}
+
+func leagueGroupsKey(week string) string {
+	return fmt.Sprintf("league:%s:groups", week)
+}
+
+func leagueGroupKey(m model.LeagueMembership) string {
+	return fmt.Sprintf("league:%s:group:%d:%d", m.Week, m.Division, m.Group)
+}
+
+func leagueRolloverKey(week string) string {
+	return fmt.Sprintf("league:%s:rollover", week)
+}
+
+// parseMembership reads a "<division>:<group>" value.
+func parseMembership(week, value string) (model.LeagueMembership, error) {
+	division, group, ok := strings.Cut(value, ":")
+	if !ok {
+		return model.LeagueMembership{}, fmt.Errorf("invalid league membership %q", value)
+	}
+	d, err := strconv.Atoi(division)
+	if err != nil {
+		return model.LeagueMembership{}, fmt.Errorf("invalid league division in %q: %w", value, err)
+	}
+	g, err := strconv.Atoi(group)
+	if err != nil {
+		return model.LeagueMembership{}, fmt.Errorf("invalid league group in %q: %w", value, err)
+	}
+	return model.LeagueMembership{Week: week, Division: d, Group: g}, nil
+}
+
+func (r *LeagueRepositoryImpl) GetDivision(ctx context.Context, userID int64) (int, error) {
+	division, err := r.client.Unwrap().HGet(ctx, leagueDivisionsKey, strconv.FormatInt(userID, 10)).Int()
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("redis HGet for league division (userID %d) failed: %w", userID, err)
+	}
+	return division, nil
+}
+
+// SetDivisions records the divisions players start the next week in.
+func (r *LeagueRepositoryImpl) SetDivisions(ctx context.Context, divisions map[int64]int) error {
+	if len(divisions) == 0 {
+		return nil
+	}
+	values := make([]interface{}, 0, len(divisions)*2)
+	for userID, division := range divisions {
+		values = append(values, strconv.FormatInt(userID, 10), division)
+	}
+	if err := r.client.Unwrap().HSet(ctx, leagueDivisionsKey, values...).Err(); err != nil {
+		return fmt.Errorf("redis HSet for league divisions failed: %w", err)
+	}
+	return nil
+}
+
+// GetMembership returns the player's group of the week; ok is false if they haven't played that week.
+func (r *LeagueRepositoryImpl) GetMembership(ctx context.Context, week string, userID int64) (model.LeagueMembership, bool, error) {
+	value, err := r.client.Unwrap().HGet(ctx, leagueMembersKey(week), strconv.FormatInt(userID, 10)).Result()
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return model.LeagueMembership{}, false, nil
+		}
+		return model.LeagueMembership{}, false, fmt.Errorf("redis HGet for league membership (userID %d) failed: %w", userID, err)
+	}
+	m, err := parseMembership(week, value)
+	if err != nil {
+		return model.LeagueMembership{}, false, err
+	}
+	return m, true, nil
+}
+
+// joinLeagueScript puts a player into the group of the division being filled, opening
+// the next group once it has ARGV[3] players. A player already in a group keeps it.
+var joinLeagueScript = goredis.NewScript(`
+local existing = redis.call('HGET', KEYS[1], ARGV[1])
+if existing then
+	return existing
+end
+local group = tonumber(redis.call('HGET', KEYS[2], 'group') or '1')
+local size = tonumber(redis.call('HGET', KEYS[2], 'size') or '0')
+if size >= tonumber(ARGV[3]) then
+	group = group + 1
+	size = 0
+end
+redis.call('HSET', KEYS[2], 'group', group, 'size', size + 1)
+local membership = ARGV[2] .. ':' .. group
+redis.call('HSET', KEYS[1], ARGV[1], membership)
+redis.call('SADD', KEYS[3], membership)
+for i = 1, 3 do
+	redis.call('EXPIRE', KEYS[i], ARGV[4])
+end
+return membership
+`)
+
+// JoinGroup places the player into a group of division for the week and returns their membership.
+func (r *LeagueRepositoryImpl) JoinGroup(ctx context.Context, week string, userID int64, division, groupSize int, ttl time.Duration) (model.LeagueMembership, error) {
+	keys := []string{leagueMembersKey(week), leagueFillKey(week, division), leagueGroupsKey(week)}
+	value, err := joinLeagueScript.Run(ctx, r.client.Unwrap(), keys,
+		strconv.FormatInt(userID, 10), division, groupSize, int64(ttl.Seconds())).Text()
+	if err != nil {
+		return model.LeagueMembership{}, fmt.Errorf("redis join of league week %s (userID %d) failed: %w", week, userID, err)
+	}
+	return parseMembership(week, value)
+}
+
+// AddScore adds chips to the player's score in their group.
+func (r *LeagueRepositoryImpl) AddScore(ctx context.Context, m model.LeagueMembership, userID int64, chips int64, ttl time.Duration) error {
+	key := leagueGroupKey(m)
+	pipe := r.client.Unwrap().TxPipeline()
+	pipe.ZIncrBy(ctx, key, float64(chips), strconv.FormatInt(userID, 10))
+	pipe.Expire(ctx, key, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("redis ZIncrBy for %s (userID %d) failed: %w", key, userID, err)
+	}
+	return nil
+}
+
+// GetGroupStandings returns the players of a group from the highest score down, ranked from 1.
+func (r *LeagueRepositoryImpl) GetGroupStandings(ctx context.Context, m model.LeagueMembership) ([]model.LeagueStanding, error) {
+	key := leagueGroupKey(m)
+	members, err := r.client.Unwrap().ZRevRangeWithScores(ctx, key, 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis ZRevRange for %s failed: %w", key, err)
+	}
+	standings := make([]model.LeagueStanding, 0, len(members))
+	for i, member := range members {
+		id, ok := member.Member.(string)
+		if !ok {
+			continue
+		}
+		userID, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user id %q in %s: %w", id, key, err)
+		}
+		standings = append(standings, model.LeagueStanding{UserID: userID, Score: int64(member.Score), Rank: i + 1})
+	}
+	return standings, nil
+}
+
+func (r *LeagueRepositoryImpl) ListGroups(ctx context.Context, week string) ([]model.LeagueMembership, error) {
+	values, err := r.client.Unwrap().SMembers(ctx, leagueGroupsKey(week)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis SMembers for league week %s failed: %w", week, err)
+	}
+	groups := make([]model.LeagueMembership, 0, len(values))
+	for _, value := range values {
+		m, err := parseMembership(week, value)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, m)
+	}
+	return groups, nil
+}
+
+// StartRollover claims closing the week; false if it is already closed, model.ErrLeagueRolloverRunning
+// if another instance is closing it. The claim expires after lockTTL, so a rollover that crashed is retried.
+func (r *LeagueRepositoryImpl) StartRollover(ctx context.Context, week string, lockTTL time.Duration) (bool, error) {
+	ok, err := r.client.Unwrap().SetNX(ctx, leagueRolloverKey(week), leagueRolloverRunning, lockTTL).Result()
+	if err != nil {
+		return false, fmt.Errorf("redis SetNX for league rollover %s failed: %w", week, err)
+	}
+	if ok {
+		return true, nil
+	}
+	state, err := r.client.Unwrap().Get(ctx, leagueRolloverKey(week)).Result()
+	if err != nil && !errors.Is(err, goredis.Nil) {
+		return false, fmt.Errorf("redis Get for league rollover %s failed: %w", week, err)
+	}
+	if state == leagueRolloverDone {
+		return false, nil
+	}
+	// The claim may have just expired; the caller retries either way
+	return false, fmt.Errorf("week %s: %w", week, model.ErrLeagueRolloverRunning)
+}
+
+// abortRolloverScript deletes the claim of a week, unless the week has been closed meanwhile.
+var abortRolloverScript = goredis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// AbortRollover gives up the claim of a rollover that failed, so the week can be closed again at once.
+func (r *LeagueRepositoryImpl) AbortRollover(ctx context.Context, week string) error {
+	if err := abortRolloverScript.Run(ctx, r.client.Unwrap(), []string{leagueRolloverKey(week)}, leagueRolloverRunning).Err(); err != nil {
+		return fmt.Errorf("redis abort of league rollover %s failed: %w", week, err)
+	}
+	return nil
+}
+
+// RolloverDone tells whether the week has been closed.
+func (r *LeagueRepositoryImpl) RolloverDone(ctx context.Context, week string) (bool, error) {
+	state, err := r.client.Unwrap().Get(ctx, leagueRolloverKey(week)).Result()
+	if err != nil && !errors.Is(err, goredis.Nil) {
+		return false, fmt.Errorf("redis Get for league rollover %s failed: %w", week, err)
+	}
+	return state == leagueRolloverDone, nil
+}
+
+// FinishRollover marks the week as closed for ttl.
+func (r *LeagueRepositoryImpl) FinishRollover(ctx context.Context, week string, ttl time.Duration) error {
+	if err := r.client.Unwrap().Set(ctx, leagueRolloverKey(week), leagueRolloverDone, ttl).Err(); err != nil {
+		return fmt.Errorf("redis Set for league rollover %s failed: %w", week, err)
+	}
+	return nil
+}
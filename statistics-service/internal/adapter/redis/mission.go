@@ -0,0 +1,79 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"statistics/internal/model"
+	"statistics/pkg/redis"
+)
+
+// Mission keys:
+//   - missions:<period key>:<userID> — hash missionID -> games counted, claimed:<missionID> -> 1
+const missionClaimedPrefix = "claimed:"
+
+type MissionRepositoryImpl struct {
+	client *redis.Client
+}
+
+func NewMissionRepository(client *redis.Client) *MissionRepositoryImpl {
+	return &MissionRepositoryImpl{client: client}
+}
+
+func missionProgressKey(periodKey string, userID int64) string {
+	return fmt.Sprintf("missions:%s:%d", periodKey, userID)
+}
+
+// AddProgress counts a game towards the missions of the period; the key expires after ttl.
+func (r *MissionRepositoryImpl) AddProgress(ctx context.Context, periodKey string, userID int64, missionIDs []string, ttl time.Duration) error {
+	if len(missionIDs) == 0 {
+		return nil
+	}
+	key := missionProgressKey(periodKey, userID)
+	pipe := r.client.Unwrap().TxPipeline()
+	for _, id := range missionIDs {
+		pipe.HIncrBy(ctx, key, id, 1)
+	}
+	pipe.Expire(ctx, key, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("redis HIncrBy for %s failed: %w", key, err)
+	}
+	return nil
+}
+
+func (r *MissionRepositoryImpl) GetProgress(ctx context.Context, periodKey string, userID int64) (model.MissionProgress, error) {
+	key := missionProgressKey(periodKey, userID)
+	values, err := r.client.Unwrap().HGetAll(ctx, key).Result()
+	if err != nil {
+		return model.MissionProgress{}, fmt.Errorf("redis HGetAll for %s failed: %w", key, err)
+	}
+
+	progress := model.MissionProgress{Progress: make(map[string]int64), Claimed: make(map[string]bool)}
+	for field, value := range values {
+		if id, ok := strings.CutPrefix(field, missionClaimedPrefix); ok {
+			progress.Claimed[id] = true
+			continue
+		}
+		count, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return model.MissionProgress{}, fmt.Errorf("invalid mission progress %q in %s: %w", value, key, err)
+		}
+		progress.Progress[field] = count
+	}
+	return progress, nil
+}
+
+// MarkClaimed records that the reward of the mission was credited.
+func (r *MissionRepositoryImpl) MarkClaimed(ctx context.Context, periodKey string, userID int64, missionID string, ttl time.Duration) error {
+	key := missionProgressKey(periodKey, userID)
+	pipe := r.client.Unwrap().TxPipeline()
+	pipe.HSet(ctx, key, missionClaimedPrefix+missionID, 1)
+	pipe.Expire(ctx, key, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("redis HSet for %s failed: %w", key, err)
+	}
+	return nil
+}
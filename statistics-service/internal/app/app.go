@@ -2,19 +2,26 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
 	"os/signal"
 	"strings"
 	"syscall"
+	"time"
 
 	"statistics/config"
 	grpcserver "statistics/internal/adapter/grpc"
+	usersvc "statistics/internal/adapter/grpc/server/frontend/proto/user"
+	grpcusersvcclient "statistics/internal/adapter/grpc/users"
 	mongorepo "statistics/internal/adapter/mongo"
 	natshandler "statistics/internal/adapter/nats/handler"
+	natsproducer "statistics/internal/adapter/nats/producer"
 	"statistics/internal/adapter/redis"
+	"statistics/internal/model"
 	"statistics/internal/usecase"
+	grpcconn "statistics/pkg/grpcconn"
 	mongocon "statistics/pkg/mongo"
 	natsconn "statistics/pkg/nats"
 	natsconsumer "statistics/pkg/nats/consumer"
@@ -26,6 +33,8 @@ const serviceName = "statistics-service"
 type App struct {
 	grpcServer         *grpcserver.API
 	natsPubSubConsumer *natsconsumer.PubSub
+	leagueUsecase      *usecase.LeagueUseCase
+	leagueCheckEvery   time.Duration
 }
 
 func New(ctx context.Context, cfg *config.Config) (*App, error) {
@@ -45,6 +54,13 @@ func New(ctx context.Context, cfg *config.Config) (*App, error) {
 	}
 	log.Println("NATS connection status is", natsClient.Conn.Status().String())
 
+	// user-service credits mission rewards
+	userServiceGRPCConn, err := grpcconn.New(cfg.Server.GRPCClient.UserServiceURL)
+	if err != nil {
+		return nil, fmt.Errorf("grpcconn.New: %w", err)
+	}
+	userServiceClient := grpcusersvcclient.NewClient(usersvc.NewUserServiceClient(userServiceGRPCConn))
+
 	// redis user
 	redisClient, err := redisconn.NewClient(ctx, (redisconn.Config)(cfg.Redis))
 	if err != nil {
@@ -61,20 +77,78 @@ func New(ctx context.Context, cfg *config.Config) (*App, error) {
 	// Initialize statistics components
 	statsRepo := mongorepo.NewStatisticsRepository(mongoDB.Conn)
 	historyStatsRepo := mongorepo.NewGameHistoryRepository(mongoDB.Conn)
-	statsUsecase := usecase.NewStatisticsUseCase(statsRepo, statisticsRedisCache, historyStatsRepo)
+
+	// Achievements
+	achievementRulesJSON, err := cfg.Achievements.Rules()
+	if err != nil {
+		return nil, fmt.Errorf("achievement rules: %w", err)
+	}
+	achievementRules, err := usecase.ParseAchievementRules(achievementRulesJSON)
+	if err != nil {
+		return nil, err
+	}
+	achievementRepo := mongorepo.NewAchievementRepository(mongoDB.Conn)
+	achievementProducer := natsproducer.NewAchievementEvent(natsClient, cfg.Nats.AchievementUnlockedSubject)
+	achievementUsecase := usecase.NewAchievementUseCase(achievementRepo, statsRepo, achievementProducer, achievementRules)
+	log.Println("loaded achievement rules:", len(achievementRules))
+
+	statsUsecase := usecase.NewStatisticsUseCase(statsRepo, statisticsRedisCache, historyStatsRepo, achievementUsecase)
 	userHandler := natshandler.NewEventHandler(statsUsecase)
 
+	// Weekly leagues
+	leagueRepo := redis.NewLeagueRepository(redisClient)
+	leagueUsecase := usecase.NewLeagueUseCase(leagueRepo, model.LeagueRules{
+		Divisions:     cfg.League.Divisions,
+		GroupSize:     cfg.League.GroupSize,
+		PromoteCount:  cfg.League.PromoteCount,
+		RelegateCount: cfg.League.RelegateCount,
+		Retention:     cfg.League.Retention,
+	})
+	leagueHandler := natshandler.NewLeagueHandler(leagueUsecase)
+
+	// Daily and weekly missions
+	missionPoolJSON, err := cfg.Missions.Pool()
+	if err != nil {
+		return nil, fmt.Errorf("mission pool: %w", err)
+	}
+	missions, err := usecase.ParseMissions(missionPoolJSON)
+	if err != nil {
+		return nil, err
+	}
+	missionRepo := redis.NewMissionRepository(redisClient)
+	missionUsecase := usecase.NewMissionUseCase(missionRepo, userServiceClient, missions, model.MissionRules{
+		DailyCount:  cfg.Missions.DailyCount,
+		WeeklyCount: cfg.Missions.WeeklyCount,
+	})
+	missionHandler := natshandler.NewMissionHandler(missionUsecase)
+	log.Println("loaded missions:", len(missions))
+
+	// Every handler has its own queue group, so each event reaches every handler once,
+	// however many replicas of the service run.
 	subscriptions := []natsconsumer.PubSubSubscriptionConfig{
 		{
 			Subject: "user.events.created",
+			Queue:   "statistics.users",
 			Handler: userHandler.HandleNATSUserCreated,
 		},
 		{
 			Subject: "game.events.result",
+			Queue:   "statistics.games",
 			Handler: userHandler.HandleNATSGameResult,
 		},
+		{
+			Subject: "game.events.result",
+			Queue:   "statistics.leagues",
+			Handler: leagueHandler.HandleNATSGameResult,
+		},
+		{
+			Subject: "game.events.result",
+			Queue:   "statistics.missions",
+			Handler: missionHandler.HandleNATSGameResult,
+		},
 		{
 			Subject: "user.events.deleted",
+			Queue:   "statistics.users",
 			Handler: userHandler.HandleNATSUserDeleted,
 		},
 	}
@@ -86,11 +160,16 @@ func New(ctx context.Context, cfg *config.Config) (*App, error) {
 	gRPCServer := grpcserver.New(
 		cfg.Server.GRPCServer,
 		statsUsecase,
+		leagueUsecase,
+		achievementUsecase,
+		missionUsecase,
 	)
 
 	app := &App{
 		grpcServer:         gRPCServer,
 		natsPubSubConsumer: natsPubSubConsumer,
+		leagueUsecase:      leagueUsecase,
+		leagueCheckEvery:   cfg.League.RolloverCheckInterval,
 	}
 
 	return app, nil
@@ -108,6 +187,7 @@ func (a *App) Run() error {
 	ctx := context.Background()
 	a.grpcServer.Run(ctx, errCh)
 	a.natsPubSubConsumer.Start(ctx, errCh)
+	go a.rollOverLeagues(ctx)
 	log.Println(fmt.Sprintf("service %v started", serviceName))
 	// Waiting signal
 	shutdownCh := make(chan os.Signal, 1)
@@ -126,3 +206,20 @@ func (a *App) Run() error {
 
 	return nil
 }
+
+// rollOverLeagues periodically closes the previous league week, so divisions move even if nobody plays.
+func (a *App) rollOverLeagues(ctx context.Context) {
+	ticker := time.NewTicker(a.leagueCheckEvery)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := a.leagueUsecase.RollOver(ctx, now); err != nil && !errors.Is(err, model.ErrLeagueRolloverRunning) {
+				log.Printf("failed to roll over leagues: %v\n", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package model
+
+import "time"
+
+// Metrics an achievement condition can check. Game metrics describe the player's side of the
+// game that just ended, stats metrics are the player's stored totals after that game.
+const (
+	MetricWon               = "won"  // 1 if the player won the game
+	MetricLost              = "lost" // 1 if the player lost the game
+	MetricDrawn             = "drawn"
+	MetricFinalScore        = "final_score"
+	MetricHandSize          = "hand_size"
+	MetricBet               = "bet"
+	MetricRanked            = "ranked" // 1 if the game was a ranked match
+	MetricJackpotPayout     = "jackpot_payout"
+	MetricStood             = "stood" // 1 if the player stood before the game ended
+	MetricGamesPlayed       = "games_played"
+	MetricGamesWon          = "games_won"
+	MetricWinStreak         = "win_streak"
+	MetricLossStreak        = "loss_streak"
+	MetricRankedGamesPlayed = "ranked_games_played"
+	MetricTotalWinnings     = "total_winnings"
+)
+
+// GameMetrics lists the metrics of a single game.
+var GameMetrics = []string{
+	MetricWon, MetricLost, MetricDrawn, MetricFinalScore, MetricHandSize, MetricBet, MetricRanked,
+	MetricJackpotPayout, MetricStood,
+}
+
+// AchievementMetrics lists every metric a rule may refer to.
+var AchievementMetrics = append(append([]string{}, GameMetrics...),
+	MetricGamesPlayed, MetricGamesWon, MetricWinStreak, MetricLossStreak,
+	MetricRankedGamesPlayed, MetricTotalWinnings,
+)
+
+// AchievementRule is an achievement declared as data: it unlocks once all conditions hold after a game.
+type AchievementRule struct {
+	ID          string
+	Name        string
+	Description string
+	Conditions  []MetricCondition
+}
+
+// MetricCondition compares a metric with a value; Op is one of ==, !=, >, >=, <, <=.
+type MetricCondition struct {
+	Metric string
+	Op     string
+	Value  int64
+}
+
+// Matches reports whether every condition holds for the metrics.
+func (r AchievementRule) Matches(metrics map[string]int64) bool {
+	for _, c := range r.Conditions {
+		if !c.Holds(metrics[c.Metric]) {
+			return false
+		}
+	}
+	return len(r.Conditions) > 0
+}
+
+func (c MetricCondition) Holds(v int64) bool {
+	switch c.Op {
+	case "==":
+		return v == c.Value
+	case "!=":
+		return v != c.Value
+	case ">":
+		return v > c.Value
+	case ">=":
+		return v >= c.Value
+	case "<":
+		return v < c.Value
+	case "<=":
+		return v <= c.Value
+	default:
+		return false
+	}
+}
+
+// UserAchievement is an achievement as a player sees it; UnlockedAt and RoomID are set once unlocked.
+type UserAchievement struct {
+	UserID        int64
+	AchievementID string
+	Name          string
+	Description   string
+	Unlocked      bool
+	UnlockedAt    time.Time
+	RoomID        string // room of the game that unlocked it
+}
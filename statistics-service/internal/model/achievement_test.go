@@ -0,0 +1,83 @@
+package model
+
+import "testing"
+
+func TestMetricConditionHolds(t *testing.T) {
+	tests := []struct {
+		op   string
+		v    int64
+		want bool
+	}{
+		{op: "==", v: 21, want: true},
+		{op: "==", v: 20, want: false},
+		{op: "!=", v: 20, want: true},
+		{op: "!=", v: 21, want: false},
+		{op: ">", v: 22, want: true},
+		{op: ">", v: 21, want: false},
+		{op: ">=", v: 21, want: true},
+		{op: ">=", v: 20, want: false},
+		{op: "<", v: 20, want: true},
+		{op: "<", v: 21, want: false},
+		{op: "<=", v: 21, want: true},
+		{op: "<=", v: 22, want: false},
+		{op: "=~", v: 21, want: false},
+	}
+	for _, tt := range tests {
+		c := MetricCondition{Metric: MetricFinalScore, Op: tt.op, Value: 21}
+		if got := c.Holds(tt.v); got != tt.want {
+			t.Errorf("%d %s 21 = %v, want %v", tt.v, tt.op, got, tt.want)
+		}
+	}
+}
+
+func TestAchievementRuleMatches(t *testing.T) {
+	blackjack := AchievementRule{ID: "blackjack", Conditions: []MetricCondition{
+		{Metric: MetricWon, Op: "==", Value: 1},
+		{Metric: MetricFinalScore, Op: "==", Value: 21},
+		{Metric: MetricHandSize, Op: "==", Value: 2},
+	}}
+	tests := []struct {
+		name    string
+		rule    AchievementRule
+		metrics map[string]int64
+		want    bool
+	}{
+		{
+			name:    "all conditions hold",
+			rule:    blackjack,
+			metrics: map[string]int64{MetricWon: 1, MetricFinalScore: 21, MetricHandSize: 2},
+			want:    true,
+		},
+		{
+			name:    "one condition fails",
+			rule:    blackjack,
+			metrics: map[string]int64{MetricWon: 1, MetricFinalScore: 21, MetricHandSize: 3},
+			want:    false,
+		},
+		{
+			name:    "missing metric counts as zero",
+			rule:    blackjack,
+			metrics: map[string]int64{MetricFinalScore: 21, MetricHandSize: 2},
+			want:    false,
+		},
+		{
+			name:    "zero condition on a missing metric",
+			rule:    AchievementRule{ID: "clean", Conditions: []MetricCondition{{Metric: MetricLossStreak, Op: "==", Value: 0}}},
+			metrics: map[string]int64{},
+			want:    true,
+		},
+		{
+			name:    "rule without conditions never matches",
+			rule:    AchievementRule{ID: "empty"},
+			metrics: map[string]int64{MetricWon: 1},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rule.Matches(tt.metrics); got != tt.want {
+				t.Errorf("Matches(%v) = %v, want %v", tt.metrics, got, tt.want)
+			}
+		})
+	}
+}
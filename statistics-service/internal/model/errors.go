@@ -4,4 +4,10 @@ import "errors"
 
 var (
 	ErrNotFound = errors.New("not found")
+
+	ErrMissionNotCompleted   = errors.New("mission is not completed")
+	ErrMissionAlreadyClaimed = errors.New("mission reward already claimed")
+
+	// ErrLeagueRolloverRunning means another instance is closing the league week right now.
+	ErrLeagueRolloverRunning = errors.New("league rollover is running")
 )
@@ -0,0 +1,106 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// League zones a player can finish the week in.
+const (
+	LeagueZonePromotion  = "promotion"
+	LeagueZoneRelegation = "relegation"
+)
+
+// LeagueRules holds the weekly league settings.
+type LeagueRules struct {
+	Divisions     []string // division names from the lowest to the highest
+	GroupSize     int      // players per group; a group is filled before the next one is opened
+	PromoteCount  int      // top players of a group who move up a division at the end of the week
+	RelegateCount int      // bottom players of a group who move down a division
+	Retention     time.Duration
+}
+
+// LeagueMembership tells which division and group a player competes in for a week.
+type LeagueMembership struct {
+	Week     string
+	Division int // index into LeagueRules.Divisions, 0 is the lowest
+	Group    int
+}
+
+// LeagueStanding is a player's place in their group.
+type LeagueStanding struct {
+	UserID int64
+	Score  int64 // chips won this week
+	Rank   int
+	Zone   string // LeagueZonePromotion, LeagueZoneRelegation or empty
+}
+
+// LeagueGroup is the group a player competes in this week with its standings.
+// Joined is false until the player wins chips this week; then only Division is set.
+type LeagueGroup struct {
+	Week          string
+	Division      int
+	DivisionName  string
+	Group         int
+	Joined        bool
+	Standings     []LeagueStanding
+	EndsAt        time.Time
+	PromoteCount  int
+	RelegateCount int
+}
+
+// LeagueWeekStart returns the start of the UTC week (Monday 00:00) that contains t.
+func LeagueWeekStart(t time.Time) time.Time {
+	t = t.UTC()
+	daysSinceMonday := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, time.UTC)
+}
+
+// LeagueWeek returns the ISO week of t, e.g. "2026-W43", which names a league week.
+func LeagueWeek(t time.Time) string {
+	year, week := t.UTC().ISOWeek()
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
+
+// PreviousLeagueWeek returns the league week before week, e.g. "2026-W01" -> "2025-W52".
+func PreviousLeagueWeek(week string) string {
+	var year, num int
+	if _, err := fmt.Sscanf(week, "%d-W%d", &year, &num); err != nil {
+		return ""
+	}
+	// January 4th is always in ISO week 1
+	monday := LeagueWeekStart(time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)).AddDate(0, 0, 7*(num-1))
+	return LeagueWeek(monday.AddDate(0, 0, -7))
+}
+
+// Zone tells whether the player at rank (1-based) of a group of groupSize players in division
+// goes up, down or stays. Only players who won chips can be promoted; in a small group the
+// promotion zone takes precedence over the relegation zone.
+func (r LeagueRules) Zone(division, rank, groupSize int, score int64) string {
+	if rank <= r.PromoteCount && score > 0 && division < len(r.Divisions)-1 {
+		return LeagueZonePromotion
+	}
+	if division > 0 && rank > r.PromoteCount && rank > groupSize-r.RelegateCount {
+		return LeagueZoneRelegation
+	}
+	return ""
+}
+
+// NextDivision returns the division the player at rank of a group in division starts the next week in.
+func (r LeagueRules) NextDivision(division, rank, groupSize int, score int64) int {
+	switch r.Zone(division, rank, groupSize, score) {
+	case LeagueZonePromotion:
+		return division + 1
+	case LeagueZoneRelegation:
+		return division - 1
+	}
+	return division
+}
+
+// DivisionName returns the name of a division, empty for one no longer configured.
+func (r LeagueRules) DivisionName(division int) string {
+	if division < 0 || division >= len(r.Divisions) {
+		return ""
+	}
+	return r.Divisions[division]
+}
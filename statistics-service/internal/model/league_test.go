@@ -0,0 +1,72 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLeagueRulesZone(t *testing.T) {
+	rules := LeagueRules{Divisions: []string{"bronze", "silver", "gold"}, PromoteCount: 2, RelegateCount: 2}
+	tests := []struct {
+		name      string
+		division  int
+		rank      int
+		groupSize int
+		score     int64
+		want      string
+	}{
+		{name: "top of the group", division: 1, rank: 1, groupSize: 10, score: 500, want: LeagueZonePromotion},
+		{name: "last promotion place", division: 1, rank: 2, groupSize: 10, score: 100, want: LeagueZonePromotion},
+		{name: "middle of the group", division: 1, rank: 5, groupSize: 10, score: 100, want: ""},
+		{name: "first relegation place", division: 1, rank: 9, groupSize: 10, score: 0, want: LeagueZoneRelegation},
+		{name: "bottom of the group", division: 1, rank: 10, groupSize: 10, score: 0, want: LeagueZoneRelegation},
+		{name: "no promotion without chips won", division: 1, rank: 1, groupSize: 10, score: 0, want: ""},
+		{name: "no promotion from the highest division", division: 2, rank: 1, groupSize: 10, score: 500, want: ""},
+		{name: "no relegation from the lowest division", division: 0, rank: 10, groupSize: 10, score: 0, want: ""},
+		{name: "promotion wins over relegation in a small group", division: 1, rank: 2, groupSize: 3, score: 100, want: LeagueZonePromotion},
+		{name: "promotion place without chips isn't relegated in a small group", division: 1, rank: 2, groupSize: 3, score: 0, want: ""},
+		{name: "relegation in a small group", division: 1, rank: 3, groupSize: 3, score: 0, want: LeagueZoneRelegation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rules.Zone(tt.division, tt.rank, tt.groupSize, tt.score); got != tt.want {
+				t.Errorf("Zone(%d, %d, %d, %d) = %q, want %q", tt.division, tt.rank, tt.groupSize, tt.score, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLeagueRulesNextDivision(t *testing.T) {
+	rules := LeagueRules{Divisions: []string{"bronze", "silver", "gold"}, PromoteCount: 1, RelegateCount: 1}
+	if got := rules.NextDivision(1, 1, 5, 100); got != 2 {
+		t.Errorf("promoted player: got division %d, want 2", got)
+	}
+	if got := rules.NextDivision(1, 5, 5, 0); got != 0 {
+		t.Errorf("relegated player: got division %d, want 0", got)
+	}
+	if got := rules.NextDivision(1, 3, 5, 50); got != 1 {
+		t.Errorf("player in the middle: got division %d, want 1", got)
+	}
+}
+
+func TestPreviousLeagueWeek(t *testing.T) {
+	tests := []struct {
+		week string
+		want string
+	}{
+		{week: "2026-W43", want: "2026-W42"},
+		{week: "2026-W01", want: "2025-W52"},
+		{week: "2021-W01", want: "2020-W53"},
+		{week: "garbage", want: ""},
+	}
+	for _, tt := range tests {
+		if got := PreviousLeagueWeek(tt.week); got != tt.want {
+			t.Errorf("PreviousLeagueWeek(%q) = %q, want %q", tt.week, got, tt.want)
+		}
+	}
+	// The week before the current one is the week seven days ago, across a year boundary too
+	now := time.Date(2026, time.January, 1, 12, 0, 0, 0, time.UTC)
+	if got, want := PreviousLeagueWeek(LeagueWeek(now)), LeagueWeek(now.AddDate(0, 0, -7)); got != want {
+		t.Errorf("PreviousLeagueWeek(%q) = %q, want %q", LeagueWeek(now), got, want)
+	}
+}
@@ -0,0 +1,102 @@
+package model
+
+import "time"
+
+// Mission periods.
+const (
+	MissionPeriodDaily  = "daily"
+	MissionPeriodWeekly = "weekly"
+)
+
+// MissionPeriods lists the periods in the order missions are shown.
+var MissionPeriods = []string{MissionPeriodDaily, MissionPeriodWeekly}
+
+// Mission is a task of a day or a week: every game whose metrics meet all conditions adds one
+// to the progress, and once it reaches Target the player can claim Reward chips.
+// A mission without conditions counts every game.
+type Mission struct {
+	ID          string
+	Name        string
+	Description string
+	Period      string
+	Conditions  []MetricCondition
+	Target      int64
+	Reward      int64
+}
+
+// Counts reports whether a game with the metrics counts towards the mission.
+func (m Mission) Counts(metrics map[string]int64) bool {
+	for _, c := range m.Conditions {
+		if !c.Holds(metrics[c.Metric]) {
+			return false
+		}
+	}
+	return true
+}
+
+// MissionRules configure how many missions of the pool are active in a period.
+type MissionRules struct {
+	DailyCount  int
+	WeeklyCount int
+}
+
+// Count returns how many missions of the period are active, 0 meaning all of them.
+func (r MissionRules) Count(period string) int {
+	if period == MissionPeriodWeekly {
+		return r.WeeklyCount
+	}
+	return r.DailyCount
+}
+
+// MissionProgress is a player's progress on the missions of one period.
+type MissionProgress struct {
+	Progress map[string]int64 // mission ID -> games counted
+	Claimed  map[string]bool
+}
+
+// UserMission is an active mission with the player's progress.
+type UserMission struct {
+	Mission
+	PeriodKey string // "2026-10-19" for a day, "2026-W43" for a week
+	Progress  int64  // capped at Target
+	Completed bool
+	Claimed   bool
+	EndsAt    time.Time
+}
+
+// MissionClaim is the result of claiming a mission reward.
+type MissionClaim struct {
+	Mission UserMission
+	Amount  int64
+	Balance int64 // balance after the reward
+}
+
+// RewardCredit is the result of crediting a reward through user-service.
+type RewardCredit struct {
+	Balance         int64
+	AlreadyCredited bool
+}
+
+// MissionPeriodStart returns the start of the day (UTC) or the league week containing t.
+func MissionPeriodStart(period string, t time.Time) time.Time {
+	if period == MissionPeriodWeekly {
+		return LeagueWeekStart(t)
+	}
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+// MissionPeriodEnd returns when the period containing t ends.
+func MissionPeriodEnd(period string, t time.Time) time.Time {
+	if period == MissionPeriodWeekly {
+		return LeagueWeekStart(t).AddDate(0, 0, 7)
+	}
+	return MissionPeriodStart(period, t).AddDate(0, 0, 1)
+}
+
+// MissionPeriodKey names the period containing t, e.g. "2026-10-19" or "2026-W43".
+func MissionPeriodKey(period string, t time.Time) string {
+	if period == MissionPeriodWeekly {
+		return LeagueWeek(t)
+	}
+	return t.UTC().Format(time.DateOnly)
+}
@@ -9,24 +9,27 @@ type GeneralGameStats struct {
 	TotalUsers       int64
 	TotalGamesPlayed int64
 	TotalBetAmount   int64 // Sum of all bets
+	TotalRake        int64 // Sum of the house rake taken from pots
+	TotalJackpotPaid int64 // Sum of progressive jackpot payouts
 	LastUpdatedAt    time.Time
 }
 
 // UserGameStats holds statistics for a specific user.
 type UserGameStats struct {
-	UserID           int64
-	GamesPlayed      int64
-	GamesWon         int64
-	GamesLost        int64
-	GamesDrawn       int64
-	TotalBet         int64
-	TotalWinnings    int64 // Sum of bets won
-	TotalLosses      int64 // Sum of bets lost
-	WinRate          float64
-	LossRate         float64
-	WinStreak        int64
-	LossStreak       int64
-	LastGamePlayedAt time.Time
+	UserID            int64
+	GamesPlayed       int64
+	GamesWon          int64
+	GamesLost         int64
+	GamesDrawn        int64
+	TotalBet          int64
+	TotalWinnings     int64 // Sum of bets won
+	TotalLosses       int64 // Sum of bets lost
+	WinRate           float64
+	LossRate          float64
+	WinStreak         int64
+	LossStreak        int64
+	RankedGamesPlayed int64
+	LastGamePlayedAt  time.Time
 }
 
 // LeaderboardEntry represents an entry in a leaderboard.
@@ -80,15 +83,19 @@ type PlayerGameResultData struct {
 	PlayerID   int64
 	FinalScore int32
 	FinalHand  []string
+	Stood      bool // The player stood before the game ended
 }
 
 // GameResultEventData holds the data for a game result event.
 type GameResultEventData struct {
-	RoomID    string
-	WinnerID  int64 // 0 for a draw
-	LoserID   int64 // 0 for a draw
-	Bet       int64
-	CreatedAt time.Time // Timestamp of game end / event creation
-	Player1   PlayerGameResultData
-	Player2   PlayerGameResultData
+	RoomID        string
+	WinnerID      int64 // 0 for a draw
+	LoserID       int64 // 0 for a draw
+	Bet           int64
+	CreatedAt     time.Time // Timestamp of game end / event creation
+	Player1       PlayerGameResultData
+	Player2       PlayerGameResultData
+	Rake          int64 // House rake taken from the pot
+	JackpotPayout int64 // Jackpot paid to the winner, 0 if not hit
+	Ranked        bool  // The game was a ranked match
 }
@@ -0,0 +1,227 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"statistics/internal/model"
+	"time"
+)
+
+// achievementRuleJSON is a rule as written in the rules file.
+type achievementRuleJSON struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Conditions  []conditionJSON `json:"conditions"`
+}
+
+// conditionJSON is a metric condition as written in a rules file.
+type conditionJSON struct {
+	Metric string `json:"metric"`
+	Op     string `json:"op"`
+	Value  int64  `json:"value"`
+}
+
+var conditionOps = []string{"==", "!=", ">", ">=", "<", "<="}
+
+// parseConditions checks that every condition uses one of metrics and a known operator.
+func parseConditions(raw []conditionJSON, metrics []string) ([]model.MetricCondition, error) {
+	conditions := make([]model.MetricCondition, 0, len(raw))
+	for _, c := range raw {
+		if !slices.Contains(metrics, c.Metric) {
+			return nil, fmt.Errorf("unknown metric %q", c.Metric)
+		}
+		if !slices.Contains(conditionOps, c.Op) {
+			return nil, fmt.Errorf("unknown operator %q", c.Op)
+		}
+		conditions = append(conditions, model.MetricCondition{Metric: c.Metric, Op: c.Op, Value: c.Value})
+	}
+	return conditions, nil
+}
+
+// ParseAchievementRules reads and validates achievement rules from JSON.
+func ParseAchievementRules(data []byte) ([]model.AchievementRule, error) {
+	var raw []achievementRuleJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse achievement rules: %w", err)
+	}
+
+	rules := make([]model.AchievementRule, 0, len(raw))
+	seen := make(map[string]bool, len(raw))
+	for i, r := range raw {
+		if r.ID == "" {
+			return nil, fmt.Errorf("achievement rule #%d has no id", i+1)
+		}
+		if seen[r.ID] {
+			return nil, fmt.Errorf("achievement rule %q is declared twice", r.ID)
+		}
+		seen[r.ID] = true
+		if len(r.Conditions) == 0 {
+			return nil, fmt.Errorf("achievement rule %q has no conditions", r.ID)
+		}
+
+		conditions, err := parseConditions(r.Conditions, model.AchievementMetrics)
+		if err != nil {
+			return nil, fmt.Errorf("achievement rule %q: %w", r.ID, err)
+		}
+		rules = append(rules, model.AchievementRule{ID: r.ID, Name: r.Name, Description: r.Description, Conditions: conditions})
+	}
+	return rules, nil
+}
+
+// AchievementUseCase unlocks achievements declared by rules: after every game it checks each rule
+// the players haven't unlocked yet against the game and their updated statistics.
+type AchievementUseCase struct {
+	repo     AchievementRepository
+	stats    StatisticsRepository
+	producer AchievementEventProducer
+	rules    []model.AchievementRule
+}
+
+func NewAchievementUseCase(
+	repo AchievementRepository,
+	stats StatisticsRepository,
+	producer AchievementEventProducer,
+	rules []model.AchievementRule,
+) *AchievementUseCase {
+	return &AchievementUseCase{
+		repo:     repo,
+		stats:    stats,
+		producer: producer,
+		rules:    rules,
+	}
+}
+
+// EvaluateGameResult unlocks the achievements both players earned with the game.
+// It must run after the game's statistics were stored.
+func (uc *AchievementUseCase) EvaluateGameResult(ctx context.Context, eventData model.GameResultEventData) error {
+	for _, player := range []model.PlayerGameResultData{eventData.Player1, eventData.Player2} {
+		if player.PlayerID == 0 {
+			continue
+		}
+		if err := uc.evaluatePlayer(ctx, eventData, player); err != nil {
+			return fmt.Errorf("failed to evaluate achievements of UserID %d: %w", player.PlayerID, err)
+		}
+	}
+	return nil
+}
+
+func (uc *AchievementUseCase) evaluatePlayer(ctx context.Context, eventData model.GameResultEventData, player model.PlayerGameResultData) error {
+	unlocked, err := uc.repo.ListUserAchievements(ctx, player.PlayerID)
+	if err != nil {
+		return err
+	}
+	stats, err := uc.stats.RepoGetUserGameStats(ctx, player.PlayerID)
+	if err != nil {
+		return err
+	}
+	metrics := achievementMetrics(eventData, player, stats)
+
+	unlockedAt := eventData.CreatedAt
+	if unlockedAt.IsZero() {
+		unlockedAt = time.Now()
+	}
+	for _, rule := range uc.rules {
+		if hasAchievement(unlocked, rule.ID) || !rule.Matches(metrics) {
+			continue
+		}
+		achievement := model.UserAchievement{
+			UserID:        player.PlayerID,
+			AchievementID: rule.ID,
+			Name:          rule.Name,
+			Description:   rule.Description,
+			Unlocked:      true,
+			UnlockedAt:    unlockedAt,
+			RoomID:        eventData.RoomID,
+		}
+		isNew, err := uc.repo.Unlock(ctx, achievement)
+		if err != nil {
+			return err
+		}
+		if !isNew {
+			continue
+		}
+		log.Printf("AchievementUseCase: UserID %d unlocked %s in RoomID %s", player.PlayerID, rule.ID, eventData.RoomID)
+		if err := uc.producer.PushAchievementUnlocked(ctx, achievement); err != nil {
+			log.Printf("AchievementUseCase: Warning - Failed to push AchievementUnlocked for UserID %d: %v", player.PlayerID, err)
+		}
+	}
+	return nil
+}
+
+// achievementMetrics collects the values rules can check: the player's side of the game and their totals.
+func achievementMetrics(eventData model.GameResultEventData, player model.PlayerGameResultData, stats model.UserGameStats) map[string]int64 {
+	metrics := gameMetrics(eventData, player)
+	metrics[model.MetricGamesPlayed] = stats.GamesPlayed
+	metrics[model.MetricGamesWon] = stats.GamesWon
+	metrics[model.MetricWinStreak] = stats.WinStreak
+	metrics[model.MetricLossStreak] = stats.LossStreak
+	metrics[model.MetricRankedGamesPlayed] = stats.RankedGamesPlayed
+	metrics[model.MetricTotalWinnings] = stats.TotalWinnings
+	return metrics
+}
+
+// gameMetrics collects the values of the player's side of the game.
+func gameMetrics(eventData model.GameResultEventData, player model.PlayerGameResultData) map[string]int64 {
+	metrics := map[string]int64{
+		model.MetricFinalScore: int64(player.FinalScore),
+		model.MetricHandSize:   int64(len(player.FinalHand)),
+		model.MetricBet:        eventData.Bet,
+	}
+	switch eventData.WinnerID {
+	case 0:
+		metrics[model.MetricDrawn] = 1
+	case player.PlayerID:
+		metrics[model.MetricWon] = 1
+		metrics[model.MetricJackpotPayout] = eventData.JackpotPayout
+	default:
+		metrics[model.MetricLost] = 1
+	}
+	if eventData.Ranked {
+		metrics[model.MetricRanked] = 1
+	}
+	if player.Stood {
+		metrics[model.MetricStood] = 1
+	}
+	return metrics
+}
+
+func hasAchievement(achievements []model.UserAchievement, id string) bool {
+	for _, a := range achievements {
+		if a.AchievementID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// GetUserAchievements returns every achievement in rule order with the player's progress on it.
+func (uc *AchievementUseCase) GetUserAchievements(ctx context.Context, userID int64) ([]model.UserAchievement, error) {
+	unlocked, err := uc.repo.ListUserAchievements(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("repository error for UserID %d: %w", userID, err)
+	}
+
+	achievements := make([]model.UserAchievement, 0, len(uc.rules))
+	for _, rule := range uc.rules {
+		achievement := model.UserAchievement{
+			UserID:        userID,
+			AchievementID: rule.ID,
+			Name:          rule.Name,
+			Description:   rule.Description,
+		}
+		for _, u := range unlocked {
+			if u.AchievementID == rule.ID {
+				achievement.Unlocked = true
+				achievement.UnlockedAt = u.UnlockedAt
+				achievement.RoomID = u.RoomID
+				break
+			}
+		}
+		achievements = append(achievements, achievement)
+	}
+	return achievements, nil
+}
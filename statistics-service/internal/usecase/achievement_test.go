@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"reflect"
+	"testing"
+
+	"statistics/internal/model"
+)
+
+func TestParseAchievementRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    []model.AchievementRule
+		wantErr bool
+	}{
+		{
+			name: "valid rules",
+			in: `[
+				{"id": "first_win", "name": "First win", "conditions": [{"metric": "games_won", "op": ">=", "value": 1}]},
+				{"id": "blackjack", "conditions": [{"metric": "final_score", "op": "==", "value": 21}, {"metric": "hand_size", "op": "==", "value": 2}]}
+			]`,
+			want: []model.AchievementRule{
+				{ID: "first_win", Name: "First win", Conditions: []model.MetricCondition{{Metric: "games_won", Op: ">=", Value: 1}}},
+				{ID: "blackjack", Conditions: []model.MetricCondition{{Metric: "final_score", Op: "==", Value: 21}, {Metric: "hand_size", Op: "==", Value: 2}}},
+			},
+		},
+		{name: "empty list", in: `[]`, want: []model.AchievementRule{}},
+		{name: "not json", in: `{`, wantErr: true},
+		{name: "missing id", in: `[{"conditions": [{"metric": "won", "op": "==", "value": 1}]}]`, wantErr: true},
+		{
+			name: "duplicate id",
+			in: `[{"id": "a", "conditions": [{"metric": "won", "op": "==", "value": 1}]},
+				{"id": "a", "conditions": [{"metric": "lost", "op": "==", "value": 1}]}]`,
+			wantErr: true,
+		},
+		{name: "no conditions", in: `[{"id": "a", "conditions": []}]`, wantErr: true},
+		{name: "unknown metric", in: `[{"id": "a", "conditions": [{"metric": "mood", "op": "==", "value": 1}]}]`, wantErr: true},
+		{name: "unknown operator", in: `[{"id": "a", "conditions": [{"metric": "won", "op": "=>", "value": 1}]}]`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAchievementRules([]byte(tt.in))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseAchievementRules = %+v, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseAchievementRules: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseAchievementRules:\n got %+v\nwant %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -33,3 +33,46 @@ type StatisticsRedisCache interface {
 type GameHistoryRepository interface {
 	InsertGame(ctx context.Context, gameHistoryEntry model.GameHistory) error
 }
+
+// LeagueRepository defines methods for storing weekly league groups and standings.
+type LeagueRepository interface {
+	GetDivision(ctx context.Context, userID int64) (int, error)
+	SetDivisions(ctx context.Context, divisions map[int64]int) error
+	GetMembership(ctx context.Context, week string, userID int64) (model.LeagueMembership, bool, error)
+	JoinGroup(ctx context.Context, week string, userID int64, division, groupSize int, ttl time.Duration) (model.LeagueMembership, error)
+	AddScore(ctx context.Context, m model.LeagueMembership, userID int64, chips int64, ttl time.Duration) error
+	GetGroupStandings(ctx context.Context, m model.LeagueMembership) ([]model.LeagueStanding, error)
+	ListGroups(ctx context.Context, week string) ([]model.LeagueMembership, error)
+	StartRollover(ctx context.Context, week string, lockTTL time.Duration) (bool, error)
+	FinishRollover(ctx context.Context, week string, ttl time.Duration) error
+	AbortRollover(ctx context.Context, week string) error
+	RolloverDone(ctx context.Context, week string) (bool, error)
+}
+
+// AchievementRepository defines methods for storing unlocked achievements.
+type AchievementRepository interface {
+	Unlock(ctx context.Context, achievement model.UserAchievement) (bool, error)
+	ListUserAchievements(ctx context.Context, userID int64) ([]model.UserAchievement, error)
+}
+
+// AchievementEventProducer defines methods for publishing achievement events.
+type AchievementEventProducer interface {
+	PushAchievementUnlocked(ctx context.Context, achievement model.UserAchievement) error
+}
+
+// AchievementEvaluator unlocks achievements earned with a game.
+type AchievementEvaluator interface {
+	EvaluateGameResult(ctx context.Context, eventData model.GameResultEventData) error
+}
+
+// MissionRepository defines methods for storing mission progress.
+type MissionRepository interface {
+	AddProgress(ctx context.Context, periodKey string, userID int64, missionIDs []string, ttl time.Duration) error
+	GetProgress(ctx context.Context, periodKey string, userID int64) (model.MissionProgress, error)
+	MarkClaimed(ctx context.Context, periodKey string, userID int64, missionID string, ttl time.Duration) error
+}
+
+// RewardCreditor credits chips through user-service.
+type RewardCreditor interface {
+	CreditReward(ctx context.Context, userID, amount int64, reason string) (model.RewardCredit, error)
+}
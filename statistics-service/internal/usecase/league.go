@@ -0,0 +1,202 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"statistics/internal/model"
+	"time"
+)
+
+// leagueRolloverLock is how long an instance may take to close a week before another one retries.
+const leagueRolloverLock = 10 * time.Minute
+
+// LeagueUseCase runs weekly leagues: every player who finishes a game in a week joins a group
+// of their division, the winner's score grows by the chips won, and at the end of the week
+// the top of each group moves up a division and the bottom moves down.
+type LeagueUseCase struct {
+	repo  LeagueRepository
+	rules model.LeagueRules
+}
+
+func NewLeagueUseCase(repo LeagueRepository, rules model.LeagueRules) *LeagueUseCase {
+	return &LeagueUseCase{repo: repo, rules: rules}
+}
+
+// HandleGameResult puts both players into their groups of the game's week and credits the winner
+// with the chips won net of rake. It doesn't close the previous week itself: the rollover ticker
+// does, and until then players join in the division the previous week's standings give them.
+func (uc *LeagueUseCase) HandleGameResult(ctx context.Context, eventData model.GameResultEventData) error {
+	playedAt := eventData.CreatedAt
+	if playedAt.IsZero() {
+		playedAt = time.Now()
+	}
+	week := model.LeagueWeek(playedAt)
+	ttl := time.Until(model.LeagueWeekStart(playedAt).AddDate(0, 0, 7)) + uc.rules.Retention
+
+	for _, playerID := range []int64{eventData.Player1.PlayerID, eventData.Player2.PlayerID} {
+		if playerID == 0 {
+			continue
+		}
+		var chips int64
+		if playerID == eventData.WinnerID {
+			chips = eventData.Bet - eventData.Rake
+		}
+		if chips < 0 {
+			chips = 0
+		}
+		if err := uc.addScore(ctx, week, playerID, chips, ttl); err != nil {
+			return fmt.Errorf("failed to update league score of UserID %d: %w", playerID, err)
+		}
+	}
+	return nil
+}
+
+func (uc *LeagueUseCase) addScore(ctx context.Context, week string, userID, chips int64, ttl time.Duration) error {
+	m, ok, err := uc.repo.GetMembership(ctx, week, userID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		division, err := uc.joiningDivision(ctx, week, userID)
+		if err != nil {
+			return err
+		}
+		if m, err = uc.repo.JoinGroup(ctx, week, userID, division, uc.rules.GroupSize, ttl); err != nil {
+			return err
+		}
+		log.Printf("LeagueUseCase: UserID %d joined %s group %d for week %s", userID, uc.rules.DivisionName(m.Division), m.Group, week)
+	}
+	return uc.repo.AddScore(ctx, m, userID, chips, ttl)
+}
+
+// joiningDivision returns the division a player joins week in. Until the week before it is closed,
+// their stored division is still last week's, so it is moved by their zone in last week's group,
+// the same way the rollover will move it.
+func (uc *LeagueUseCase) joiningDivision(ctx context.Context, week string, userID int64) (int, error) {
+	division, err := uc.division(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	prevWeek := model.PreviousLeagueWeek(week)
+	closed, err := uc.repo.RolloverDone(ctx, prevWeek)
+	if err != nil || closed {
+		return division, err
+	}
+	m, ok, err := uc.repo.GetMembership(ctx, prevWeek, userID)
+	if err != nil || !ok {
+		return division, err
+	}
+	standings, err := uc.repo.GetGroupStandings(ctx, m)
+	if err != nil {
+		return 0, err
+	}
+	for _, s := range standings {
+		if s.UserID == userID {
+			return uc.rules.NextDivision(m.Division, s.Rank, len(standings), s.Score), nil
+		}
+	}
+	return m.Division, nil
+}
+
+// division returns the player's division, clamped in case divisions were removed from the config.
+func (uc *LeagueUseCase) division(ctx context.Context, userID int64) (int, error) {
+	division, err := uc.repo.GetDivision(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	if division >= len(uc.rules.Divisions) {
+		division = len(uc.rules.Divisions) - 1
+	}
+	if division < 0 {
+		division = 0
+	}
+	return division, nil
+}
+
+// GetUserLeague returns the player's group of the current week with its standings.
+func (uc *LeagueUseCase) GetUserLeague(ctx context.Context, userID int64) (model.LeagueGroup, error) {
+	now := time.Now()
+	week := model.LeagueWeek(now)
+	group := model.LeagueGroup{
+		Week:          week,
+		EndsAt:        model.LeagueWeekStart(now).AddDate(0, 0, 7),
+		PromoteCount:  uc.rules.PromoteCount,
+		RelegateCount: uc.rules.RelegateCount,
+	}
+
+	m, ok, err := uc.repo.GetMembership(ctx, week, userID)
+	if err != nil {
+		return model.LeagueGroup{}, fmt.Errorf("repository error for UserID %d: %w", userID, err)
+	}
+	if !ok {
+		if group.Division, err = uc.division(ctx, userID); err != nil {
+			return model.LeagueGroup{}, fmt.Errorf("repository error for UserID %d: %w", userID, err)
+		}
+		group.DivisionName = uc.rules.DivisionName(group.Division)
+		return group, nil
+	}
+
+	standings, err := uc.repo.GetGroupStandings(ctx, m)
+	if err != nil {
+		return model.LeagueGroup{}, fmt.Errorf("repository error for UserID %d: %w", userID, err)
+	}
+	for i := range standings {
+		standings[i].Zone = uc.rules.Zone(m.Division, standings[i].Rank, len(standings), standings[i].Score)
+	}
+	group.Division = m.Division
+	group.DivisionName = uc.rules.DivisionName(m.Division)
+	group.Group = m.Group
+	group.Joined = true
+	group.Standings = standings
+	return group, nil
+}
+
+// RollOver closes the week before now: the promotion zone of every group moves up a division and
+// the relegation zone moves down. Only one instance closes a week; later calls return at once,
+// with model.ErrLeagueRolloverRunning while it is not closed yet. A rollover that fails gives up
+// its claim, so the next call retries it.
+func (uc *LeagueUseCase) RollOver(ctx context.Context, now time.Time) error {
+	week := model.PreviousLeagueWeek(model.LeagueWeek(now))
+	started, err := uc.repo.StartRollover(ctx, week, leagueRolloverLock)
+	if err != nil || !started {
+		return err
+	}
+	if err := uc.closeWeek(ctx, week); err != nil {
+		if errAbort := uc.repo.AbortRollover(context.WithoutCancel(ctx), week); errAbort != nil {
+			log.Printf("LeagueUseCase: Failed to release the rollover claim of week %s: %v", week, errAbort)
+		}
+		return err
+	}
+	return nil
+}
+
+// closeWeek moves the players of week by their zones and marks the week as closed.
+func (uc *LeagueUseCase) closeWeek(ctx context.Context, week string) error {
+
+	groups, err := uc.repo.ListGroups(ctx, week)
+	if err != nil {
+		return fmt.Errorf("failed to list league groups of week %s: %w", week, err)
+	}
+	divisions := make(map[int64]int)
+	for _, g := range groups {
+		standings, err := uc.repo.GetGroupStandings(ctx, g)
+		if err != nil {
+			return fmt.Errorf("failed to get standings of week %s: %w", week, err)
+		}
+		for _, s := range standings {
+			if next := uc.rules.NextDivision(g.Division, s.Rank, len(standings), s.Score); next != g.Division {
+				divisions[s.UserID] = next
+			}
+		}
+	}
+	if err := uc.repo.SetDivisions(ctx, divisions); err != nil {
+		return fmt.Errorf("failed to move players of week %s: %w", week, err)
+	}
+	if err := uc.repo.FinishRollover(ctx, week, uc.rules.Retention); err != nil {
+		return err
+	}
+
+	log.Printf("LeagueUseCase: Week %s closed: %d groups, %d players changed division", week, len(groups), len(divisions))
+	return nil
+}
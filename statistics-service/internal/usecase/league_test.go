@@ -0,0 +1,246 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"statistics/internal/model"
+)
+
+// fakeLeagueRepo keeps leagues in memory: groups hold the chips won by each member.
+type fakeLeagueRepo struct {
+	divisions  map[int64]int
+	members    map[string]map[int64]model.LeagueMembership // week -> userID -> membership
+	groups     map[model.LeagueMembership]map[int64]int64
+	rollovers  map[string]string // week -> "running" or "done"
+	setDivErr  error
+	joinedWith map[int64]int // userID -> division passed to JoinGroup
+}
+
+func newFakeLeagueRepo() *fakeLeagueRepo {
+	return &fakeLeagueRepo{
+		divisions:  make(map[int64]int),
+		members:    make(map[string]map[int64]model.LeagueMembership),
+		groups:     make(map[model.LeagueMembership]map[int64]int64),
+		rollovers:  make(map[string]string),
+		joinedWith: make(map[int64]int),
+	}
+}
+
+// seed puts players with their scores into a group of week.
+func (r *fakeLeagueRepo) seed(m model.LeagueMembership, scores map[int64]int64) {
+	if r.members[m.Week] == nil {
+		r.members[m.Week] = make(map[int64]model.LeagueMembership)
+	}
+	if r.groups[m] == nil {
+		r.groups[m] = make(map[int64]int64)
+	}
+	for userID, score := range scores {
+		r.members[m.Week][userID] = m
+		r.groups[m][userID] = score
+	}
+}
+
+func (r *fakeLeagueRepo) GetDivision(_ context.Context, userID int64) (int, error) {
+	return r.divisions[userID], nil
+}
+
+func (r *fakeLeagueRepo) SetDivisions(_ context.Context, divisions map[int64]int) error {
+	if r.setDivErr != nil {
+		return r.setDivErr
+	}
+	for userID, d := range divisions {
+		r.divisions[userID] = d
+	}
+	return nil
+}
+
+func (r *fakeLeagueRepo) GetMembership(_ context.Context, week string, userID int64) (model.LeagueMembership, bool, error) {
+	m, ok := r.members[week][userID]
+	return m, ok, nil
+}
+
+func (r *fakeLeagueRepo) JoinGroup(_ context.Context, week string, userID int64, division, _ int, _ time.Duration) (model.LeagueMembership, error) {
+	r.joinedWith[userID] = division
+	m := model.LeagueMembership{Week: week, Division: division}
+	r.seed(m, map[int64]int64{userID: 0})
+	return m, nil
+}
+
+func (r *fakeLeagueRepo) AddScore(_ context.Context, m model.LeagueMembership, userID int64, chips int64, _ time.Duration) error {
+	r.groups[m][userID] += chips
+	return nil
+}
+
+func (r *fakeLeagueRepo) GetGroupStandings(_ context.Context, m model.LeagueMembership) ([]model.LeagueStanding, error) {
+	standings := make([]model.LeagueStanding, 0, len(r.groups[m]))
+	for userID, score := range r.groups[m] {
+		standings = append(standings, model.LeagueStanding{UserID: userID, Score: score})
+	}
+	sort.Slice(standings, func(i, j int) bool {
+		if standings[i].Score != standings[j].Score {
+			return standings[i].Score > standings[j].Score
+		}
+		return standings[i].UserID < standings[j].UserID
+	})
+	for i := range standings {
+		standings[i].Rank = i + 1
+	}
+	return standings, nil
+}
+
+func (r *fakeLeagueRepo) ListGroups(_ context.Context, week string) ([]model.LeagueMembership, error) {
+	var groups []model.LeagueMembership
+	for m := range r.groups {
+		if m.Week == week {
+			groups = append(groups, m)
+		}
+	}
+	return groups, nil
+}
+
+func (r *fakeLeagueRepo) StartRollover(_ context.Context, week string, _ time.Duration) (bool, error) {
+	switch r.rollovers[week] {
+	case "done":
+		return false, nil
+	case "running":
+		return false, model.ErrLeagueRolloverRunning
+	}
+	r.rollovers[week] = "running"
+	return true, nil
+}
+
+func (r *fakeLeagueRepo) FinishRollover(_ context.Context, week string, _ time.Duration) error {
+	r.rollovers[week] = "done"
+	return nil
+}
+
+func (r *fakeLeagueRepo) AbortRollover(_ context.Context, week string) error {
+	if r.rollovers[week] == "running" {
+		delete(r.rollovers, week)
+	}
+	return nil
+}
+
+func (r *fakeLeagueRepo) RolloverDone(_ context.Context, week string) (bool, error) {
+	return r.rollovers[week] == "done", nil
+}
+
+var testLeagueRules = model.LeagueRules{
+	Divisions:     []string{"bronze", "silver", "gold"},
+	GroupSize:     5,
+	PromoteCount:  1,
+	RelegateCount: 1,
+}
+
+func TestRollOverMovesPromotionAndRelegationZones(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 19, 0, 5, 0, 0, time.UTC)
+	week := model.LeagueWeek(now.AddDate(0, 0, -7))
+
+	repo := newFakeLeagueRepo()
+	repo.seed(model.LeagueMembership{Week: week, Division: 0}, map[int64]int64{1: 300, 2: 100, 3: 0})
+	repo.seed(model.LeagueMembership{Week: week, Division: 1}, map[int64]int64{4: 500, 5: 200, 6: 50, 7: 0})
+	repo.seed(model.LeagueMembership{Week: week, Division: 2}, map[int64]int64{8: 900, 9: 0})
+	for userID, d := range map[int64]int{4: 1, 5: 1, 6: 1, 7: 1, 8: 2, 9: 2} {
+		repo.divisions[userID] = d
+	}
+
+	uc := NewLeagueUseCase(repo, testLeagueRules)
+	if err := uc.RollOver(ctx, now); err != nil {
+		t.Fatalf("RollOver: %v", err)
+	}
+	want := map[int64]int{
+		1: 1, // top of bronze goes up
+		// 2 and 3 stay: nobody is relegated from the lowest division
+		4: 2, 5: 1, 6: 1,
+		7: 0, // bottom of silver goes down
+		8: 2, // nobody is promoted from the highest division
+		9: 1,
+	}
+	if !reflect.DeepEqual(repo.divisions, want) {
+		t.Errorf("divisions after rollover = %v, want %v", repo.divisions, want)
+	}
+	if repo.rollovers[week] != "done" {
+		t.Errorf("week %s rollover state = %q, want done", week, repo.rollovers[week])
+	}
+
+	// A closed week isn't closed again
+	repo.divisions = map[int64]int{}
+	if err := uc.RollOver(ctx, now); err != nil {
+		t.Fatalf("second RollOver: %v", err)
+	}
+	if len(repo.divisions) != 0 {
+		t.Errorf("second RollOver moved players again: %v", repo.divisions)
+	}
+}
+
+func TestRollOverReleasesTheClaimOnFailure(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 19, 0, 5, 0, 0, time.UTC)
+	week := model.LeagueWeek(now.AddDate(0, 0, -7))
+
+	repo := newFakeLeagueRepo()
+	repo.seed(model.LeagueMembership{Week: week, Division: 0}, map[int64]int64{1: 300, 2: 0})
+	repo.setDivErr = errors.New("redis is down")
+
+	uc := NewLeagueUseCase(repo, testLeagueRules)
+	if err := uc.RollOver(ctx, now); err == nil {
+		t.Fatal("RollOver succeeded with a failing repository")
+	}
+	if state, ok := repo.rollovers[week]; ok {
+		t.Fatalf("failed rollover left the claim %q", state)
+	}
+
+	repo.setDivErr = nil
+	if err := uc.RollOver(ctx, now); err != nil {
+		t.Fatalf("retried RollOver: %v", err)
+	}
+	if repo.divisions[1] != 1 {
+		t.Errorf("player 1 division = %d after the retry, want 1", repo.divisions[1])
+	}
+}
+
+func TestHandleGameResultJoinsByLastWeekBeforeRollover(t *testing.T) {
+	ctx := context.Background()
+	playedAt := time.Date(2026, time.October, 19, 0, 1, 0, 0, time.UTC)
+	prevWeek := model.LeagueWeek(playedAt.AddDate(0, 0, -7))
+
+	repo := newFakeLeagueRepo()
+	repo.seed(model.LeagueMembership{Week: prevWeek, Division: 1}, map[int64]int64{1: 400, 2: 100, 3: 0})
+	for _, userID := range []int64{1, 2, 3} {
+		repo.divisions[userID] = 1
+	}
+
+	uc := NewLeagueUseCase(repo, testLeagueRules)
+	game := model.GameResultEventData{
+		Player1:   model.PlayerGameResultData{PlayerID: 1},
+		Player2:   model.PlayerGameResultData{PlayerID: 3},
+		WinnerID:  1,
+		Bet:       100,
+		CreatedAt: playedAt,
+	}
+	if err := uc.HandleGameResult(ctx, game); err != nil {
+		t.Fatalf("HandleGameResult: %v", err)
+	}
+	// Last week isn't closed yet, so the players join in the division its standings give them
+	want := map[int64]int{1: 2, 3: 0}
+	if !reflect.DeepEqual(repo.joinedWith, want) {
+		t.Errorf("joined divisions = %v, want %v", repo.joinedWith, want)
+	}
+
+	// Once last week is closed the stored division is used as is
+	repo.rollovers[prevWeek] = "done"
+	game.Player1, game.Player2 = model.PlayerGameResultData{PlayerID: 2}, model.PlayerGameResultData{PlayerID: 4}
+	game.WinnerID = 2
+	if err := uc.HandleGameResult(ctx, game); err != nil {
+		t.Fatalf("HandleGameResult: %v", err)
+	}
+	if repo.joinedWith[2] != 1 || repo.joinedWith[4] != 0 {
+		t.Errorf("joined divisions after rollover = %v, want 2 in 1 and 4 in 0", repo.joinedWith)
+	}
+}
@@ -0,0 +1,224 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"slices"
+	"statistics/internal/model"
+	"strings"
+	"time"
+)
+
+// missionProgressRetention is how long progress is kept after its period ended.
+const missionProgressRetention = 24 * time.Hour
+
+// missionJSON is a mission as written in the mission pool file.
+type missionJSON struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Period      string          `json:"period"`
+	Target      int64           `json:"target"`
+	Reward      int64           `json:"reward"`
+	Conditions  []conditionJSON `json:"conditions"`
+}
+
+// ParseMissions reads and validates the mission pool from JSON.
+func ParseMissions(data []byte) ([]model.Mission, error) {
+	var raw []missionJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse missions: %w", err)
+	}
+
+	missions := make([]model.Mission, 0, len(raw))
+	seen := make(map[string]bool, len(raw))
+	for i, m := range raw {
+		if m.ID == "" || strings.Contains(m.ID, ":") {
+			return nil, fmt.Errorf("mission #%d has no id or an id with ':'", i+1)
+		}
+		if seen[m.ID] {
+			return nil, fmt.Errorf("mission %q is declared twice", m.ID)
+		}
+		seen[m.ID] = true
+		if !slices.Contains(model.MissionPeriods, m.Period) {
+			return nil, fmt.Errorf("mission %q: unknown period %q", m.ID, m.Period)
+		}
+		if m.Target <= 0 || m.Reward <= 0 {
+			return nil, fmt.Errorf("mission %q: target and reward must be positive", m.ID)
+		}
+		conditions, err := parseConditions(m.Conditions, model.GameMetrics)
+		if err != nil {
+			return nil, fmt.Errorf("mission %q: %w", m.ID, err)
+		}
+		missions = append(missions, model.Mission{
+			ID:          m.ID,
+			Name:        m.Name,
+			Description: m.Description,
+			Period:      m.Period,
+			Conditions:  conditions,
+			Target:      m.Target,
+			Reward:      m.Reward,
+		})
+	}
+	return missions, nil
+}
+
+// MissionUseCase runs daily and weekly missions. Every period a few missions of the pool rotate in,
+// the same for all players; games count towards them, and a completed mission's reward is credited
+// through user-service once.
+type MissionUseCase struct {
+	repo     MissionRepository
+	rewards  RewardCreditor
+	missions []model.Mission
+	rules    model.MissionRules
+}
+
+func NewMissionUseCase(repo MissionRepository, rewards RewardCreditor, missions []model.Mission, rules model.MissionRules) *MissionUseCase {
+	return &MissionUseCase{
+		repo:     repo,
+		rewards:  rewards,
+		missions: missions,
+		rules:    rules,
+	}
+}
+
+// activeMissions picks the missions of the period containing t. The pick depends only on the
+// period, so every instance and every player sees the same missions.
+func (uc *MissionUseCase) activeMissions(period string, t time.Time) []model.Mission {
+	var pool []model.Mission
+	for _, m := range uc.missions {
+		if m.Period == period {
+			pool = append(pool, m)
+		}
+	}
+	count := uc.rules.Count(period)
+	if count <= 0 || count >= len(pool) {
+		return pool
+	}
+
+	periodKey := model.MissionPeriodKey(period, t)
+	order := make([]int, len(pool))
+	weights := make([]uint32, len(pool))
+	for i, m := range pool {
+		h := fnv.New32a()
+		h.Write([]byte(periodKey + ":" + m.ID))
+		order[i], weights[i] = i, h.Sum32()
+	}
+	slices.SortFunc(order, func(a, b int) int { return cmp.Compare(weights[a], weights[b]) })
+	picked := order[:count]
+	slices.Sort(picked)
+
+	active := make([]model.Mission, 0, count)
+	for _, i := range picked {
+		active = append(active, pool[i])
+	}
+	return active
+}
+
+// HandleGameResult counts the game towards the active missions of both players.
+func (uc *MissionUseCase) HandleGameResult(ctx context.Context, eventData model.GameResultEventData) error {
+	playedAt := eventData.CreatedAt
+	if playedAt.IsZero() {
+		playedAt = time.Now()
+	}
+
+	for _, player := range []model.PlayerGameResultData{eventData.Player1, eventData.Player2} {
+		if player.PlayerID == 0 {
+			continue
+		}
+		metrics := gameMetrics(eventData, player)
+		for _, period := range model.MissionPeriods {
+			var counted []string
+			for _, m := range uc.activeMissions(period, playedAt) {
+				if m.Counts(metrics) {
+					counted = append(counted, m.ID)
+				}
+			}
+			periodKey := model.MissionPeriodKey(period, playedAt)
+			ttl := time.Until(model.MissionPeriodEnd(period, playedAt)) + missionProgressRetention
+			if err := uc.repo.AddProgress(ctx, periodKey, player.PlayerID, counted, ttl); err != nil {
+				return fmt.Errorf("failed to update %s missions of UserID %d: %w", period, player.PlayerID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// GetUserMissions returns the active daily and weekly missions with the player's progress.
+func (uc *MissionUseCase) GetUserMissions(ctx context.Context, userID int64) ([]model.UserMission, error) {
+	now := time.Now()
+	var missions []model.UserMission
+	for _, period := range model.MissionPeriods {
+		periodKey := model.MissionPeriodKey(period, now)
+		progress, err := uc.repo.GetProgress(ctx, periodKey, userID)
+		if err != nil {
+			return nil, fmt.Errorf("repository error for UserID %d: %w", userID, err)
+		}
+		for _, m := range uc.activeMissions(period, now) {
+			missions = append(missions, userMission(m, periodKey, now, progress))
+		}
+	}
+	return missions, nil
+}
+
+func userMission(m model.Mission, periodKey string, now time.Time, progress model.MissionProgress) model.UserMission {
+	um := model.UserMission{
+		Mission:   m,
+		PeriodKey: periodKey,
+		Progress:  min(progress.Progress[m.ID], m.Target),
+		Claimed:   progress.Claimed[m.ID],
+		EndsAt:    model.MissionPeriodEnd(m.Period, now),
+	}
+	um.Completed = um.Progress >= m.Target
+	return um
+}
+
+// ClaimMissionReward credits the reward of a completed active mission. The reward's reason code
+// names the mission and its period, so user-service credits it once even if a claim is retried.
+func (uc *MissionUseCase) ClaimMissionReward(ctx context.Context, userID int64, missionID string) (model.MissionClaim, error) {
+	now := time.Now()
+	for _, period := range model.MissionPeriods {
+		for _, m := range uc.activeMissions(period, now) {
+			if m.ID == missionID {
+				return uc.claim(ctx, userID, m, now)
+			}
+		}
+	}
+	return model.MissionClaim{}, fmt.Errorf("mission %q is not active: %w", missionID, model.ErrNotFound)
+}
+
+func (uc *MissionUseCase) claim(ctx context.Context, userID int64, m model.Mission, now time.Time) (model.MissionClaim, error) {
+	periodKey := model.MissionPeriodKey(m.Period, now)
+	progress, err := uc.repo.GetProgress(ctx, periodKey, userID)
+	if err != nil {
+		return model.MissionClaim{}, fmt.Errorf("repository error for UserID %d: %w", userID, err)
+	}
+	um := userMission(m, periodKey, now, progress)
+	if um.Claimed {
+		return model.MissionClaim{}, model.ErrMissionAlreadyClaimed
+	}
+	if !um.Completed {
+		return model.MissionClaim{}, model.ErrMissionNotCompleted
+	}
+
+	reason := fmt.Sprintf("mission:%s:%s:%s", m.Period, periodKey, m.ID)
+	credit, err := uc.rewards.CreditReward(ctx, userID, m.Reward, reason)
+	if err != nil {
+		return model.MissionClaim{}, fmt.Errorf("failed to credit reward %s to UserID %d: %w", reason, userID, err)
+	}
+	ttl := time.Until(um.EndsAt) + missionProgressRetention
+	if err := uc.repo.MarkClaimed(ctx, periodKey, userID, m.ID, ttl); err != nil {
+		log.Printf("MissionUseCase: Warning - Failed to mark %s claimed for UserID %d: %v", reason, userID, err)
+	}
+	if credit.AlreadyCredited {
+		return model.MissionClaim{}, model.ErrMissionAlreadyClaimed
+	}
+
+	log.Printf("MissionUseCase: UserID %d claimed %d chips for %s", userID, m.Reward, reason)
+	um.Claimed = true
+	return model.MissionClaim{Mission: um, Amount: m.Reward, Balance: credit.Balance}, nil
+}
@@ -0,0 +1,247 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"statistics/internal/model"
+)
+
+// fakeMissionRepo keeps mission progress in memory, keyed by "<periodKey>:<userID>".
+type fakeMissionRepo struct {
+	progress   map[string]model.MissionProgress
+	failMarked bool
+}
+
+func newFakeMissionRepo() *fakeMissionRepo {
+	return &fakeMissionRepo{progress: make(map[string]model.MissionProgress)}
+}
+
+func (r *fakeMissionRepo) get(periodKey string, userID int64) model.MissionProgress {
+	key := fmt.Sprintf("%s:%d", periodKey, userID)
+	p, ok := r.progress[key]
+	if !ok {
+		p = model.MissionProgress{Progress: make(map[string]int64), Claimed: make(map[string]bool)}
+		r.progress[key] = p
+	}
+	return p
+}
+
+func (r *fakeMissionRepo) AddProgress(_ context.Context, periodKey string, userID int64, missionIDs []string, _ time.Duration) error {
+	p := r.get(periodKey, userID)
+	for _, id := range missionIDs {
+		p.Progress[id]++
+	}
+	return nil
+}
+
+func (r *fakeMissionRepo) GetProgress(_ context.Context, periodKey string, userID int64) (model.MissionProgress, error) {
+	return r.get(periodKey, userID), nil
+}
+
+func (r *fakeMissionRepo) MarkClaimed(_ context.Context, periodKey string, userID int64, missionID string, _ time.Duration) error {
+	if r.failMarked {
+		return errors.New("redis is down")
+	}
+	r.get(periodKey, userID).Claimed[missionID] = true
+	return nil
+}
+
+// fakeRewardCreditor credits each reason once, like user-service's idempotent rewards.
+type fakeRewardCreditor struct {
+	balance  int64
+	credited map[string]bool
+}
+
+func (c *fakeRewardCreditor) CreditReward(_ context.Context, _ int64, amount int64, reason string) (model.RewardCredit, error) {
+	if c.credited[reason] {
+		return model.RewardCredit{Balance: c.balance, AlreadyCredited: true}, nil
+	}
+	c.credited[reason] = true
+	c.balance += amount
+	return model.RewardCredit{Balance: c.balance}, nil
+}
+
+func TestParseMissions(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    []model.Mission
+		wantErr bool
+	}{
+		{
+			name: "valid missions",
+			in: `[
+				{"id": "play_3", "name": "Play 3 games", "period": "daily", "target": 3, "reward": 100},
+				{"id": "win_ranked", "period": "weekly", "target": 5, "reward": 500,
+				 "conditions": [{"metric": "won", "op": "==", "value": 1}, {"metric": "ranked", "op": "==", "value": 1}]}
+			]`,
+			want: []model.Mission{
+				{ID: "play_3", Name: "Play 3 games", Period: "daily", Conditions: []model.MetricCondition{}, Target: 3, Reward: 100},
+				{ID: "win_ranked", Period: "weekly", Target: 5, Reward: 500, Conditions: []model.MetricCondition{
+					{Metric: "won", Op: "==", Value: 1}, {Metric: "ranked", Op: "==", Value: 1},
+				}},
+			},
+		},
+		{name: "not json", in: `[{]`, wantErr: true},
+		{name: "missing id", in: `[{"period": "daily", "target": 1, "reward": 1}]`, wantErr: true},
+		{name: "id with a colon", in: `[{"id": "a:b", "period": "daily", "target": 1, "reward": 1}]`, wantErr: true},
+		{
+			name: "duplicate id",
+			in: `[{"id": "a", "period": "daily", "target": 1, "reward": 1},
+				{"id": "a", "period": "weekly", "target": 1, "reward": 1}]`,
+			wantErr: true,
+		},
+		{name: "unknown period", in: `[{"id": "a", "period": "monthly", "target": 1, "reward": 1}]`, wantErr: true},
+		{name: "zero target", in: `[{"id": "a", "period": "daily", "target": 0, "reward": 1}]`, wantErr: true},
+		{name: "negative reward", in: `[{"id": "a", "period": "daily", "target": 1, "reward": -5}]`, wantErr: true},
+		{
+			// Missions count single games, so totals such as games_won can't be used
+			name:    "stats metric",
+			in:      `[{"id": "a", "period": "daily", "target": 1, "reward": 1, "conditions": [{"metric": "games_won", "op": ">", "value": 1}]}]`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMissions([]byte(tt.in))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseMissions = %+v, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseMissions: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseMissions:\n got %+v\nwant %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// missionPool returns n daily missions "d1".."dn" and one weekly mission "w1".
+func missionPool(n int) []model.Mission {
+	var pool []model.Mission
+	for i := 1; i <= n; i++ {
+		pool = append(pool, model.Mission{ID: fmt.Sprintf("d%d", i), Period: model.MissionPeriodDaily, Target: 1, Reward: 10})
+	}
+	return append(pool, model.Mission{ID: "w1", Period: model.MissionPeriodWeekly, Target: 1, Reward: 100})
+}
+
+func missionIDs(missions []model.Mission) []string {
+	ids := make([]string, 0, len(missions))
+	for _, m := range missions {
+		ids = append(ids, m.ID)
+	}
+	return ids
+}
+
+func TestActiveMissionsAreTheSameOnEveryInstance(t *testing.T) {
+	rules := model.MissionRules{DailyCount: 3}
+	day := time.Date(2026, time.October, 19, 8, 0, 0, 0, time.UTC)
+
+	// Two instances with the pool in a different order pick the same missions of a day
+	a := NewMissionUseCase(nil, nil, missionPool(10), rules)
+	reversed := missionPool(10)
+	for i, j := 0, len(reversed)-1; i < j; i, j = i+1, j-1 {
+		reversed[i], reversed[j] = reversed[j], reversed[i]
+	}
+	b := NewMissionUseCase(nil, nil, reversed, rules)
+
+	picked := missionIDs(a.activeMissions(model.MissionPeriodDaily, day))
+	if len(picked) != 3 {
+		t.Fatalf("picked %v, want 3 missions", picked)
+	}
+	if got := missionIDs(a.activeMissions(model.MissionPeriodDaily, day.Add(15*time.Hour))); !reflect.DeepEqual(got, picked) {
+		t.Errorf("later the same day picked %v, want %v", got, picked)
+	}
+	got := missionIDs(b.activeMissions(model.MissionPeriodDaily, day))
+	if !sameIDs(got, picked) {
+		t.Errorf("other instance picked %v, want %v", got, picked)
+	}
+
+	// The pick rotates: over a few days not every day has the same missions
+	rotated := false
+	for d := 1; d <= 7 && !rotated; d++ {
+		other := missionIDs(a.activeMissions(model.MissionPeriodDaily, day.AddDate(0, 0, d)))
+		rotated = !reflect.DeepEqual(other, picked)
+	}
+	if !rotated {
+		t.Errorf("the same missions %v were active for a week", picked)
+	}
+
+	// A count of 0 or above the pool size keeps the whole pool of the period
+	if got := missionIDs(a.activeMissions(model.MissionPeriodWeekly, day)); !reflect.DeepEqual(got, []string{"w1"}) {
+		t.Errorf("weekly missions = %v, want [w1]", got)
+	}
+}
+
+func sameIDs(a, b []string) bool {
+	seen := make(map[string]bool, len(a))
+	for _, id := range a {
+		seen[id] = true
+	}
+	for _, id := range b {
+		if !seen[id] {
+			return false
+		}
+	}
+	return len(a) == len(b)
+}
+
+func TestClaimCreditsAMissionOnce(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	mission := model.Mission{ID: "play_2", Period: model.MissionPeriodDaily, Target: 2, Reward: 50}
+	periodKey := model.MissionPeriodKey(mission.Period, now)
+
+	tests := []struct {
+		name        string
+		games       int
+		failMarked  bool // the first claim credits the reward but can't store the claimed flag
+		wantFirst   error
+		wantBalance int64
+	}{
+		{name: "not completed", games: 1, wantFirst: model.ErrMissionNotCompleted, wantBalance: 1000},
+		{name: "claimed twice", games: 3, wantBalance: 1050},
+		{name: "claimed twice, flag lost", games: 2, failMarked: true, wantBalance: 1050},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeMissionRepo()
+			rewards := &fakeRewardCreditor{balance: 1000, credited: make(map[string]bool)}
+			uc := NewMissionUseCase(repo, rewards, []model.Mission{mission}, model.MissionRules{})
+			for i := 0; i < tt.games; i++ {
+				_ = repo.AddProgress(ctx, periodKey, 7, []string{mission.ID}, time.Hour)
+			}
+
+			repo.failMarked = tt.failMarked
+			first, err := uc.claim(ctx, 7, mission, now)
+			if !errors.Is(err, tt.wantFirst) {
+				t.Fatalf("first claim: err = %v, want %v", err, tt.wantFirst)
+			}
+			if err == nil && (first.Amount != 50 || first.Balance != 1050 || !first.Mission.Claimed) {
+				t.Errorf("first claim = %+v, want 50 chips, balance 1050, claimed", first)
+			}
+
+			repo.failMarked = false
+			if _, err := uc.claim(ctx, 7, mission, now); err == nil {
+				t.Error("second claim succeeded")
+			} else if tt.wantFirst == nil && !errors.Is(err, model.ErrMissionAlreadyClaimed) {
+				t.Errorf("second claim: err = %v, want %v", err, model.ErrMissionAlreadyClaimed)
+			}
+			if rewards.balance != tt.wantBalance {
+				t.Errorf("balance = %d, want %d", rewards.balance, tt.wantBalance)
+			}
+			if claimed := repo.get(periodKey, 7).Claimed[mission.ID]; claimed != (tt.wantFirst == nil) {
+				t.Errorf("mission claimed flag = %v, want %v", claimed, tt.wantFirst == nil)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ type StatisticsUseCase struct {
 	repo            StatisticsRepository
 	redis           StatisticsRedisCache
 	gameHistoryRepo GameHistoryRepository
+	achievements    AchievementEvaluator
 	cacheTTL        time.Duration
 }
 
@@ -19,11 +20,13 @@ func NewStatisticsUseCase(
 	repo StatisticsRepository,
 	redisCache StatisticsRedisCache,
 	ghr GameHistoryRepository,
+	achievements AchievementEvaluator,
 ) *StatisticsUseCase {
 	return &StatisticsUseCase{
 		repo:            repo,
 		redis:           redisCache,
 		gameHistoryRepo: ghr,
+		achievements:    achievements,
 		cacheTTL:        15 * time.Minute,
 	}
 }
@@ -94,7 +97,12 @@ func (uc *StatisticsUseCase) HandleGameResult(ctx context.Context, eventData mod
 		log.Printf("StatisticsUseCase: Failed to insert game history for RoomID %s: %v", eventData.RoomID, err)
 	}
 
-	// 3. Invalidate/clear relevant caches
+	// 3. Unlock achievements earned with the game; they depend on the stats updated above
+	if err := uc.achievements.EvaluateGameResult(ctx, eventData); err != nil {
+		log.Printf("StatisticsUseCase: Failed to evaluate achievements for RoomID %s: %v", eventData.RoomID, err)
+	}
+
+	// 4. Invalidate/clear relevant caches
 	if err := uc.redis.DeleteGeneralGameStats(ctx); err != nil {
 		log.Printf("StatisticsUseCase: Warning - Failed to delete general stats cache: %v", err)
 	}
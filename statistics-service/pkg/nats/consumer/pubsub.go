@@ -21,6 +21,9 @@ type PubSub struct {
 
 type PubSubSubscriptionConfig struct {
 	Subject string
+	// Queue is the queue group of the subscription: replicas in the same group split the messages.
+	// Empty means every replica gets every message.
+	Queue   string
 	Handler natscl.MsgHandler
 }
 
@@ -66,7 +69,7 @@ func (c *PubSub) Stop() {
 }
 
 func (c *PubSub) consume(cfg PubSubSubscriptionConfig) error {
-	sub, err := c.client.Subscribe(cfg.Subject, cfg.Handler)
+	sub, err := c.client.Subscribe(cfg.Subject, cfg.Queue, cfg.Handler)
 	if err != nil {
 		return fmt.Errorf("c.user.Subscribe: %w", err)
 	}
@@ -30,8 +30,10 @@ func NewClient(ctx context.Context, hosts []string, nkey string, isTest bool) (*
 	}, nil
 }
 
-func (c *Client) Subscribe(subject string, handler MsgHandler) (*nats.Subscription, error) {
-	sub, err := c.Conn.Subscribe(subject, func(msg *nats.Msg) {
+// Subscribe delivers every message of subject to handler. With a non-empty queue, replicas that
+// subscribe with the same queue share the messages, so each one is handled by a single replica.
+func (c *Client) Subscribe(subject, queue string, handler MsgHandler) (*nats.Subscription, error) {
+	sub, err := c.Conn.QueueSubscribe(subject, queue, func(msg *nats.Msg) {
 		ctx, cancel := context.WithTimeout(context.Background(), nats.DefaultTimeout)
 		defer cancel()
 
@@ -13,6 +13,10 @@ type (
 		Nats     Nats
 		Redis    Redis
 		Cache    Cache
+		Holds    Holds
+		Rewards  Rewards
+		Transfer Transfer
+		Gaming   Gaming
 
 		Version string `env:"VERSION"`
 	}
@@ -33,6 +37,13 @@ type (
 		Hosts  []string `env:"NATS_HOSTS,notEmpty" envSeparator:"," envDefault:"localhost:4222"`
 		NKey   string   `env:"NATS_NKEY,notEmpty"`
 		IsTest bool     `env:"NATS_IS_TEST,notEmpty" envDefault:"true"`
+
+		NatsSubjects NatsSubjects
+	}
+
+	// NatsSubjects the service publishes to
+	NatsSubjects struct {
+		ChipTransferSubject string `env:"NATS_CHIP_TRANSFER_SUBJECT" envDefault:"user.events.chip_transfer"`
 	}
 
 	// Redis configuration for main application
@@ -50,6 +61,34 @@ type (
 
 		CMSVariableRefreshTime time.Duration `env:"CLIENT_REFRESH_TIME" envDefault:"1m"`
 	}
+
+	// Holds configures chips reserved for running games
+	Holds struct {
+		DefaultTTL    time.Duration `env:"HOLD_DEFAULT_TTL" envDefault:"30m"`
+		SweepInterval time.Duration `env:"HOLD_SWEEP_INTERVAL" envDefault:"1m"`
+	}
+
+	// Rewards configures the daily bonus and the refill for players who ran out of chips
+	Rewards struct {
+		DailyBonus             int64         `env:"REWARD_DAILY_BONUS" envDefault:"500"`
+		DailyStreakMultipliers []float64     `env:"REWARD_DAILY_STREAK_MULTIPLIERS" envSeparator:"," envDefault:"1,1.2,1.5,2,2.5,3,4"`
+		RefillThreshold        int64         `env:"REWARD_REFILL_THRESHOLD" envDefault:"100"`
+		RefillTo               int64         `env:"REWARD_REFILL_TO" envDefault:"1000"`
+		RefillCooldown         time.Duration `env:"REWARD_REFILL_COOLDOWN" envDefault:"4h"`
+	}
+
+	// Transfer limits chip gifting between players
+	Transfer struct {
+		DailySendCap    int64         `env:"TRANSFER_DAILY_SEND_CAP" envDefault:"5000"`
+		DailyReceiveCap int64         `env:"TRANSFER_DAILY_RECEIVE_CAP" envDefault:"10000"`
+		MinAccountAge   time.Duration `env:"TRANSFER_MIN_ACCOUNT_AGE" envDefault:"72h"`
+	}
+
+	// Gaming configures responsible gaming controls
+	Gaming struct {
+		CoolingOff       time.Duration `env:"GAMING_LIMIT_COOLING_OFF" envDefault:"24h"`
+		MaxSelfExclusion time.Duration `env:"GAMING_MAX_SELF_EXCLUSION" envDefault:"43800h"`
+	}
 )
 
 func New() (*Config, error) {
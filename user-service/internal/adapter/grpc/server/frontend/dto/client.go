@@ -53,3 +53,130 @@ func ToUserUpdateDataFromUpdateProfileRequest(req *usersvc.UpdateProfileRequest)
 
 	return update
 }
+
+func FromModelToProtoChipHold(h model.ChipHold) *usersvc.ChipHold {
+	return &usersvc.ChipHold{
+		Id:        h.ID,
+		UserId:    h.UserID,
+		Amount:    h.Amount,
+		Reference: h.Reference,
+		Status:    h.Status,
+		ExpiresAt: timestamppb.New(h.ExpiresAt),
+	}
+}
+
+func ToLedgerFilterFromGetTransactionsRequest(req *usersvc.GetTransactionsRequest) model.LedgerFilter {
+	return model.LedgerFilter{
+		AccountID: req.UserId,
+		Limit:     req.Limit,
+		Offset:    req.Offset,
+	}
+}
+
+func FromModelToGetTransactionsResponse(page model.LedgerPage) *usersvc.GetTransactionsResponse {
+	entries := make([]*usersvc.LedgerEntry, 0, len(page.Entries))
+	for _, e := range page.Entries {
+		entry := &usersvc.LedgerEntry{
+			Id:            e.ID,
+			TransactionId: e.TransactionID,
+			Kind:          e.Kind,
+			Amount:        e.Amount,
+			Reference:     e.Reference,
+			CreatedAt:     timestamppb.New(e.CreatedAt),
+		}
+		if e.BalanceAfter != nil {
+			entry.BalanceAfter = *e.BalanceAfter
+		}
+		entries = append(entries, entry)
+	}
+
+	return &usersvc.GetTransactionsResponse{
+		Entries: entries,
+		Total:   page.Total,
+	}
+}
+
+func ToSettlementFromSettleMatchRequest(req *usersvc.SettleMatchRequest) model.Settlement {
+	deltas := make([]model.PlayerDelta, 0, len(req.Deltas))
+	for _, d := range req.Deltas {
+		deltas = append(deltas, model.PlayerDelta{
+			UserID: d.UserId,
+			Delta:  d.Delta,
+		})
+	}
+	return model.Settlement{
+		GameID:              req.GameId,
+		Deltas:              deltas,
+		Rake:                req.Rake,
+		JackpotContribution: req.JackpotContribution,
+		JackpotWinnerID:     req.JackpotWinnerId,
+	}
+}
+
+func FromModelToSettleMatchResponse(result model.SettlementResult) *usersvc.SettleMatchResponse {
+	balances := make([]*usersvc.PlayerBalance, 0, len(result.Balances))
+	for _, b := range result.Balances {
+		balances = append(balances, &usersvc.PlayerBalance{
+			UserId:  b.UserID,
+			Balance: b.Balance,
+		})
+	}
+	return &usersvc.SettleMatchResponse{
+		Balances:       balances,
+		AlreadySettled: result.AlreadySettled,
+		JackpotPayout:  result.JackpotPayout,
+	}
+}
+
+func FromModelToRewardClaimResponse(claim model.RewardClaim) *usersvc.RewardClaimResponse {
+	return &usersvc.RewardClaimResponse{
+		Amount:      claim.Amount,
+		Balance:     claim.Balance,
+		DailyStreak: claim.DailyStreak,
+	}
+}
+
+func FromModelToCreditRewardResponse(credit model.RewardCredit) *usersvc.CreditRewardResponse {
+	return &usersvc.CreditRewardResponse{
+		Balance:         credit.Balance,
+		AlreadyCredited: credit.AlreadyCredited,
+	}
+}
+
+func ToTransferFromTransferChipsRequest(req *usersvc.TransferChipsRequest) model.Transfer {
+	return model.Transfer{
+		FromUserID:     req.FromUserId,
+		ToUserID:       req.ToUserId,
+		Amount:         req.Amount,
+		IdempotencyKey: req.IdempotencyKey,
+	}
+}
+
+func FromModelToTransferChipsResponse(result model.TransferResult) *usersvc.TransferChipsResponse {
+	return &usersvc.TransferChipsResponse{
+		Balance:        result.FromBalance,
+		AlreadyApplied: result.AlreadyApplied,
+	}
+}
+
+func FromModelToResponsibleGamingResponse(settings model.ResponsibleGaming) *usersvc.ResponsibleGamingResponse {
+	resp := &usersvc.ResponsibleGamingResponse{
+		Limits: make([]*usersvc.GamingLimit, 0, len(settings.Limits)),
+	}
+	for _, l := range settings.Limits {
+		limit := &usersvc.GamingLimit{
+			Kind:  l.Kind,
+			Value: l.Value,
+		}
+		if l.PendingValue != nil && l.PendingFrom != nil {
+			limit.HasPending = true
+			limit.PendingValue = *l.PendingValue
+			limit.PendingFrom = timestamppb.New(*l.PendingFrom)
+		}
+		resp.Limits = append(resp.Limits, limit)
+	}
+	if settings.SelfExcludedUntil != nil {
+		resp.SelfExcludedUntil = timestamppb.New(*settings.SelfExcludedUntil)
+	}
+	return resp
+}
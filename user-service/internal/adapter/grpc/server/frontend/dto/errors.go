@@ -12,6 +12,17 @@ var (
 	ErrUserNotFound           = status.Error(codes.NotFound, "user not found")
 	ErrInvalidInput           = status.Error(codes.InvalidArgument, "invalid input data")
 	ErrNotEnoughBalance       = status.Error(codes.FailedPrecondition, "not enough balance")
+	ErrHoldNotFound           = status.Error(codes.NotFound, "hold not found")
+	ErrHoldExpired            = status.Error(codes.FailedPrecondition, "hold expired")
+	ErrChipsHeld              = status.Error(codes.FailedPrecondition, "chips are held in an active game")
+	ErrAlreadyClaimed         = status.Error(codes.FailedPrecondition, "reward already claimed today")
+	ErrRefillNotAvailable     = status.Error(codes.FailedPrecondition, "balance is above the refill threshold")
+	ErrRefillCooldown         = status.Error(codes.ResourceExhausted, "refill is on cooldown")
+	ErrTransferLimitExceeded  = status.Error(codes.ResourceExhausted, "daily transfer limit exceeded")
+	ErrAccountTooNew          = status.Error(codes.FailedPrecondition, "account is too new to transfer chips")
+	ErrSelfExcluded           = status.Error(codes.PermissionDenied, "player is self-excluded")
+	ErrLossLimitReached       = status.Error(codes.FailedPrecondition, "loss limit reached")
+	ErrWagerLimitReached      = status.Error(codes.FailedPrecondition, "wager limit reached")
 
 	ErrConflict = status.Error(codes.AlreadyExists, "conflict")
 )
@@ -28,6 +39,28 @@ func FromError(err error) error {
 		return ErrNotEnoughBalance
 	case errors.Is(err, model.ErrConflict):
 		return ErrConflict
+	case errors.Is(err, model.ErrHoldNotFound):
+		return ErrHoldNotFound
+	case errors.Is(err, model.ErrHoldExpired):
+		return ErrHoldExpired
+	case errors.Is(err, model.ErrChipsHeld):
+		return ErrChipsHeld
+	case errors.Is(err, model.ErrAlreadyClaimed):
+		return ErrAlreadyClaimed
+	case errors.Is(err, model.ErrRefillNotAvailable):
+		return ErrRefillNotAvailable
+	case errors.Is(err, model.ErrRefillCooldown):
+		return ErrRefillCooldown
+	case errors.Is(err, model.ErrTransferLimitExceeded):
+		return ErrTransferLimitExceeded
+	case errors.Is(err, model.ErrAccountTooNew):
+		return ErrAccountTooNew
+	case errors.Is(err, model.ErrSelfExcluded):
+		return ErrSelfExcluded
+	case errors.Is(err, model.ErrLossLimitReached):
+		return ErrLossLimitReached
+	case errors.Is(err, model.ErrWagerLimitReached):
+		return ErrWagerLimitReached
 
 	default:
 		return status.Error(codes.Internal, "something went wrong")
@@ -2,6 +2,7 @@ package frontend
 
 import (
 	"context"
+	"time"
 	"user_svc/internal/model"
 )
 
@@ -10,10 +11,24 @@ type UserUsecase interface {
 	UpdateUsername(ctx context.Context, updateData model.UserUpdateData) error
 	RecordUser(ctx context.Context, request model.User) error
 	GetBalance(ctx context.Context, userID int64) (int64, error)
-	AddBalance(ctx context.Context, userID int64, delta int64) error
-	SubtractBalance(ctx context.Context, userID int64, delta int64) error
+	AddBalance(ctx context.Context, userID int64, delta int64, idempotencyKey string) error
+	SubtractBalance(ctx context.Context, userID int64, delta int64, idempotencyKey string) error
 	GetRating(ctx context.Context, userID int64) (int64, error)
 	UpdateRating(ctx context.Context, userID int64, newRating int64) error
 	GetProfile(ctx context.Context, userID int64) (model.User, error)
 	UpdateProfile(ctx context.Context, update model.UserUpdateData) error
+	GetHeldBalance(ctx context.Context, userID int64) (int64, error)
+	PlaceHold(ctx context.Context, userID int64, amount int64, reference string, ttl time.Duration) (model.ChipHold, error)
+	ReleaseHold(ctx context.Context, userID int64, reference string) error
+	CaptureHold(ctx context.Context, userID int64, reference string, beneficiaryID int64) (model.CaptureResult, error)
+	GetTransactions(ctx context.Context, filter model.LedgerFilter) (model.LedgerPage, error)
+	SettleMatch(ctx context.Context, settlement model.Settlement) (model.SettlementResult, error)
+	ClaimDailyBonus(ctx context.Context, userID int64) (model.RewardClaim, error)
+	ClaimRefill(ctx context.Context, userID int64) (model.RewardClaim, error)
+	CreditReward(ctx context.Context, userID, amount int64, reason string) (model.RewardCredit, error)
+	TransferChips(ctx context.Context, transfer model.Transfer) (model.TransferResult, error)
+	GetResponsibleGaming(ctx context.Context, userID int64) (model.ResponsibleGaming, error)
+	SetGamingLimit(ctx context.Context, userID int64, kind string, value int64) (model.ResponsibleGaming, error)
+	SelfExclude(ctx context.Context, userID int64, duration time.Duration) (model.ResponsibleGaming, error)
+	CheckPlayAllowed(ctx context.Context, userID int64, amount int64) error
 }
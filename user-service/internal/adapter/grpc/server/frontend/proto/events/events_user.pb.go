@@ -2,7 +2,7 @@
 // versions:
 // 	protoc-gen-go v1.36.6
 // 	protoc        v5.29.3
-// source: events_game.proto
+// source: events_user.proto
 
 package events
 
@@ -298,6 +298,94 @@ func (x *EmailSendRequest) GetBody() string {
 	return ""
 }
 
+type ChipTransfer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user the notification is for
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// the other side of the transfer
+	CounterpartyId int64 `protobuf:"varint,2,opt,name=counterparty_id,json=counterpartyId,proto3" json:"counterparty_id,omitempty"`
+	// sent or received
+	Direction string `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	Amount    int64  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// balance of user_id after the transfer
+	Balance       int64                  `protobuf:"varint,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChipTransfer) Reset() {
+	*x = ChipTransfer{}
+	mi := &file_events_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChipTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChipTransfer) ProtoMessage() {}
+
+func (x *ChipTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_events_user_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChipTransfer.ProtoReflect.Descriptor instead.
+func (*ChipTransfer) Descriptor() ([]byte, []int) {
+	return file_events_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ChipTransfer) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ChipTransfer) GetCounterpartyId() int64 {
+	if x != nil {
+		return x.CounterpartyId
+	}
+	return 0
+}
+
+func (x *ChipTransfer) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *ChipTransfer) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ChipTransfer) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *ChipTransfer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
 var File_events_user_proto protoreflect.FileDescriptor
 
 const file_events_user_proto_rawDesc = "" +
@@ -323,7 +411,15 @@ const file_events_user_proto_rawDesc = "" +
 	"\x10EmailSendRequest\x12\x0e\n" +
 	"\x02to\x18\x01 \x01(\tR\x02to\x12\x18\n" +
 	"\asubject\x18\x02 \x01(\tR\asubject\x12\x12\n" +
-	"\x04body\x18\x03 \x01(\tR\x04bodyBAZ?auth-service/internal/adapter/grpc/server/frontend/proto/eventsb\x06proto3"
+	"\x04body\x18\x03 \x01(\tR\x04body\"\xdb\x01\n" +
+	"\fChipTransfer\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12'\n" +
+	"\x0fcounterparty_id\x18\x02 \x01(\x03R\x0ecounterpartyId\x12\x1c\n" +
+	"\tdirection\x18\x03 \x01(\tR\tdirection\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x18\n" +
+	"\abalance\x18\x05 \x01(\x03R\abalance\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtBAZ?auth-service/internal/adapter/grpc/server/frontend/proto/eventsb\x06proto3"
 
 var (
 	file_events_user_proto_rawDescOnce sync.Once
@@ -337,25 +433,27 @@ func file_events_user_proto_rawDescGZIP() []byte {
 	return file_events_user_proto_rawDescData
 }
 
-var file_events_user_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_events_user_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_events_user_proto_goTypes = []any{
 	(*User)(nil),                  // 0: events_svc.User
 	(*UserCreated)(nil),           // 1: events_svc.UserCreated
 	(*UserUpdated)(nil),           // 2: events_svc.UserUpdated
 	(*UserDeleted)(nil),           // 3: events_svc.UserDeleted
 	(*EmailSendRequest)(nil),      // 4: events_svc.EmailSendRequest
-	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*ChipTransfer)(nil),          // 5: events_svc.ChipTransfer
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
 }
 var file_events_user_proto_depIdxs = []int32{
-	5, // 0: events_svc.User.created_at:type_name -> google.protobuf.Timestamp
-	5, // 1: events_svc.User.updated_at:type_name -> google.protobuf.Timestamp
+	6, // 0: events_svc.User.created_at:type_name -> google.protobuf.Timestamp
+	6, // 1: events_svc.User.updated_at:type_name -> google.protobuf.Timestamp
 	0, // 2: events_svc.UserCreated.user:type_name -> events_svc.User
 	0, // 3: events_svc.UserUpdated.user:type_name -> events_svc.User
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	6, // 4: events_svc.ChipTransfer.created_at:type_name -> google.protobuf.Timestamp
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_events_user_proto_init() }
@@ -369,7 +467,7 @@ func file_events_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_user_proto_rawDesc), len(file_events_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -4,6 +4,7 @@ import (
 	"context"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"log"
+	"time"
 	usersvc "user_svc/internal/adapter/grpc/server/frontend/proto/user"
 
 	"user_svc/internal/adapter/grpc/server/frontend/dto"
@@ -26,18 +27,26 @@ func (c *User) GetBalance(ctx context.Context, req *usersvc.UserIDRequest) (*use
 	if err != nil {
 		return nil, dto.FromError(err)
 	}
-	return &usersvc.GetBalanceResponse{Balance: balance}, nil
+	held, err := c.userUsecase.GetHeldBalance(ctx, req.Id)
+	if err != nil {
+		return nil, dto.FromError(err)
+	}
+	return &usersvc.GetBalanceResponse{
+		Balance:   balance,
+		Held:      held,
+		Available: balance - held,
+	}, nil
 }
 
 func (c *User) AddBalance(ctx context.Context, req *usersvc.BalanceUpdateRequest) (*emptypb.Empty, error) {
-	if err := c.userUsecase.AddBalance(ctx, req.Id, req.Balance); err != nil {
+	if err := c.userUsecase.AddBalance(ctx, req.Id, req.Balance, req.IdempotencyKey); err != nil {
 		return nil, dto.FromError(err)
 	}
 	return &emptypb.Empty{}, nil
 }
 
 func (c *User) SubtractBalance(ctx context.Context, req *usersvc.BalanceUpdateRequest) (*emptypb.Empty, error) {
-	if err := c.userUsecase.SubtractBalance(ctx, req.Id, req.Balance); err != nil {
+	if err := c.userUsecase.SubtractBalance(ctx, req.Id, req.Balance, req.IdempotencyKey); err != nil {
 		return nil, dto.FromError(err)
 	}
 	return &emptypb.Empty{}, nil
@@ -77,3 +86,109 @@ func (c *User) UpdateRating(ctx context.Context, req *usersvc.RatingUpdateRespon
 	}
 	return &emptypb.Empty{}, nil
 }
+
+func (c *User) PlaceHold(ctx context.Context, req *usersvc.PlaceHoldRequest) (*usersvc.ChipHold, error) {
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	hold, err := c.userUsecase.PlaceHold(ctx, req.UserId, req.Amount, req.Reference, ttl)
+	if err != nil {
+		return nil, dto.FromError(err)
+	}
+	return dto.FromModelToProtoChipHold(hold), nil
+}
+
+func (c *User) ReleaseHold(ctx context.Context, req *usersvc.HoldRequest) (*emptypb.Empty, error) {
+	if err := c.userUsecase.ReleaseHold(ctx, req.UserId, req.Reference); err != nil {
+		return nil, dto.FromError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (c *User) CaptureHold(ctx context.Context, req *usersvc.CaptureHoldRequest) (*usersvc.CaptureHoldResponse, error) {
+	result, err := c.userUsecase.CaptureHold(ctx, req.UserId, req.Reference, req.BeneficiaryId)
+	if err != nil {
+		return nil, dto.FromError(err)
+	}
+	return &usersvc.CaptureHoldResponse{
+		UserBalance:        result.UserBalance,
+		BeneficiaryBalance: result.BeneficiaryBalance,
+	}, nil
+}
+
+func (c *User) GetTransactions(ctx context.Context, req *usersvc.GetTransactionsRequest) (*usersvc.GetTransactionsResponse, error) {
+	page, err := c.userUsecase.GetTransactions(ctx, dto.ToLedgerFilterFromGetTransactionsRequest(req))
+	if err != nil {
+		return nil, dto.FromError(err)
+	}
+	return dto.FromModelToGetTransactionsResponse(page), nil
+}
+
+func (c *User) SettleMatch(ctx context.Context, req *usersvc.SettleMatchRequest) (*usersvc.SettleMatchResponse, error) {
+	result, err := c.userUsecase.SettleMatch(ctx, dto.ToSettlementFromSettleMatchRequest(req))
+	if err != nil {
+		return nil, dto.FromError(err)
+	}
+	return dto.FromModelToSettleMatchResponse(result), nil
+}
+
+func (c *User) ClaimDailyBonus(ctx context.Context, req *usersvc.UserIDRequest) (*usersvc.RewardClaimResponse, error) {
+	claim, err := c.userUsecase.ClaimDailyBonus(ctx, req.Id)
+	if err != nil {
+		return nil, dto.FromError(err)
+	}
+	return dto.FromModelToRewardClaimResponse(claim), nil
+}
+
+func (c *User) ClaimRefill(ctx context.Context, req *usersvc.UserIDRequest) (*usersvc.RewardClaimResponse, error) {
+	claim, err := c.userUsecase.ClaimRefill(ctx, req.Id)
+	if err != nil {
+		return nil, dto.FromError(err)
+	}
+	return dto.FromModelToRewardClaimResponse(claim), nil
+}
+
+func (c *User) CreditReward(ctx context.Context, req *usersvc.CreditRewardRequest) (*usersvc.CreditRewardResponse, error) {
+	credit, err := c.userUsecase.CreditReward(ctx, req.UserId, req.Amount, req.Reason)
+	if err != nil {
+		return nil, dto.FromError(err)
+	}
+	return dto.FromModelToCreditRewardResponse(credit), nil
+}
+
+func (c *User) TransferChips(ctx context.Context, req *usersvc.TransferChipsRequest) (*usersvc.TransferChipsResponse, error) {
+	result, err := c.userUsecase.TransferChips(ctx, dto.ToTransferFromTransferChipsRequest(req))
+	if err != nil {
+		return nil, dto.FromError(err)
+	}
+	return dto.FromModelToTransferChipsResponse(result), nil
+}
+
+func (c *User) GetResponsibleGaming(ctx context.Context, req *usersvc.UserIDRequest) (*usersvc.ResponsibleGamingResponse, error) {
+	settings, err := c.userUsecase.GetResponsibleGaming(ctx, req.Id)
+	if err != nil {
+		return nil, dto.FromError(err)
+	}
+	return dto.FromModelToResponsibleGamingResponse(settings), nil
+}
+
+func (c *User) SetGamingLimit(ctx context.Context, req *usersvc.SetGamingLimitRequest) (*usersvc.ResponsibleGamingResponse, error) {
+	settings, err := c.userUsecase.SetGamingLimit(ctx, req.UserId, req.Kind, req.Value)
+	if err != nil {
+		return nil, dto.FromError(err)
+	}
+	return dto.FromModelToResponsibleGamingResponse(settings), nil
+}
+
+func (c *User) SelfExclude(ctx context.Context, req *usersvc.SelfExcludeRequest) (*usersvc.ResponsibleGamingResponse, error) {
+	settings, err := c.userUsecase.SelfExclude(ctx, req.UserId, time.Duration(req.DurationSeconds)*time.Second)
+	if err != nil {
+		return nil, dto.FromError(err)
+	}
+	return dto.FromModelToResponsibleGamingResponse(settings), nil
+}
+
+func (c *User) CheckPlayAllowed(ctx context.Context, req *usersvc.CheckPlayAllowedRequest) (*emptypb.Empty, error) {
+	if err := c.userUsecase.CheckPlayAllowed(ctx, req.UserId, req.Amount); err != nil {
+		return nil, dto.FromError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,18 @@
+package dto
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+	eventsproto "user_svc/internal/adapter/grpc/server/frontend/proto/events"
+	"user_svc/internal/model"
+)
+
+func FromTransferNotification(notification model.TransferNotification) *eventsproto.ChipTransfer {
+	return &eventsproto.ChipTransfer{
+		UserId:         notification.UserID,
+		CounterpartyId: notification.CounterpartyID,
+		Direction:      notification.Direction,
+		Amount:         notification.Amount,
+		Balance:        notification.Balance,
+		CreatedAt:      timestamppb.New(notification.CreatedAt),
+	}
+}
@@ -0,0 +1,50 @@
+package producer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"user_svc/internal/adapter/nats/producer/dto"
+	"user_svc/internal/model"
+	"user_svc/pkg/nats"
+)
+
+const PushTimeout = time.Second * 30
+
+type User struct {
+	natsClient          *nats.Client
+	chipTransferSubject string
+}
+
+func NewUserProducer(
+	natsClient *nats.Client,
+	chipTransferSubject string,
+) *User {
+	return &User{
+		natsClient:          natsClient,
+		chipTransferSubject: chipTransferSubject,
+	}
+}
+
+func (c *User) PushChipTransfer(ctx context.Context, notification model.TransferNotification) error {
+	ctx, cancel := context.WithTimeout(ctx, PushTimeout)
+	defer cancel()
+
+	pbTransfer := dto.FromTransferNotification(notification)
+	data, err := proto.Marshal(pbTransfer)
+	if err != nil {
+		return fmt.Errorf("proto.Marshal: %w", err)
+	}
+
+	err = c.natsClient.Conn.Publish(c.chipTransferSubject, data)
+	if err != nil {
+		return fmt.Errorf("publish ChipTransfer: %w", err)
+	}
+
+	log.Printf("ChipTransfer event pushed to %s: user %d %s %d", c.chipTransferSubject, notification.UserID, notification.Direction, notification.Amount)
+	return nil
+}
@@ -0,0 +1,30 @@
+package dao
+
+import (
+	"time"
+	"user_svc/internal/model"
+)
+
+type ChipHold struct {
+	ID        int64     `db:"id"`
+	UserID    int64     `db:"user_id"`
+	Amount    int64     `db:"amount"`
+	Reference string    `db:"reference"`
+	Status    string    `db:"status"`
+	ExpiresAt time.Time `db:"expires_at"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func ToChipHold(h ChipHold) model.ChipHold {
+	return model.ChipHold{
+		ID:        h.ID,
+		UserID:    h.UserID,
+		Amount:    h.Amount,
+		Reference: h.Reference,
+		Status:    h.Status,
+		ExpiresAt: h.ExpiresAt,
+		CreatedAt: h.CreatedAt,
+		UpdatedAt: h.UpdatedAt,
+	}
+}
@@ -0,0 +1,34 @@
+package dao
+
+import (
+	"database/sql"
+	"time"
+	"user_svc/internal/model"
+)
+
+type LedgerEntry struct {
+	ID            int64         `db:"id"`
+	TransactionID int64         `db:"transaction_id"`
+	AccountID     int64         `db:"account_id"`
+	Kind          string        `db:"kind"`
+	Amount        int64         `db:"amount"`
+	BalanceAfter  sql.NullInt64 `db:"balance_after"`
+	Reference     string        `db:"reference"`
+	CreatedAt     time.Time     `db:"created_at"`
+}
+
+func ToLedgerEntry(e LedgerEntry) model.LedgerEntry {
+	entry := model.LedgerEntry{
+		ID:            e.ID,
+		TransactionID: e.TransactionID,
+		AccountID:     e.AccountID,
+		Kind:          e.Kind,
+		Amount:        e.Amount,
+		Reference:     e.Reference,
+		CreatedAt:     e.CreatedAt,
+	}
+	if e.BalanceAfter.Valid {
+		entry.BalanceAfter = &e.BalanceAfter.Int64
+	}
+	return entry
+}
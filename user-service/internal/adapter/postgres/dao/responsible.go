@@ -0,0 +1,27 @@
+package dao
+
+import (
+	"database/sql"
+	"user_svc/internal/model"
+)
+
+type GamingLimit struct {
+	UserID       int64         `db:"user_id"`
+	Kind         string        `db:"kind"`
+	Value        int64         `db:"value"`
+	PendingValue sql.NullInt64 `db:"pending_value"`
+	PendingFrom  sql.NullTime  `db:"pending_from"`
+}
+
+func ToGamingLimit(l GamingLimit) model.GamingLimit {
+	limit := model.GamingLimit{
+		UserID: l.UserID,
+		Kind:   l.Kind,
+		Value:  l.Value,
+	}
+	if l.PendingValue.Valid && l.PendingFrom.Valid {
+		limit.PendingValue = &l.PendingValue.Int64
+		limit.PendingFrom = &l.PendingFrom.Time
+	}
+	return limit
+}
@@ -0,0 +1,27 @@
+package dao
+
+import (
+	"database/sql"
+	"user_svc/internal/model"
+)
+
+type RewardState struct {
+	UserID           int64        `db:"user_id"`
+	DailyStreak      int64        `db:"daily_streak"`
+	LastDailyClaimAt sql.NullTime `db:"last_daily_claim_at"`
+	LastRefillAt     sql.NullTime `db:"last_refill_at"`
+}
+
+func ToRewardState(r RewardState) model.RewardState {
+	state := model.RewardState{
+		UserID:      r.UserID,
+		DailyStreak: r.DailyStreak,
+	}
+	if r.LastDailyClaimAt.Valid {
+		state.LastDailyClaimAt = &r.LastDailyClaimAt.Time
+	}
+	if r.LastRefillAt.Valid {
+		state.LastRefillAt = &r.LastRefillAt.Time
+	}
+	return state
+}
@@ -0,0 +1,200 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+	"user_svc/internal/adapter/postgres/dao"
+	"user_svc/internal/model"
+	"user_svc/pkg/postgres"
+)
+
+type HoldRepository struct {
+	db *sql.DB
+}
+
+func NewHoldRepository(db *sql.DB) *HoldRepository {
+	return &HoldRepository{
+		db: db,
+	}
+}
+
+func (r *HoldRepository) Create(ctx context.Context, hold model.ChipHold) (model.ChipHold, error) {
+	query := `
+		INSERT INTO chip_holds (
+			user_id, amount, reference, status, expires_at
+		) VALUES (
+			$1, $2, $3, $4, $5
+		)
+		RETURNING id, user_id, amount, reference, status, expires_at, created_at, updated_at
+	`
+
+	var holdDAO dao.ChipHold
+	err := postgres.ExecutorFromCtx(ctx, r.db).QueryRowContext(ctx, query,
+		hold.UserID,
+		hold.Amount,
+		hold.Reference,
+		hold.Status,
+		hold.ExpiresAt,
+	).Scan(
+		&holdDAO.ID,
+		&holdDAO.UserID,
+		&holdDAO.Amount,
+		&holdDAO.Reference,
+		&holdDAO.Status,
+		&holdDAO.ExpiresAt,
+		&holdDAO.CreatedAt,
+		&holdDAO.UpdatedAt,
+	)
+	if err != nil {
+		return model.ChipHold{}, fmt.Errorf("failed to create hold: %w", err)
+	}
+
+	return dao.ToChipHold(holdDAO), nil
+}
+
+// GetForUpdate returns the hold of a user for the given reference and locks
+// its row until the surrounding transaction ends.
+func (r *HoldRepository) GetForUpdate(ctx context.Context, userID int64, reference string) (model.ChipHold, error) {
+	query := `
+		SELECT id, user_id, amount, reference, status, expires_at, created_at, updated_at
+		FROM chip_holds
+		WHERE user_id = $1 AND reference = $2
+		FOR UPDATE
+	`
+
+	var holdDAO dao.ChipHold
+	err := postgres.ExecutorFromCtx(ctx, r.db).QueryRowContext(ctx, query, userID, reference).Scan(
+		&holdDAO.ID,
+		&holdDAO.UserID,
+		&holdDAO.Amount,
+		&holdDAO.Reference,
+		&holdDAO.Status,
+		&holdDAO.ExpiresAt,
+		&holdDAO.CreatedAt,
+		&holdDAO.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.ChipHold{}, model.ErrHoldNotFound
+		}
+		return model.ChipHold{}, fmt.Errorf("failed to get hold: %w", err)
+	}
+
+	return dao.ToChipHold(holdDAO), nil
+}
+
+// SumActive returns the amount of chips currently held for a user.
+func (r *HoldRepository) SumActive(ctx context.Context, userID int64) (int64, error) {
+	query := `
+		SELECT COALESCE(SUM(amount), 0)
+		FROM chip_holds
+		WHERE user_id = $1 AND status = $2 AND expires_at > NOW()
+	`
+
+	var held int64
+	err := postgres.ExecutorFromCtx(ctx, r.db).QueryRowContext(ctx, query, userID, model.HoldStatusHeld).Scan(&held)
+	if err != nil {
+		return 0, fmt.Errorf("failed to sum holds: %w", err)
+	}
+
+	return held, nil
+}
+
+// ListActive returns the holds of a user that are still held and not past their expiry.
+func (r *HoldRepository) ListActive(ctx context.Context, userID int64) ([]model.ChipHold, error) {
+	query := `
+		SELECT id, user_id, amount, reference, status, expires_at, created_at, updated_at
+		FROM chip_holds
+		WHERE user_id = $1 AND status = $2 AND expires_at > NOW()
+		ORDER BY id
+	`
+
+	rows, err := postgres.ExecutorFromCtx(ctx, r.db).QueryContext(ctx, query, userID, model.HoldStatusHeld)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list holds: %w", err)
+	}
+	defer rows.Close()
+
+	var holds []model.ChipHold
+	for rows.Next() {
+		var holdDAO dao.ChipHold
+		if err := rows.Scan(
+			&holdDAO.ID,
+			&holdDAO.UserID,
+			&holdDAO.Amount,
+			&holdDAO.Reference,
+			&holdDAO.Status,
+			&holdDAO.ExpiresAt,
+			&holdDAO.CreatedAt,
+			&holdDAO.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan hold: %w", err)
+		}
+		holds = append(holds, dao.ToChipHold(holdDAO))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate holds: %w", err)
+	}
+
+	return holds, nil
+}
+
+// SumPlacedSince returns the amount of chips put on holds since the given time,
+// whatever happened to the holds afterwards. It is the amount the user wagered.
+func (r *HoldRepository) SumPlacedSince(ctx context.Context, userID int64, since time.Time) (int64, error) {
+	query := `
+		SELECT COALESCE(SUM(amount), 0)
+		FROM chip_holds
+		WHERE user_id = $1 AND created_at >= $2
+	`
+
+	var placed int64
+	err := postgres.ExecutorFromCtx(ctx, r.db).QueryRowContext(ctx, query, userID, since).Scan(&placed)
+	if err != nil {
+		return 0, fmt.Errorf("failed to sum placed holds: %w", err)
+	}
+
+	return placed, nil
+}
+
+func (r *HoldRepository) UpdateStatus(ctx context.Context, holdID int64, status string) error {
+	query := `UPDATE chip_holds SET status = $1, updated_at = NOW() WHERE id = $2`
+
+	res, err := postgres.ExecutorFromCtx(ctx, r.db).ExecContext(ctx, query, status, holdID)
+	if err != nil {
+		return fmt.Errorf("failed to update hold status: %w", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return model.ErrHoldNotFound
+	}
+
+	return nil
+}
+
+// ExpireStale marks every hold past its expiry as expired and returns how many were changed.
+func (r *HoldRepository) ExpireStale(ctx context.Context) (int64, error) {
+	query := `
+		UPDATE chip_holds SET status = $1, updated_at = NOW()
+		WHERE status = $2 AND expires_at <= NOW()
+	`
+
+	res, err := postgres.ExecutorFromCtx(ctx, r.db).ExecContext(ctx, query, model.HoldStatusExpired, model.HoldStatusHeld)
+	if err != nil {
+		return 0, fmt.Errorf("failed to expire holds: %w", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
+}
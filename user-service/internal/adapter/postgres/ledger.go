@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+	"user_svc/internal/adapter/postgres/dao"
+	"user_svc/internal/model"
+	"user_svc/pkg/postgres"
+
+	"github.com/lib/pq"
+)
+
+type LedgerRepository struct {
+	db *sql.DB
+}
+
+func NewLedgerRepository(db *sql.DB) *LedgerRepository {
+	return &LedgerRepository{
+		db: db,
+	}
+}
+
+// CreateTransaction inserts a ledger transaction. It returns model.ErrConflict
+// when a transaction with the same idempotency key already exists.
+func (r *LedgerRepository) CreateTransaction(ctx context.Context, tx model.LedgerTransaction) (model.LedgerTransaction, error) {
+	query := `
+		INSERT INTO ledger_transactions (reference, idempotency_key)
+		VALUES ($1, $2)
+		ON CONFLICT (idempotency_key) DO NOTHING
+		RETURNING id, created_at
+	`
+
+	err := postgres.ExecutorFromCtx(ctx, r.db).QueryRowContext(ctx, query,
+		tx.Reference,
+		tx.IdempotencyKey,
+	).Scan(&tx.ID, &tx.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.LedgerTransaction{}, model.ErrConflict
+		}
+		return model.LedgerTransaction{}, fmt.Errorf("failed to create ledger transaction: %w", err)
+	}
+
+	return tx, nil
+}
+
+func (r *LedgerRepository) CreateEntry(ctx context.Context, entry model.LedgerEntry) error {
+	query := `
+		INSERT INTO ledger_entries (
+			transaction_id, account_id, kind, amount, balance_after
+		) VALUES (
+			$1, $2, $3, $4, $5
+		)
+	`
+
+	var balanceAfter sql.NullInt64
+	if entry.BalanceAfter != nil {
+		balanceAfter = sql.NullInt64{Int64: *entry.BalanceAfter, Valid: true}
+	}
+
+	_, err := postgres.ExecutorFromCtx(ctx, r.db).ExecContext(ctx, query,
+		entry.TransactionID,
+		entry.AccountID,
+		entry.Kind,
+		entry.Amount,
+		balanceAfter,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create ledger entry: %w", err)
+	}
+
+	return nil
+}
+
+// ListEntries returns a page of entries of one account, newest first.
+func (r *LedgerRepository) ListEntries(ctx context.Context, filter model.LedgerFilter) (model.LedgerPage, error) {
+	exec := postgres.ExecutorFromCtx(ctx, r.db)
+
+	var page model.LedgerPage
+	countQuery := `SELECT COUNT(*) FROM ledger_entries WHERE account_id = $1`
+	if err := exec.QueryRowContext(ctx, countQuery, filter.AccountID).Scan(&page.Total); err != nil {
+		return model.LedgerPage{}, fmt.Errorf("failed to count ledger entries: %w", err)
+	}
+
+	query := `
+		SELECT e.id, e.transaction_id, e.account_id, e.kind, e.amount, e.balance_after, t.reference, e.created_at
+		FROM ledger_entries e
+		JOIN ledger_transactions t ON t.id = e.transaction_id
+		WHERE e.account_id = $1
+		ORDER BY e.id DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := exec.QueryContext(ctx, query, filter.AccountID, filter.Limit, filter.Offset)
+	if err != nil {
+		return model.LedgerPage{}, fmt.Errorf("failed to list ledger entries: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entryDAO dao.LedgerEntry
+		if err := rows.Scan(
+			&entryDAO.ID,
+			&entryDAO.TransactionID,
+			&entryDAO.AccountID,
+			&entryDAO.Kind,
+			&entryDAO.Amount,
+			&entryDAO.BalanceAfter,
+			&entryDAO.Reference,
+			&entryDAO.CreatedAt,
+		); err != nil {
+			return model.LedgerPage{}, fmt.Errorf("failed to scan ledger entry: %w", err)
+		}
+		page.Entries = append(page.Entries, dao.ToLedgerEntry(entryDAO))
+	}
+	if err := rows.Err(); err != nil {
+		return model.LedgerPage{}, fmt.Errorf("failed to iterate ledger entries: %w", err)
+	}
+
+	return page, nil
+}
+
+// SumEntriesSince sums entries of the given kinds posted to the account since the given time.
+func (r *LedgerRepository) SumEntriesSince(ctx context.Context, accountID int64, since time.Time, kinds ...string) (model.EntryTotals, error) {
+	query := `
+		SELECT
+			COALESCE(SUM(-amount) FILTER (WHERE amount < 0), 0),
+			COALESCE(SUM(amount) FILTER (WHERE amount > 0), 0)
+		FROM ledger_entries
+		WHERE account_id = $1 AND kind = ANY($2) AND created_at >= $3
+	`
+
+	var totals model.EntryTotals
+	err := postgres.ExecutorFromCtx(ctx, r.db).QueryRowContext(ctx, query, accountID, pq.Array(kinds), since).Scan(&totals.Debit, &totals.Credit)
+	if err != nil {
+		return model.EntryTotals{}, fmt.Errorf("failed to sum ledger entries: %w", err)
+	}
+
+	return totals, nil
+}
+
+// ChangeSystemBalance adds delta to a system account and returns the new balance.
+// System accounts may go negative, e.g. the mint.
+func (r *LedgerRepository) ChangeSystemBalance(ctx context.Context, accountID int64, delta int64) (int64, error) {
+	query := `UPDATE system_accounts SET balance = balance + $1 WHERE id = $2 RETURNING balance`
+
+	var balance int64
+	err := postgres.ExecutorFromCtx(ctx, r.db).QueryRowContext(ctx, query, delta, accountID).Scan(&balance)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, model.ErrNotFound
+		}
+		return 0, fmt.Errorf("failed to change system balance: %w", err)
+	}
+
+	return balance, nil
+}
+
+// GetSystemBalanceForUpdate reads a system account balance and locks it until the surrounding transaction ends.
+func (r *LedgerRepository) GetSystemBalanceForUpdate(ctx context.Context, accountID int64) (int64, error) {
+	query := `SELECT balance FROM system_accounts WHERE id = $1 FOR UPDATE`
+
+	var balance int64
+	err := postgres.ExecutorFromCtx(ctx, r.db).QueryRowContext(ctx, query, accountID).Scan(&balance)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, model.ErrNotFound
+		}
+		return 0, fmt.Errorf("failed to get system balance: %w", err)
+	}
+
+	return balance, nil
+}
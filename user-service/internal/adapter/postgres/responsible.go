@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+	"user_svc/internal/adapter/postgres/dao"
+	"user_svc/internal/model"
+	"user_svc/pkg/postgres"
+)
+
+type ResponsibleGamingRepository struct {
+	db *sql.DB
+}
+
+func NewResponsibleGamingRepository(db *sql.DB) *ResponsibleGamingRepository {
+	return &ResponsibleGamingRepository{
+		db: db,
+	}
+}
+
+func (r *ResponsibleGamingRepository) ListLimits(ctx context.Context, userID int64) ([]model.GamingLimit, error) {
+	query := `
+		SELECT user_id, kind, value, pending_value, pending_from
+		FROM gaming_limits
+		WHERE user_id = $1
+	`
+
+	rows, err := postgres.ExecutorFromCtx(ctx, r.db).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list gaming limits: %w", err)
+	}
+	defer rows.Close()
+
+	var limits []model.GamingLimit
+	for rows.Next() {
+		var limitDAO dao.GamingLimit
+		if err := rows.Scan(
+			&limitDAO.UserID,
+			&limitDAO.Kind,
+			&limitDAO.Value,
+			&limitDAO.PendingValue,
+			&limitDAO.PendingFrom,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan gaming limit: %w", err)
+		}
+		limits = append(limits, dao.ToGamingLimit(limitDAO))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate gaming limits: %w", err)
+	}
+
+	return limits, nil
+}
+
+func (r *ResponsibleGamingRepository) UpsertLimit(ctx context.Context, limit model.GamingLimit) error {
+	query := `
+		INSERT INTO gaming_limits (user_id, kind, value, pending_value, pending_from)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, kind) DO UPDATE
+		SET value = EXCLUDED.value,
+			pending_value = EXCLUDED.pending_value,
+			pending_from = EXCLUDED.pending_from,
+			updated_at = NOW()
+	`
+
+	_, err := postgres.ExecutorFromCtx(ctx, r.db).ExecContext(ctx, query,
+		limit.UserID,
+		limit.Kind,
+		limit.Value,
+		limit.PendingValue,
+		limit.PendingFrom,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert gaming limit: %w", err)
+	}
+
+	return nil
+}
+
+// GetSelfExclusion returns when the self-exclusion of the user ends, nil if the user never excluded themselves.
+func (r *ResponsibleGamingRepository) GetSelfExclusion(ctx context.Context, userID int64) (*time.Time, error) {
+	query := `SELECT excluded_until FROM self_exclusions WHERE user_id = $1`
+
+	var until time.Time
+	err := postgres.ExecutorFromCtx(ctx, r.db).QueryRowContext(ctx, query, userID).Scan(&until)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get self exclusion: %w", err)
+	}
+
+	return &until, nil
+}
+
+// SetSelfExclusion stores the end of the self-exclusion. An exclusion can only be extended.
+func (r *ResponsibleGamingRepository) SetSelfExclusion(ctx context.Context, userID int64, until time.Time) error {
+	query := `
+		INSERT INTO self_exclusions (user_id, excluded_until)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE
+		SET excluded_until = GREATEST(self_exclusions.excluded_until, EXCLUDED.excluded_until),
+			updated_at = NOW()
+	`
+
+	_, err := postgres.ExecutorFromCtx(ctx, r.db).ExecContext(ctx, query, userID, until)
+	if err != nil {
+		return fmt.Errorf("failed to set self exclusion: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"user_svc/internal/adapter/postgres/dao"
+	"user_svc/internal/model"
+	"user_svc/pkg/postgres"
+)
+
+type RewardRepository struct {
+	db *sql.DB
+}
+
+func NewRewardRepository(db *sql.DB) *RewardRepository {
+	return &RewardRepository{
+		db: db,
+	}
+}
+
+// GetForUpdate returns the reward state of a user, creating an empty one on first use,
+// and locks it until the surrounding transaction ends.
+func (r *RewardRepository) GetForUpdate(ctx context.Context, userID int64) (model.RewardState, error) {
+	exec := postgres.ExecutorFromCtx(ctx, r.db)
+
+	insertQuery := `INSERT INTO user_rewards (user_id) VALUES ($1) ON CONFLICT (user_id) DO NOTHING`
+	if _, err := exec.ExecContext(ctx, insertQuery, userID); err != nil {
+		return model.RewardState{}, fmt.Errorf("failed to init reward state: %w", err)
+	}
+
+	query := `
+		SELECT user_id, daily_streak, last_daily_claim_at, last_refill_at
+		FROM user_rewards
+		WHERE user_id = $1
+		FOR UPDATE
+	`
+
+	var stateDAO dao.RewardState
+	err := exec.QueryRowContext(ctx, query, userID).Scan(
+		&stateDAO.UserID,
+		&stateDAO.DailyStreak,
+		&stateDAO.LastDailyClaimAt,
+		&stateDAO.LastRefillAt,
+	)
+	if err != nil {
+		return model.RewardState{}, fmt.Errorf("failed to get reward state: %w", err)
+	}
+
+	return dao.ToRewardState(stateDAO), nil
+}
+
+func (r *RewardRepository) Update(ctx context.Context, state model.RewardState) error {
+	query := `
+		UPDATE user_rewards
+		SET daily_streak = $1, last_daily_claim_at = $2, last_refill_at = $3, updated_at = NOW()
+		WHERE user_id = $4
+	`
+
+	_, err := postgres.ExecutorFromCtx(ctx, r.db).ExecContext(ctx, query,
+		state.DailyStreak,
+		state.LastDailyClaimAt,
+		state.LastRefillAt,
+		state.UserID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update reward state: %w", err)
+	}
+
+	return nil
+}
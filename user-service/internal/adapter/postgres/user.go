@@ -30,7 +30,7 @@ func (r *UserRepository) Create(ctx context.Context, customer model.User) error
 			$1, $2, $3, $4, $5, $6
 		)
 	`
-	_, err := r.db.ExecContext(ctx, query,
+	_, err := postgres.ExecutorFromCtx(ctx, r.db).ExecContext(ctx, query,
 		customer.ID,
 		customer.Username,
 		customer.Email,
@@ -210,25 +210,6 @@ func (r *UserRepository) GetBalance(ctx context.Context, userID int64) (int64, e
 	return balance, nil
 }
 
-func (r *UserRepository) UpdateBalance(ctx context.Context, userID int64, newBalance int64) error {
-	query := `UPDATE users SET balance = $1, updated_at = NOW() WHERE id = $2`
-
-	res, err := r.db.ExecContext(ctx, query, newBalance, userID)
-	if err != nil {
-		return fmt.Errorf("failed to update balance: %w", err)
-	}
-
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
-	}
-	if rowsAffected == 0 {
-		return model.ErrNotFound
-	}
-
-	return nil
-}
-
 func (r *UserRepository) GetRating(ctx context.Context, userID int64) (int64, error) {
 	query := `SELECT rating FROM users WHERE id = $1`
 
@@ -266,3 +247,43 @@ func (r *UserRepository) UpdateRating(ctx context.Context, userID int64, newRati
 
 	return nil
 }
+
+// GetBalanceForUpdate reads the balance and locks the user row until the surrounding transaction ends.
+func (r *UserRepository) GetBalanceForUpdate(ctx context.Context, userID int64) (int64, error) {
+	query := `SELECT balance FROM users WHERE id = $1 FOR UPDATE`
+
+	var balance int64
+	err := postgres.ExecutorFromCtx(ctx, r.db).QueryRowContext(ctx, query, userID).Scan(&balance)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, model.ErrNotFound
+		}
+		return 0, fmt.Errorf("failed to get balance: %w", err)
+	}
+
+	return balance, nil
+}
+
+// ChangeBalance atomically adds delta to the balance and returns the new value.
+// The update is rejected when it would make the balance negative.
+func (r *UserRepository) ChangeBalance(ctx context.Context, userID int64, delta int64) (int64, error) {
+	query := `
+		UPDATE users SET balance = balance + $1, updated_at = NOW()
+		WHERE id = $2 AND balance + $1 >= 0
+		RETURNING balance
+	`
+
+	var balance int64
+	err := postgres.ExecutorFromCtx(ctx, r.db).QueryRowContext(ctx, query, delta, userID).Scan(&balance)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			if _, getErr := r.GetBalance(ctx, userID); getErr != nil {
+				return 0, getErr
+			}
+			return 0, model.ErrNotEnoughBalance
+		}
+		return 0, fmt.Errorf("failed to change balance: %w", err)
+	}
+
+	return balance, nil
+}
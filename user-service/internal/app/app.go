@@ -8,12 +8,15 @@ import (
 	"os/signal"
 	"strings"
 	"syscall"
+	"time"
 
 	"user_svc/config"
 	grpcserver "user_svc/internal/adapter/grpc/server"
+	natsproducer "user_svc/internal/adapter/nats/producer"
 	natssubscriber "user_svc/internal/adapter/nats/subscriber"
 	postgresrepo "user_svc/internal/adapter/postgres"
 	redisrepo "user_svc/internal/adapter/redis"
+	"user_svc/internal/model"
 	"user_svc/internal/usecase"
 	natsconn "user_svc/pkg/nats"
 	natsconsumer "user_svc/pkg/nats/consumer"
@@ -27,6 +30,8 @@ type App struct {
 	grpcServer         *grpcserver.API
 	db                 *postgrescon.DB
 	natsPubSubConsumer *natsconsumer.PubSub
+	userUsecase        *usecase.User
+	holdSweepInterval  time.Duration
 }
 
 func New(ctx context.Context, cfg *config.Config) (*App, error) {
@@ -60,12 +65,26 @@ func New(ctx context.Context, cfg *config.Config) (*App, error) {
 
 	// Initialize repositories
 	userRepo := postgresrepo.NewUserRepository(postgresDB.Conn)
+	holdRepo := postgresrepo.NewHoldRepository(postgresDB.Conn)
+	ledgerRepo := postgresrepo.NewLedgerRepository(postgresDB.Conn)
+	rewardRepo := postgresrepo.NewRewardRepository(postgresDB.Conn)
+	gamingRepo := postgresrepo.NewResponsibleGamingRepository(postgresDB.Conn)
 	userCache := redisrepo.NewUserCache(redisClient, cfg.Cache.ClientTTL)
+	userProducer := natsproducer.NewUserProducer(natsClient, cfg.Nats.NatsSubjects.ChipTransferSubject)
 	// Initialize use cases
 	userUsecase := usecase.NewUser(
 		userRepo,
+		holdRepo,
+		ledgerRepo,
+		rewardRepo,
+		gamingRepo,
 		transactor.WithinTransaction,
 		userCache,
+		userProducer,
+		cfg.Holds.DefaultTTL,
+		model.RewardRules(cfg.Rewards),
+		model.TransferRules(cfg.Transfer),
+		model.ResponsibleGamingRules(cfg.Gaming),
 	)
 	userHandler := natssubscriber.NewUserSubscriber(userUsecase)
 
@@ -98,6 +117,8 @@ func New(ctx context.Context, cfg *config.Config) (*App, error) {
 		grpcServer:         gRPCServer,
 		db:                 postgresDB,
 		natsPubSubConsumer: natsPubSubConsumer,
+		userUsecase:        userUsecase,
+		holdSweepInterval:  cfg.Holds.SweepInterval,
 	}
 
 	return app, nil
@@ -117,6 +138,7 @@ func (a *App) Run() error {
 	// Start gRPC server
 	go a.grpcServer.Run(ctx, errCh)
 	go a.natsPubSubConsumer.Start(ctx, errCh)
+	go a.sweepHolds(ctx)
 
 	log.Printf("service %s started successfully\n", serviceName)
 
@@ -136,3 +158,20 @@ func (a *App) Run() error {
 
 	return nil
 }
+
+// sweepHolds periodically expires chip holds left behind by games that never settled.
+func (a *App) sweepHolds(ctx context.Context) {
+	ticker := time.NewTicker(a.holdSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := a.userUsecase.ExpireStaleHolds(ctx); err != nil {
+				log.Printf("failed to expire stale holds: %v\n", err)
+			}
+		}
+	}
+}
@@ -9,4 +9,15 @@ var (
 	ErrNotEnoughBalance       = errors.New("not enough balance")
 	ErrConflict               = errors.New("conflict")
 	ErrUserNotFound           = errors.New("user not found")
+	ErrHoldNotFound           = errors.New("hold not found")
+	ErrHoldExpired            = errors.New("hold expired")
+	ErrChipsHeld              = errors.New("chips are held in an active game")
+	ErrAlreadyClaimed         = errors.New("reward already claimed")
+	ErrRefillNotAvailable     = errors.New("balance is above the refill threshold")
+	ErrRefillCooldown         = errors.New("refill is on cooldown")
+	ErrTransferLimitExceeded  = errors.New("daily transfer limit exceeded")
+	ErrAccountTooNew          = errors.New("account is too new to transfer chips")
+	ErrSelfExcluded           = errors.New("player is self-excluded")
+	ErrLossLimitReached       = errors.New("loss limit reached")
+	ErrWagerLimitReached      = errors.New("wager limit reached")
 )
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	HoldStatusHeld     = "held"
+	HoldStatusReleased = "released"
+	HoldStatusCaptured = "captured"
+	HoldStatusExpired  = "expired"
+)
+
+// TournamentHoldPrefix starts the reference of a tournament buy-in hold, "tournament:<id>".
+// game-service places it at registration and keeps it until the tournament is over.
+const TournamentHoldPrefix = "tournament:"
+
+// ChipHold reserves part of a user's balance for a running game.
+// Held chips stay on the balance but can't be spent elsewhere until
+// the hold is released, captured or expires.
+type ChipHold struct {
+	ID        int64
+	UserID    int64
+	Amount    int64
+	Reference string // game ID, or TournamentHoldPrefix + tournament ID, the chips are held for
+	Status    string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IsTournamentBuyIn reports whether the hold is a tournament buy-in rather than the stake of a game.
+func (h ChipHold) IsTournamentBuyIn() bool {
+	return strings.HasPrefix(h.Reference, TournamentHoldPrefix)
+}
+
+// CaptureResult holds balances of both sides after a hold was captured.
+type CaptureResult struct {
+	UserBalance        int64
+	BeneficiaryBalance int64
+}
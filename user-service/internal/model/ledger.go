@@ -0,0 +1,77 @@
+package model
+
+import "time"
+
+// Kinds of ledger entries.
+const (
+	EntryKindGameStake       = "game_stake"
+	EntryKindWinnings        = "winnings"
+	EntryKindBonus           = "bonus"
+	EntryKindDailyBonus      = "daily_bonus"
+	EntryKindRefill          = "refill"
+	EntryKindReward          = "reward"
+	EntryKindAdminAdjustment = "admin_adjustment"
+	EntryKindTransfer        = "transfer"
+	EntryKindOpeningBalance  = "opening_balance"
+	EntryKindRake            = "rake"
+	EntryKindJackpotFunding  = "jackpot_contribution"
+	EntryKindJackpot         = "jackpot"
+)
+
+// System accounts live next to user accounts in the ledger. They use
+// negative IDs so they never collide with user IDs.
+const (
+	// MintAccountID is the source of chips that enter the game from outside,
+	// e.g. bonuses and admin adjustments.
+	MintAccountID int64 = -1
+	// HouseAccountID collects the rake taken from pots.
+	HouseAccountID int64 = -2
+	// JackpotAccountID holds the progressive jackpot pool.
+	JackpotAccountID int64 = -3
+)
+
+// IsSystemAccount reports whether the account does not belong to a user.
+func IsSystemAccount(accountID int64) bool {
+	return accountID < 0
+}
+
+// Posting is a single balance movement requested for a ledger transaction.
+// Postings of one transaction must sum to zero.
+type Posting struct {
+	AccountID int64
+	Kind      string
+	Amount    int64
+}
+
+// LedgerTransaction groups postings that are applied together.
+// IdempotencyKey makes a repeated request a no-op.
+type LedgerTransaction struct {
+	ID             int64
+	Reference      string
+	IdempotencyKey string
+	CreatedAt      time.Time
+}
+
+// LedgerEntry is an immutable record of one balance movement.
+type LedgerEntry struct {
+	ID            int64
+	TransactionID int64
+	AccountID     int64
+	Kind          string
+	Amount        int64
+	BalanceAfter  *int64
+	Reference     string
+	CreatedAt     time.Time
+}
+
+type LedgerFilter struct {
+	AccountID int64
+	Limit     int64
+	Offset    int64
+}
+
+// LedgerPage is a page of entries of one account, newest first.
+type LedgerPage struct {
+	Entries []LedgerEntry
+	Total   int64
+}
@@ -0,0 +1,50 @@
+package model
+
+import "time"
+
+// Kinds of responsible gaming limits. A value of 0 means no limit.
+const (
+	LimitDailyLoss   = "daily_loss"
+	LimitWeeklyLoss  = "weekly_loss"
+	LimitDailyWager  = "daily_wager"
+	LimitWeeklyWager = "weekly_wager"
+	// LimitSessionReminder is the number of minutes between session-length reminders.
+	LimitSessionReminder = "session_reminder"
+)
+
+// LimitKinds lists every limit a user can set.
+var LimitKinds = []string{LimitDailyLoss, LimitWeeklyLoss, LimitDailyWager, LimitWeeklyWager, LimitSessionReminder}
+
+// ResponsibleGamingRules configure how players can limit their own play.
+type ResponsibleGamingRules struct {
+	// CoolingOff delays loosening a limit, tightening applies immediately.
+	CoolingOff time.Duration
+	// MaxSelfExclusion is the longest self-exclusion a player can request at once.
+	MaxSelfExclusion time.Duration
+}
+
+// GamingLimit is one limit of a user. A loosening change waits in PendingValue until PendingFrom.
+type GamingLimit struct {
+	UserID       int64
+	Kind         string
+	Value        int64
+	PendingValue *int64
+	PendingFrom  *time.Time
+}
+
+// ResponsibleGaming holds the limits in force for a user.
+type ResponsibleGaming struct {
+	UserID            int64
+	Limits            []GamingLimit
+	SelfExcludedUntil *time.Time
+}
+
+// Limit returns the value of the limit of the given kind, 0 when it is not set.
+func (r ResponsibleGaming) Limit(kind string) int64 {
+	for _, l := range r.Limits {
+		if l.Kind == kind {
+			return l.Value
+		}
+	}
+	return 0
+}
@@ -0,0 +1,39 @@
+package model
+
+import "time"
+
+// RewardRules configure free chips players can claim.
+type RewardRules struct {
+	// DailyBonus is credited once per UTC day, multiplied by the streak multiplier.
+	DailyBonus int64
+	// DailyStreakMultipliers[i] applies on day i+1 of a streak, the last one
+	// applies to every later day.
+	DailyStreakMultipliers []float64
+	// Players with a balance below RefillThreshold can top it up to RefillTo
+	// once per RefillCooldown.
+	RefillThreshold int64
+	RefillTo        int64
+	RefillCooldown  time.Duration
+}
+
+// RewardState tracks claims of a user.
+type RewardState struct {
+	UserID           int64
+	DailyStreak      int64
+	LastDailyClaimAt *time.Time
+	LastRefillAt     *time.Time
+}
+
+// RewardClaim is the result of a successful claim.
+type RewardClaim struct {
+	Amount      int64
+	Balance     int64
+	DailyStreak int64
+}
+
+// RewardCredit is the result of crediting a reward.
+type RewardCredit struct {
+	Balance int64
+	// AlreadyCredited is true when the reward was credited before and nothing was moved.
+	AlreadyCredited bool
+}
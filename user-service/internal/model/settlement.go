@@ -0,0 +1,34 @@
+package model
+
+// PlayerDelta is the change of one player's balance after a match.
+type PlayerDelta struct {
+	UserID int64
+	Delta  int64
+}
+
+// Settlement is the outcome of a match. GameID is used as the idempotency key,
+// a match is settled at most once.
+//
+// Players' deltas sum to -Rake: the rake goes to the house, except for
+// JackpotContribution which feeds the jackpot pool. When JackpotWinnerID is
+// set the whole pool is paid out to that player.
+type Settlement struct {
+	GameID              string
+	Deltas              []PlayerDelta
+	Rake                int64
+	JackpotContribution int64
+	JackpotWinnerID     int64
+}
+
+type PlayerBalance struct {
+	UserID  int64
+	Balance int64
+}
+
+// SettlementResult holds balances of all players after the settlement.
+// AlreadySettled is true when the match had been settled by an earlier call.
+type SettlementResult struct {
+	Balances       []PlayerBalance
+	AlreadySettled bool
+	JackpotPayout  int64
+}
@@ -0,0 +1,52 @@
+package model
+
+import "time"
+
+// TransferRules limit chip gifting between players to make farming chips
+// with secondary accounts unprofitable.
+type TransferRules struct {
+	// DailySendCap and DailyReceiveCap limit chips a user can send and receive per UTC day.
+	DailySendCap    int64
+	DailyReceiveCap int64
+	// MinAccountAge is how old both accounts must be before they can take part in a transfer.
+	MinAccountAge time.Duration
+}
+
+// Transfer is a request to move chips from one player to another.
+// IdempotencyKey makes a retried request a no-op.
+type Transfer struct {
+	FromUserID     int64
+	ToUserID       int64
+	Amount         int64
+	IdempotencyKey string
+}
+
+// TransferResult is the outcome of a transfer.
+type TransferResult struct {
+	FromBalance int64
+	ToBalance   int64
+	// AlreadyApplied is true when the idempotency key was used before and nothing was moved.
+	AlreadyApplied bool
+}
+
+// Directions of a transfer notification.
+const (
+	TransferDirectionSent     = "sent"
+	TransferDirectionReceived = "received"
+)
+
+// TransferNotification tells one side of a transfer about it.
+type TransferNotification struct {
+	UserID         int64
+	CounterpartyID int64
+	Direction      string
+	Amount         int64
+	Balance        int64
+	CreatedAt      time.Time
+}
+
+// EntryTotals sums entries of one account: Debit holds outgoing amounts, Credit incoming ones, both positive.
+type EntryTotals struct {
+	Debit  int64
+	Credit int64
+}
@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"user_svc/internal/model"
+)
+
+// GetHeldBalance returns how many chips of the user are currently held by running games.
+func (uc *User) GetHeldBalance(ctx context.Context, userID int64) (int64, error) {
+	return uc.holdRepo.SumActive(ctx, userID)
+}
+
+// PlaceHold reserves amount chips of the user for the game identified by reference.
+// Placing a hold twice for the same reference returns the existing hold.
+func (uc *User) PlaceHold(ctx context.Context, userID int64, amount int64, reference string, ttl time.Duration) (model.ChipHold, error) {
+	if amount <= 0 || reference == "" {
+		return model.ChipHold{}, model.ErrInvalidInput
+	}
+	if ttl <= 0 {
+		ttl = uc.holdTTL
+	}
+
+	var hold model.ChipHold
+	txFn := func(ctx context.Context) error {
+		balance, err := uc.repo.GetBalanceForUpdate(ctx, userID)
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				return model.ErrUserNotFound
+			}
+			return err
+		}
+
+		existing, err := uc.holdRepo.GetForUpdate(ctx, userID, reference)
+		switch {
+		case err == nil && existing.Status == model.HoldStatusHeld:
+			hold = existing
+			return nil
+		case err == nil:
+			return model.ErrConflict
+		case !errors.Is(err, model.ErrHoldNotFound):
+			return err
+		}
+
+		held, err := uc.holdRepo.SumActive(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if balance-held < amount {
+			return model.ErrNotEnoughBalance
+		}
+		if err := uc.checkPlayAllowed(ctx, userID, amount); err != nil {
+			return err
+		}
+
+		hold, err = uc.holdRepo.Create(ctx, model.ChipHold{
+			UserID:    userID,
+			Amount:    amount,
+			Reference: reference,
+			Status:    model.HoldStatusHeld,
+			ExpiresAt: time.Now().Add(ttl),
+		})
+		return err
+	}
+	if err := uc.callTx(ctx, txFn); err != nil {
+		return model.ChipHold{}, fmt.Errorf("place hold transaction failed: %w", err)
+	}
+
+	return hold, nil
+}
+
+// ReleaseHold gives held chips back to the user. Releasing a hold that is
+// no longer active is a no-op so callers can safely retry.
+func (uc *User) ReleaseHold(ctx context.Context, userID int64, reference string) error {
+	txFn := func(ctx context.Context) error {
+		return uc.releaseHoldTx(ctx, userID, reference)
+	}
+	if err := uc.callTx(ctx, txFn); err != nil {
+		return fmt.Errorf("release hold transaction failed: %w", err)
+	}
+	return nil
+}
+
+// releaseHoldTx releases the hold if it is still active. It must be called inside uc.callTx.
+func (uc *User) releaseHoldTx(ctx context.Context, userID int64, reference string) error {
+	hold, err := uc.holdRepo.GetForUpdate(ctx, userID, reference)
+	if err != nil {
+		if errors.Is(err, model.ErrHoldNotFound) {
+			return nil
+		}
+		return err
+	}
+	if hold.Status != model.HoldStatusHeld {
+		return nil
+	}
+	return uc.holdRepo.UpdateStatus(ctx, hold.ID, model.HoldStatusReleased)
+}
+
+// CaptureHold moves the chips held for reference from the user to the beneficiary.
+func (uc *User) CaptureHold(ctx context.Context, userID int64, reference string, beneficiaryID int64) (model.CaptureResult, error) {
+	var result model.CaptureResult
+	txFn := func(ctx context.Context) error {
+		hold, err := uc.holdRepo.GetForUpdate(ctx, userID, reference)
+		if err != nil {
+			return err
+		}
+
+		switch hold.Status {
+		case model.HoldStatusCaptured:
+			// Already settled by an earlier attempt, report current balances.
+			if result.UserBalance, err = uc.repo.GetBalanceForUpdate(ctx, userID); err != nil {
+				return err
+			}
+			result.BeneficiaryBalance, err = uc.repo.GetBalanceForUpdate(ctx, beneficiaryID)
+			return err
+		case model.HoldStatusHeld:
+		default:
+			return model.ErrHoldNotFound
+		}
+
+		// Expired holds are left for the sweeper, their chips already count as free.
+		if !hold.ExpiresAt.After(time.Now()) {
+			return model.ErrHoldExpired
+		}
+
+		balances, _, err := uc.post(ctx, hold.Reference, fmt.Sprintf("hold:%d", hold.ID), []model.Posting{
+			{AccountID: userID, Kind: model.EntryKindGameStake, Amount: -hold.Amount},
+			{AccountID: beneficiaryID, Kind: model.EntryKindWinnings, Amount: hold.Amount},
+		})
+		if err != nil {
+			return err
+		}
+		result.UserBalance = balances[userID]
+		result.BeneficiaryBalance = balances[beneficiaryID]
+
+		return uc.holdRepo.UpdateStatus(ctx, hold.ID, model.HoldStatusCaptured)
+	}
+	if err := uc.callTx(ctx, txFn); err != nil {
+		return model.CaptureResult{}, fmt.Errorf("capture hold transaction failed: %w", err)
+	}
+
+	_ = uc.cache.SetBalance(ctx, userID, result.UserBalance)
+	_ = uc.cache.SetBalance(ctx, beneficiaryID, result.BeneficiaryBalance)
+	return result, nil
+}
+
+// ExpireStaleHolds marks holds past their expiry as expired, returning their chips to the owners.
+func (uc *User) ExpireStaleHolds(ctx context.Context) (int64, error) {
+	expired, err := uc.holdRepo.ExpireStale(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if expired > 0 {
+		log.Printf("expired %d stale chip holds", expired)
+	}
+	return expired, nil
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 	"user_svc/internal/model"
 )
 
@@ -11,11 +12,47 @@ type UserRepo interface {
 	GetWithFilter(ctx context.Context, filter model.UserFilter) (model.User, error)
 	GetListWithFilter(ctx context.Context, filter model.UserFilter) ([]model.User, error)
 	GetBalance(ctx context.Context, userID int64) (int64, error)
-	UpdateBalance(ctx context.Context, userID int64, newBalance int64) error
+	GetBalanceForUpdate(ctx context.Context, userID int64) (int64, error)
+	ChangeBalance(ctx context.Context, userID int64, delta int64) (int64, error)
 	GetRating(ctx context.Context, userID int64) (int64, error)
 	UpdateRating(ctx context.Context, userID int64, newRating int64) error
 }
 
+type HoldRepo interface {
+	Create(ctx context.Context, hold model.ChipHold) (model.ChipHold, error)
+	GetForUpdate(ctx context.Context, userID int64, reference string) (model.ChipHold, error)
+	SumActive(ctx context.Context, userID int64) (int64, error)
+	ListActive(ctx context.Context, userID int64) ([]model.ChipHold, error)
+	SumPlacedSince(ctx context.Context, userID int64, since time.Time) (int64, error)
+	UpdateStatus(ctx context.Context, holdID int64, status string) error
+	ExpireStale(ctx context.Context) (int64, error)
+}
+
+type LedgerRepo interface {
+	CreateTransaction(ctx context.Context, tx model.LedgerTransaction) (model.LedgerTransaction, error)
+	CreateEntry(ctx context.Context, entry model.LedgerEntry) error
+	ListEntries(ctx context.Context, filter model.LedgerFilter) (model.LedgerPage, error)
+	ChangeSystemBalance(ctx context.Context, accountID int64, delta int64) (int64, error)
+	GetSystemBalanceForUpdate(ctx context.Context, accountID int64) (int64, error)
+	SumEntriesSince(ctx context.Context, accountID int64, since time.Time, kinds ...string) (model.EntryTotals, error)
+}
+
+type RewardRepo interface {
+	GetForUpdate(ctx context.Context, userID int64) (model.RewardState, error)
+	Update(ctx context.Context, state model.RewardState) error
+}
+
+type ResponsibleGamingRepo interface {
+	ListLimits(ctx context.Context, userID int64) ([]model.GamingLimit, error)
+	UpsertLimit(ctx context.Context, limit model.GamingLimit) error
+	GetSelfExclusion(ctx context.Context, userID int64) (*time.Time, error)
+	SetSelfExclusion(ctx context.Context, userID int64, until time.Time) error
+}
+
+type UserEventProducer interface {
+	PushChipTransfer(ctx context.Context, notification model.TransferNotification) error
+}
+
 type UserCache interface {
 	// Profile caching
 	Get(ctx context.Context, userID int64) (model.User, error)
@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"user_svc/internal/model"
+)
+
+const (
+	defaultTransactionsLimit = 20
+	maxTransactionsLimit     = 100
+)
+
+// GetTransactions returns a page of ledger entries of the user, newest first.
+func (uc *User) GetTransactions(ctx context.Context, filter model.LedgerFilter) (model.LedgerPage, error) {
+	if filter.AccountID <= 0 || filter.Offset < 0 || filter.Limit < 0 {
+		return model.LedgerPage{}, model.ErrInvalidInput
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultTransactionsLimit
+	}
+	if filter.Limit > maxTransactionsLimit {
+		filter.Limit = maxTransactionsLimit
+	}
+
+	return uc.ledgerRepo.ListEntries(ctx, filter)
+}
+
+// post records postings as one ledger transaction and applies them to account balances.
+// It must be called inside uc.callTx. The returned map holds the new balance of every
+// account touched. When the idempotency key was already used nothing is changed
+// and applied is false.
+func (uc *User) post(ctx context.Context, reference, idempotencyKey string, postings []model.Posting) (balances map[int64]int64, applied bool, err error) {
+	if len(postings) == 0 || idempotencyKey == "" {
+		return nil, false, model.ErrInvalidInput
+	}
+	var sum int64
+	for _, p := range postings {
+		if p.Amount == 0 {
+			return nil, false, model.ErrInvalidInput
+		}
+		sum += p.Amount
+	}
+	if sum != 0 {
+		return nil, false, fmt.Errorf("unbalanced ledger transaction: %w", model.ErrInvalidInput)
+	}
+
+	tx, err := uc.ledgerRepo.CreateTransaction(ctx, model.LedgerTransaction{
+		Reference:      reference,
+		IdempotencyKey: idempotencyKey,
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrConflict) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	// Lock rows in a stable order, users before system accounts, so concurrent postings can't deadlock.
+	ordered := make([]model.Posting, len(postings))
+	copy(ordered, postings)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		si, sj := model.IsSystemAccount(ordered[i].AccountID), model.IsSystemAccount(ordered[j].AccountID)
+		if si != sj {
+			return sj
+		}
+		return ordered[i].AccountID < ordered[j].AccountID
+	})
+
+	balances = make(map[int64]int64)
+	for _, p := range ordered {
+		entry := model.LedgerEntry{
+			TransactionID: tx.ID,
+			AccountID:     p.AccountID,
+			Kind:          p.Kind,
+			Amount:        p.Amount,
+		}
+		var balance int64
+		if model.IsSystemAccount(p.AccountID) {
+			balance, err = uc.ledgerRepo.ChangeSystemBalance(ctx, p.AccountID, p.Amount)
+		} else {
+			balance, err = uc.repo.ChangeBalance(ctx, p.AccountID, p.Amount)
+			if errors.Is(err, model.ErrNotFound) {
+				err = model.ErrUserNotFound
+			}
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		balances[p.AccountID] = balance
+		entry.BalanceAfter = &balance
+		if err := uc.ledgerRepo.CreateEntry(ctx, entry); err != nil {
+			return nil, false, err
+		}
+	}
+
+	return balances, true, nil
+}
+
+// recordOpeningBalance puts a balance that was set outside the ledger into it,
+// so the entries of the user always add up to the balance column.
+func (uc *User) recordOpeningBalance(ctx context.Context, userID int64, balance int64) error {
+	tx, err := uc.ledgerRepo.CreateTransaction(ctx, model.LedgerTransaction{
+		Reference:      "opening",
+		IdempotencyKey: fmt.Sprintf("opening:%d", userID),
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrConflict) {
+			return nil
+		}
+		return err
+	}
+
+	if err := uc.ledgerRepo.CreateEntry(ctx, model.LedgerEntry{
+		TransactionID: tx.ID,
+		AccountID:     userID,
+		Kind:          model.EntryKindOpeningBalance,
+		Amount:        balance,
+		BalanceAfter:  &balance,
+	}); err != nil {
+		return err
+	}
+	mintBalance, err := uc.ledgerRepo.ChangeSystemBalance(ctx, model.MintAccountID, -balance)
+	if err != nil {
+		return err
+	}
+	return uc.ledgerRepo.CreateEntry(ctx, model.LedgerEntry{
+		TransactionID: tx.ID,
+		AccountID:     model.MintAccountID,
+		Kind:          model.EntryKindOpeningBalance,
+		Amount:        -balance,
+		BalanceAfter:  &mintBalance,
+	})
+}
@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"user_svc/internal/model"
+)
+
+func newLedgerTestUser(balances map[int64]int64, ledger *fakeLedgerRepo) *User {
+	callTx := func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) }
+	return NewUser(
+		&fakeUserRepo{balances: balances},
+		&fakeHoldRepo{},
+		ledger,
+		nil,
+		nil,
+		callTx,
+		fakeCache{},
+		nil,
+		time.Minute,
+		model.RewardRules{},
+		model.TransferRules{},
+		model.ResponsibleGamingRules{},
+	)
+}
+
+func TestPostRejectsInvalidTransactions(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		postings []model.Posting
+	}{
+		{name: "no postings", key: "k"},
+		{name: "no idempotency key", postings: []model.Posting{{AccountID: 1, Amount: -10}, {AccountID: 2, Amount: 10}}},
+		{name: "zero amount", key: "k", postings: []model.Posting{{AccountID: 1, Amount: 0}, {AccountID: 2, Amount: 0}}},
+		{name: "unbalanced", key: "k", postings: []model.Posting{{AccountID: 1, Amount: -10}, {AccountID: 2, Amount: 9}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ledger := &fakeLedgerRepo{}
+			uc := newLedgerTestUser(map[int64]int64{1: 100, 2: 100}, ledger)
+			_, applied, err := uc.post(context.Background(), "test", tt.key, tt.postings)
+			if !errors.Is(err, model.ErrInvalidInput) {
+				t.Fatalf("post = %v, want ErrInvalidInput", err)
+			}
+			if applied || ledger.txs != 0 || len(ledger.entries) != 0 {
+				t.Errorf("rejected post wrote %d transactions and %d entries", ledger.txs, len(ledger.entries))
+			}
+		})
+	}
+}
+
+func TestPostAppliesBalancedTransactionOnce(t *testing.T) {
+	ctx := context.Background()
+	ledger := &fakeLedgerRepo{}
+	uc := newLedgerTestUser(map[int64]int64{1: 100, 2: 50}, ledger)
+	postings := []model.Posting{
+		{AccountID: model.HouseAccountID, Kind: model.EntryKindRake, Amount: 5},
+		{AccountID: 2, Kind: model.EntryKindWinnings, Amount: 45},
+		{AccountID: 1, Kind: model.EntryKindGameStake, Amount: -50},
+	}
+
+	balances, applied, err := uc.post(ctx, "game-1", "settle:game-1", postings)
+	if err != nil || !applied {
+		t.Fatalf("post = (%v, %v), want applied", applied, err)
+	}
+	want := map[int64]int64{1: 50, 2: 95, model.HouseAccountID: 5}
+	for account, balance := range want {
+		if balances[account] != balance {
+			t.Errorf("balance of account %d = %d, want %d", account, balances[account], balance)
+		}
+	}
+	var sum int64
+	for _, e := range ledger.entries {
+		sum += e.Amount
+		if e.TransactionID != 1 {
+			t.Errorf("entry of account %d belongs to transaction %d, want 1", e.AccountID, e.TransactionID)
+		}
+	}
+	if len(ledger.entries) != len(postings) || sum != 0 {
+		t.Errorf("got %d entries summing to %d, want %d entries summing to 0", len(ledger.entries), sum, len(postings))
+	}
+	// Users are locked before system accounts, each in ascending ID order
+	order := []int64{1, 2, model.HouseAccountID}
+	for i, e := range ledger.entries {
+		if e.AccountID != order[i] {
+			t.Errorf("entry %d is for account %d, want %d", i, e.AccountID, order[i])
+		}
+	}
+
+	// The same key again changes nothing
+	balances, applied, err = uc.post(ctx, "game-1", "settle:game-1", postings)
+	if err != nil || applied || balances != nil {
+		t.Fatalf("repeated post = (%v, %v, %v), want not applied", balances, applied, err)
+	}
+	if len(ledger.entries) != len(postings) {
+		t.Errorf("repeated post added entries: got %d, want %d", len(ledger.entries), len(postings))
+	}
+	users := uc.repo.(*fakeUserRepo)
+	if users.balances[1] != 50 || users.balances[2] != 95 || ledger.system[model.HouseAccountID] != 5 {
+		t.Errorf("balances after the repeated post = %v, house %d", users.balances, ledger.system[model.HouseAccountID])
+	}
+}
+
+func TestAdminAdjustmentKeysAreScopedToTheUser(t *testing.T) {
+	ctx := context.Background()
+	uc := newLedgerTestUser(map[int64]int64{1: 100, 2: 100}, &fakeLedgerRepo{})
+
+	for _, userID := range []int64{1, 2, 1} {
+		if err := uc.AddBalance(ctx, userID, 10, "refund-42"); err != nil {
+			t.Fatalf("AddBalance(%d): %v", userID, err)
+		}
+	}
+	// The same key credits each user once
+	users := uc.repo.(*fakeUserRepo)
+	if users.balances[1] != 110 || users.balances[2] != 110 {
+		t.Errorf("balances = %v, want 110 for both users", users.balances)
+	}
+}
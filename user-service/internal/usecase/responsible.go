@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"user_svc/internal/model"
+)
+
+// GetResponsibleGaming returns the limits in force for the user and the end of the self-exclusion, if any.
+func (uc *User) GetResponsibleGaming(ctx context.Context, userID int64) (model.ResponsibleGaming, error) {
+	if userID <= 0 {
+		return model.ResponsibleGaming{}, model.ErrInvalidInput
+	}
+	now := time.Now()
+
+	stored, err := uc.gamingRepo.ListLimits(ctx, userID)
+	if err != nil {
+		return model.ResponsibleGaming{}, err
+	}
+	settings := model.ResponsibleGaming{UserID: userID, Limits: make([]model.GamingLimit, 0, len(model.LimitKinds))}
+	for _, kind := range model.LimitKinds {
+		limit := model.GamingLimit{UserID: userID, Kind: kind}
+		for _, l := range stored {
+			if l.Kind == kind {
+				limit = effectiveLimit(l, now)
+			}
+		}
+		settings.Limits = append(settings.Limits, limit)
+	}
+
+	until, err := uc.gamingRepo.GetSelfExclusion(ctx, userID)
+	if err != nil {
+		return model.ResponsibleGaming{}, err
+	}
+	if until != nil && until.After(now) {
+		settings.SelfExcludedUntil = until
+	}
+
+	return settings, nil
+}
+
+// SetGamingLimit changes one limit of the user. A stricter limit applies at once,
+// a looser one only after the cooling-off period, so it can't be lifted in the heat of a game.
+func (uc *User) SetGamingLimit(ctx context.Context, userID int64, kind string, value int64) (model.ResponsibleGaming, error) {
+	if userID <= 0 || value < 0 || !slices.Contains(model.LimitKinds, kind) {
+		return model.ResponsibleGaming{}, model.ErrInvalidInput
+	}
+
+	settings, err := uc.GetResponsibleGaming(ctx, userID)
+	if err != nil {
+		return model.ResponsibleGaming{}, err
+	}
+	current := settings.Limit(kind)
+
+	limit := model.GamingLimit{UserID: userID, Kind: kind, Value: value}
+	if !isStricterLimit(current, value) {
+		pendingFrom := time.Now().Add(uc.gaming.CoolingOff)
+		limit.Value = current
+		limit.PendingValue = &value
+		limit.PendingFrom = &pendingFrom
+	}
+	if err := uc.gamingRepo.UpsertLimit(ctx, limit); err != nil {
+		return model.ResponsibleGaming{}, err
+	}
+
+	return uc.GetResponsibleGaming(ctx, userID)
+}
+
+// SelfExclude blocks the user from playing for the given duration. An exclusion
+// can be extended but never shortened.
+func (uc *User) SelfExclude(ctx context.Context, userID int64, duration time.Duration) (model.ResponsibleGaming, error) {
+	if userID <= 0 || duration <= 0 || duration > uc.gaming.MaxSelfExclusion {
+		return model.ResponsibleGaming{}, model.ErrInvalidInput
+	}
+	if err := uc.gamingRepo.SetSelfExclusion(ctx, userID, time.Now().Add(duration)); err != nil {
+		return model.ResponsibleGaming{}, err
+	}
+	return uc.GetResponsibleGaming(ctx, userID)
+}
+
+// CheckPlayAllowed reports whether the user may start a game risking amount chips.
+func (uc *User) CheckPlayAllowed(ctx context.Context, userID int64, amount int64) error {
+	if userID <= 0 || amount < 0 {
+		return model.ErrInvalidInput
+	}
+	return uc.checkPlayAllowed(ctx, userID, amount)
+}
+
+func (uc *User) checkPlayAllowed(ctx context.Context, userID int64, amount int64) error {
+	settings, err := uc.GetResponsibleGaming(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if settings.SelfExcludedUntil != nil {
+		return model.ErrSelfExcluded
+	}
+
+	now := time.Now().UTC()
+	periods := []struct {
+		since      time.Time
+		lossLimit  int64
+		wagerLimit int64
+	}{
+		{now.Truncate(24 * time.Hour), settings.Limit(model.LimitDailyLoss), settings.Limit(model.LimitDailyWager)},
+		{weekStart(now), settings.Limit(model.LimitWeeklyLoss), settings.Limit(model.LimitWeeklyWager)},
+	}
+	for _, p := range periods {
+		if p.lossLimit > 0 {
+			totals, err := uc.ledgerRepo.SumEntriesSince(ctx, userID, p.since, model.EntryKindGameStake, model.EntryKindWinnings, model.EntryKindJackpot)
+			if err != nil {
+				return err
+			}
+			// The whole amount can still be lost, so it counts against the limit up front.
+			if lost := max(totals.Debit-totals.Credit, 0); lost+amount > p.lossLimit {
+				return model.ErrLossLimitReached
+			}
+		}
+		if p.wagerLimit > 0 {
+			wagered, err := uc.holdRepo.SumPlacedSince(ctx, userID, p.since)
+			if err != nil {
+				return err
+			}
+			if wagered+amount > p.wagerLimit {
+				return model.ErrWagerLimitReached
+			}
+		}
+	}
+
+	return nil
+}
+
+// effectiveLimit applies a pending change once its cooling-off period has passed.
+func effectiveLimit(limit model.GamingLimit, now time.Time) model.GamingLimit {
+	if limit.PendingFrom != nil && !now.Before(*limit.PendingFrom) {
+		limit.Value = *limit.PendingValue
+		limit.PendingValue = nil
+		limit.PendingFrom = nil
+	}
+	return limit
+}
+
+// isStricterLimit reports whether next restricts play at least as much as current. 0 means no limit.
+func isStricterLimit(current, next int64) bool {
+	switch {
+	case next == current:
+		return true
+	case next == 0:
+		return false
+	case current == 0:
+		return true
+	default:
+		return next < current
+	}
+}
+
+// weekStart returns the start of the UTC week (Monday) containing t.
+func weekStart(t time.Time) time.Time {
+	day := t.UTC().Truncate(24 * time.Hour)
+	return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+}
@@ -0,0 +1,199 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"user_svc/internal/model"
+)
+
+type fakeGamingRepo struct {
+	limits       map[string]model.GamingLimit
+	excludedTill *time.Time
+}
+
+func (r *fakeGamingRepo) ListLimits(_ context.Context, _ int64) ([]model.GamingLimit, error) {
+	var limits []model.GamingLimit
+	for _, l := range r.limits {
+		limits = append(limits, l)
+	}
+	return limits, nil
+}
+
+func (r *fakeGamingRepo) UpsertLimit(_ context.Context, limit model.GamingLimit) error {
+	r.limits[limit.Kind] = limit
+	return nil
+}
+
+func (r *fakeGamingRepo) GetSelfExclusion(context.Context, int64) (*time.Time, error) {
+	return r.excludedTill, nil
+}
+
+func (r *fakeGamingRepo) SetSelfExclusion(_ context.Context, _ int64, until time.Time) error {
+	r.excludedTill = &until
+	return nil
+}
+
+func newGamingTestUser(gaming *fakeGamingRepo, ledger *fakeLedgerRepo, holds ...model.ChipHold) *User {
+	callTx := func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) }
+	return NewUser(
+		&fakeUserRepo{balances: map[int64]int64{1: 1000}},
+		&fakeHoldRepo{holds: holds},
+		ledger,
+		nil,
+		gaming,
+		callTx,
+		fakeCache{},
+		nil,
+		time.Minute,
+		model.RewardRules{},
+		model.TransferRules{},
+		model.ResponsibleGamingRules{CoolingOff: 24 * time.Hour, MaxSelfExclusion: 30 * 24 * time.Hour},
+	)
+}
+
+func TestIsStricterLimit(t *testing.T) {
+	tests := []struct {
+		current, next int64
+		want          bool
+	}{
+		{current: 0, next: 0, want: true},
+		{current: 100, next: 100, want: true},
+		{current: 100, next: 50, want: true},
+		{current: 0, next: 50, want: true},
+		{current: 100, next: 150, want: false},
+		{current: 100, next: 0, want: false},
+	}
+	for _, tt := range tests {
+		if got := isStricterLimit(tt.current, tt.next); got != tt.want {
+			t.Errorf("isStricterLimit(%d, %d) = %v, want %v", tt.current, tt.next, got, tt.want)
+		}
+	}
+}
+
+func TestSetGamingLimitLoosensOnlyAfterCoolingOff(t *testing.T) {
+	ctx := context.Background()
+	gaming := &fakeGamingRepo{limits: map[string]model.GamingLimit{}}
+	uc := newGamingTestUser(gaming, &fakeLedgerRepo{})
+
+	settings, err := uc.SetGamingLimit(ctx, 1, model.LimitDailyLoss, 100)
+	if err != nil {
+		t.Fatalf("SetGamingLimit: %v", err)
+	}
+	if got := settings.Limit(model.LimitDailyLoss); got != 100 {
+		t.Fatalf("a new limit is %d, want 100 at once", got)
+	}
+	if settings, _ = uc.SetGamingLimit(ctx, 1, model.LimitDailyLoss, 50); settings.Limit(model.LimitDailyLoss) != 50 {
+		t.Fatalf("a stricter limit is %d, want 50 at once", settings.Limit(model.LimitDailyLoss))
+	}
+
+	// Lifting the limit waits for the cooling-off period
+	for _, next := range []int64{500, 0} {
+		settings, err = uc.SetGamingLimit(ctx, 1, model.LimitDailyLoss, next)
+		if err != nil {
+			t.Fatalf("SetGamingLimit(%d): %v", next, err)
+		}
+		if got := settings.Limit(model.LimitDailyLoss); got != 50 {
+			t.Errorf("limit right after loosening it to %d is %d, want 50", next, got)
+		}
+		stored := gaming.limits[model.LimitDailyLoss]
+		if stored.PendingValue == nil || *stored.PendingValue != next || time.Until(*stored.PendingFrom) < 23*time.Hour {
+			t.Errorf("pending change = %+v, want %d in a day", stored, next)
+		}
+	}
+
+	past := time.Now().Add(-time.Minute)
+	stored := gaming.limits[model.LimitDailyLoss]
+	stored.PendingFrom = &past
+	gaming.limits[model.LimitDailyLoss] = stored
+	if settings, _ = uc.GetResponsibleGaming(ctx, 1); settings.Limit(model.LimitDailyLoss) != 0 {
+		t.Errorf("limit after the cooling-off is %d, want it lifted", settings.Limit(model.LimitDailyLoss))
+	}
+}
+
+func TestCheckPlayAllowed(t *testing.T) {
+	now := time.Now()
+	tomorrow, yesterday := now.Add(24*time.Hour), now.Add(-24*time.Hour)
+	limit := func(kind string, value int64) map[string]model.GamingLimit {
+		return map[string]model.GamingLimit{kind: {UserID: 1, Kind: kind, Value: value}}
+	}
+	// Lost 100, won 20 back today
+	results := []model.LedgerEntry{
+		{AccountID: 1, Kind: model.EntryKindGameStake, Amount: -100},
+		{AccountID: 1, Kind: model.EntryKindWinnings, Amount: 20},
+	}
+	tests := []struct {
+		name         string
+		limits       map[string]model.GamingLimit
+		excludedTill *time.Time
+		entries      []model.LedgerEntry
+		holds        []model.ChipHold
+		amount       int64
+		wantErr      error
+	}{
+		{name: "no limits", amount: 500},
+		{name: "self-excluded", excludedTill: &tomorrow, amount: 10, wantErr: model.ErrSelfExcluded},
+		{name: "self-exclusion is over", excludedTill: &yesterday, amount: 10},
+		{name: "within the daily loss limit", limits: limit(model.LimitDailyLoss, 100), entries: results, amount: 20},
+		{name: "over the daily loss limit", limits: limit(model.LimitDailyLoss, 100), entries: results, amount: 21, wantErr: model.ErrLossLimitReached},
+		{name: "over the weekly loss limit", limits: limit(model.LimitWeeklyLoss, 90), entries: results, amount: 20, wantErr: model.ErrLossLimitReached},
+		{
+			name:    "over the daily wager limit",
+			limits:  limit(model.LimitDailyWager, 200),
+			holds:   []model.ChipHold{gameHold(1, 1, 150, "g1")},
+			amount:  60,
+			wantErr: model.ErrWagerLimitReached,
+		},
+		{
+			name:   "within the weekly wager limit",
+			limits: limit(model.LimitWeeklyWager, 200),
+			holds:  []model.ChipHold{gameHold(1, 1, 150, "g1")},
+			amount: 50,
+		},
+		{
+			// A loosened limit still holds during its cooling-off
+			name: "pending loosening",
+			limits: map[string]model.GamingLimit{model.LimitDailyLoss: {
+				UserID: 1, Kind: model.LimitDailyLoss, Value: 100, PendingValue: new(int64), PendingFrom: &tomorrow,
+			}},
+			entries: results,
+			amount:  50,
+			wantErr: model.ErrLossLimitReached,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limits := tt.limits
+			if limits == nil {
+				limits = map[string]model.GamingLimit{}
+			}
+			gaming := &fakeGamingRepo{limits: limits, excludedTill: tt.excludedTill}
+			uc := newGamingTestUser(gaming, &fakeLedgerRepo{entries: tt.entries}, tt.holds...)
+			if err := uc.checkPlayAllowed(context.Background(), 1, tt.amount); !errors.Is(err, tt.wantErr) {
+				t.Errorf("checkPlayAllowed(%d) = %v, want %v", tt.amount, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSelfExcludeBlocksPlay(t *testing.T) {
+	ctx := context.Background()
+	gaming := &fakeGamingRepo{limits: map[string]model.GamingLimit{}}
+	uc := newGamingTestUser(gaming, &fakeLedgerRepo{})
+
+	if _, err := uc.SelfExclude(ctx, 1, 31*24*time.Hour); !errors.Is(err, model.ErrInvalidInput) {
+		t.Fatalf("SelfExclude above the maximum = %v, want ErrInvalidInput", err)
+	}
+	settings, err := uc.SelfExclude(ctx, 1, 7*24*time.Hour)
+	if err != nil {
+		t.Fatalf("SelfExclude: %v", err)
+	}
+	if settings.SelfExcludedUntil == nil || time.Until(*settings.SelfExcludedUntil) < 6*24*time.Hour {
+		t.Errorf("self-excluded until %v, want a week from now", settings.SelfExcludedUntil)
+	}
+	if err := uc.CheckPlayAllowed(ctx, 1, 10); !errors.Is(err, model.ErrSelfExcluded) {
+		t.Errorf("CheckPlayAllowed during self-exclusion = %v, want ErrSelfExcluded", err)
+	}
+}
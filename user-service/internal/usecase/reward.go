@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"user_svc/internal/model"
+)
+
+// ClaimDailyBonus credits the daily bonus. Claiming on consecutive UTC days grows
+// the streak and with it the multiplier, missing a day starts the streak over.
+func (uc *User) ClaimDailyBonus(ctx context.Context, userID int64) (model.RewardClaim, error) {
+	now := time.Now().UTC()
+	today := now.Truncate(24 * time.Hour)
+
+	var claim model.RewardClaim
+	txFn := func(ctx context.Context) error {
+		if _, err := uc.lockIdleBalance(ctx, userID); err != nil {
+			return err
+		}
+		state, err := uc.rewardRepo.GetForUpdate(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case state.LastDailyClaimAt == nil:
+			state.DailyStreak = 1
+		case !state.LastDailyClaimAt.UTC().Truncate(24 * time.Hour).Before(today):
+			return model.ErrAlreadyClaimed
+		case state.LastDailyClaimAt.UTC().Truncate(24 * time.Hour).Equal(today.AddDate(0, 0, -1)):
+			state.DailyStreak++
+		default:
+			state.DailyStreak = 1
+		}
+		state.LastDailyClaimAt = &now
+
+		amount := dailyBonusAmount(uc.rewards, state.DailyStreak)
+		balances, _, err := uc.post(ctx, "daily_bonus", fmt.Sprintf("daily:%d:%s", userID, today.Format(time.DateOnly)), []model.Posting{
+			{AccountID: userID, Kind: model.EntryKindDailyBonus, Amount: amount},
+			{AccountID: model.MintAccountID, Kind: model.EntryKindDailyBonus, Amount: -amount},
+		})
+		if err != nil {
+			return err
+		}
+		if err := uc.rewardRepo.Update(ctx, state); err != nil {
+			return err
+		}
+
+		claim = model.RewardClaim{Amount: amount, Balance: balances[userID], DailyStreak: state.DailyStreak}
+		return nil
+	}
+	if err := uc.callTx(ctx, txFn); err != nil {
+		return model.RewardClaim{}, fmt.Errorf("claim daily bonus transaction failed: %w", err)
+	}
+
+	_ = uc.cache.SetBalance(ctx, userID, claim.Balance)
+	return claim, nil
+}
+
+// ClaimRefill tops the balance up to the refill amount for players who are almost out of chips.
+func (uc *User) ClaimRefill(ctx context.Context, userID int64) (model.RewardClaim, error) {
+	now := time.Now().UTC()
+
+	var claim model.RewardClaim
+	txFn := func(ctx context.Context) error {
+		balance, err := uc.lockIdleBalance(ctx, userID)
+		if err != nil {
+			return err
+		}
+		amount := uc.rewards.RefillTo - balance
+		if balance >= uc.rewards.RefillThreshold || amount <= 0 {
+			return model.ErrRefillNotAvailable
+		}
+
+		state, err := uc.rewardRepo.GetForUpdate(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if state.LastRefillAt != nil && now.Sub(*state.LastRefillAt) < uc.rewards.RefillCooldown {
+			return model.ErrRefillCooldown
+		}
+		state.LastRefillAt = &now
+
+		balances, _, err := uc.post(ctx, "refill", fmt.Sprintf("refill:%d:%d", userID, now.Unix()), []model.Posting{
+			{AccountID: userID, Kind: model.EntryKindRefill, Amount: amount},
+			{AccountID: model.MintAccountID, Kind: model.EntryKindRefill, Amount: -amount},
+		})
+		if err != nil {
+			return err
+		}
+		if err := uc.rewardRepo.Update(ctx, state); err != nil {
+			return err
+		}
+
+		claim = model.RewardClaim{Amount: amount, Balance: balances[userID], DailyStreak: state.DailyStreak}
+		return nil
+	}
+	if err := uc.callTx(ctx, txFn); err != nil {
+		return model.RewardClaim{}, fmt.Errorf("claim refill transaction failed: %w", err)
+	}
+
+	_ = uc.cache.SetBalance(ctx, userID, claim.Balance)
+	return claim, nil
+}
+
+// CreditReward credits a reward earned outside user-service, e.g. a mission prize. The reason code
+// is the idempotency key: a reward is credited once per user and reason however often it is claimed.
+func (uc *User) CreditReward(ctx context.Context, userID, amount int64, reason string) (model.RewardCredit, error) {
+	if amount <= 0 || reason == "" {
+		return model.RewardCredit{}, model.ErrInvalidInput
+	}
+
+	var credit model.RewardCredit
+	txFn := func(ctx context.Context) error {
+		balance, err := uc.repo.GetBalanceForUpdate(ctx, userID)
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				return model.ErrUserNotFound
+			}
+			return err
+		}
+
+		balances, applied, err := uc.post(ctx, reason, fmt.Sprintf("reward:%d:%s", userID, reason), []model.Posting{
+			{AccountID: userID, Kind: model.EntryKindReward, Amount: amount},
+			{AccountID: model.MintAccountID, Kind: model.EntryKindReward, Amount: -amount},
+		})
+		if err != nil {
+			return err
+		}
+		credit = model.RewardCredit{Balance: balance, AlreadyCredited: !applied}
+		if applied {
+			credit.Balance = balances[userID]
+		}
+		return nil
+	}
+	if err := uc.callTx(ctx, txFn); err != nil {
+		return model.RewardCredit{}, fmt.Errorf("credit reward transaction failed: %w", err)
+	}
+
+	if !credit.AlreadyCredited {
+		_ = uc.cache.SetBalance(ctx, userID, credit.Balance)
+	}
+	return credit, nil
+}
+
+// lockIdleBalance locks the user's balance and makes sure none of it is held by a running game.
+// Tournament buy-ins don't count: they are held until the tournament is over, which can take a day.
+func (uc *User) lockIdleBalance(ctx context.Context, userID int64) (int64, error) {
+	balance, err := uc.repo.GetBalanceForUpdate(ctx, userID)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return 0, model.ErrUserNotFound
+		}
+		return 0, err
+	}
+	holds, err := uc.holdRepo.ListActive(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	for _, hold := range holds {
+		if !hold.IsTournamentBuyIn() {
+			return 0, model.ErrChipsHeld
+		}
+	}
+	return balance, nil
+}
+
+func dailyBonusAmount(rules model.RewardRules, streak int64) int64 {
+	multiplier := 1.0
+	if n := int64(len(rules.DailyStreakMultipliers)); n > 0 {
+		multiplier = rules.DailyStreakMultipliers[min(streak, n)-1]
+	}
+	return int64(math.Round(float64(rules.DailyBonus) * multiplier))
+}
@@ -0,0 +1,225 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"user_svc/internal/model"
+)
+
+// Fakes implement only what the reward claims use; any other call panics on the nil interface.
+
+type fakeUserRepo struct {
+	UserRepo
+	balances map[int64]int64
+	created  map[int64]time.Time
+}
+
+func (r *fakeUserRepo) GetWithFilter(_ context.Context, filter model.UserFilter) (model.User, error) {
+	createdAt, ok := r.created[*filter.ID]
+	if !ok {
+		return model.User{}, model.ErrNotFound
+	}
+	return model.User{ID: *filter.ID, CreatedAt: createdAt}, nil
+}
+
+func (r *fakeUserRepo) GetBalanceForUpdate(_ context.Context, userID int64) (int64, error) {
+	balance, ok := r.balances[userID]
+	if !ok {
+		return 0, model.ErrNotFound
+	}
+	return balance, nil
+}
+
+func (r *fakeUserRepo) ChangeBalance(_ context.Context, userID int64, delta int64) (int64, error) {
+	r.balances[userID] += delta
+	return r.balances[userID], nil
+}
+
+type fakeHoldRepo struct {
+	HoldRepo
+	holds []model.ChipHold
+}
+
+func (r *fakeHoldRepo) SumActive(ctx context.Context, userID int64) (int64, error) {
+	active, _ := r.ListActive(ctx, userID)
+	var sum int64
+	for _, h := range active {
+		sum += h.Amount
+	}
+	return sum, nil
+}
+
+// SumPlacedSince treats every hold as placed today.
+func (r *fakeHoldRepo) SumPlacedSince(_ context.Context, userID int64, _ time.Time) (int64, error) {
+	var sum int64
+	for _, h := range r.holds {
+		if h.UserID == userID {
+			sum += h.Amount
+		}
+	}
+	return sum, nil
+}
+
+func (r *fakeHoldRepo) GetForUpdate(_ context.Context, userID int64, reference string) (model.ChipHold, error) {
+	for _, h := range r.holds {
+		if h.UserID == userID && h.Reference == reference {
+			return h, nil
+		}
+	}
+	return model.ChipHold{}, model.ErrHoldNotFound
+}
+
+func (r *fakeHoldRepo) UpdateStatus(_ context.Context, holdID int64, status string) error {
+	for i := range r.holds {
+		if r.holds[i].ID == holdID {
+			r.holds[i].Status = status
+		}
+	}
+	return nil
+}
+
+func (r *fakeHoldRepo) ListActive(_ context.Context, userID int64) ([]model.ChipHold, error) {
+	var active []model.ChipHold
+	for _, h := range r.holds {
+		if h.UserID == userID && h.Status == model.HoldStatusHeld {
+			active = append(active, h)
+		}
+	}
+	return active, nil
+}
+
+// fakeLedgerRepo rejects a reused idempotency key like the unique index does and keeps the entries it got.
+type fakeLedgerRepo struct {
+	LedgerRepo
+	txs     int64
+	keys    map[string]bool
+	entries []model.LedgerEntry
+	system  map[int64]int64
+}
+
+func (r *fakeLedgerRepo) CreateTransaction(_ context.Context, tx model.LedgerTransaction) (model.LedgerTransaction, error) {
+	if r.keys == nil {
+		r.keys = make(map[string]bool)
+	}
+	if r.keys[tx.IdempotencyKey] {
+		return model.LedgerTransaction{}, model.ErrConflict
+	}
+	r.keys[tx.IdempotencyKey] = true
+	r.txs++
+	tx.ID = r.txs
+	return tx, nil
+}
+
+func (r *fakeLedgerRepo) CreateEntry(_ context.Context, entry model.LedgerEntry) error {
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func (r *fakeLedgerRepo) ChangeSystemBalance(_ context.Context, accountID int64, delta int64) (int64, error) {
+	if r.system == nil {
+		r.system = make(map[int64]int64)
+	}
+	r.system[accountID] += delta
+	return r.system[accountID], nil
+}
+
+// SumEntriesSince treats every entry as made today.
+func (r *fakeLedgerRepo) SumEntriesSince(_ context.Context, accountID int64, _ time.Time, kinds ...string) (model.EntryTotals, error) {
+	var totals model.EntryTotals
+	for _, e := range r.entries {
+		if e.AccountID != accountID || !slices.Contains(kinds, e.Kind) {
+			continue
+		}
+		if e.Amount < 0 {
+			totals.Debit -= e.Amount
+		} else {
+			totals.Credit += e.Amount
+		}
+	}
+	return totals, nil
+}
+
+func (r *fakeLedgerRepo) GetSystemBalanceForUpdate(_ context.Context, accountID int64) (int64, error) {
+	return r.system[accountID], nil
+}
+
+type fakeRewardRepo struct {
+	states map[int64]model.RewardState
+}
+
+func (r *fakeRewardRepo) GetForUpdate(_ context.Context, userID int64) (model.RewardState, error) {
+	state, ok := r.states[userID]
+	if !ok {
+		state = model.RewardState{UserID: userID}
+	}
+	return state, nil
+}
+
+func (r *fakeRewardRepo) Update(_ context.Context, state model.RewardState) error {
+	r.states[state.UserID] = state
+	return nil
+}
+
+type fakeCache struct{ UserCache }
+
+func (fakeCache) SetBalance(context.Context, int64, int64) error { return nil }
+
+func newRewardTestUser(balance int64, holds ...model.ChipHold) *User {
+	callTx := func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) }
+	return NewUser(
+		&fakeUserRepo{balances: map[int64]int64{1: balance}},
+		&fakeHoldRepo{holds: holds},
+		&fakeLedgerRepo{},
+		&fakeRewardRepo{states: map[int64]model.RewardState{}},
+		nil,
+		callTx,
+		fakeCache{},
+		nil,
+		time.Minute,
+		model.RewardRules{DailyBonus: 100, RefillThreshold: 50, RefillTo: 500, RefillCooldown: time.Hour},
+		model.TransferRules{},
+		model.ResponsibleGamingRules{},
+	)
+}
+
+func activeHold(reference string, amount int64) model.ChipHold {
+	return model.ChipHold{UserID: 1, Amount: amount, Reference: reference, Status: model.HoldStatusHeld, ExpiresAt: time.Now().Add(24 * time.Hour)}
+}
+
+func TestClaimsIgnoreTournamentBuyIn(t *testing.T) {
+	ctx := context.Background()
+	uc := newRewardTestUser(40, activeHold(model.TournamentHoldPrefix+"t1", 20))
+
+	claim, err := uc.ClaimDailyBonus(ctx, 1)
+	if err != nil {
+		t.Fatalf("ClaimDailyBonus with a tournament buy-in held: %v", err)
+	}
+	if claim.Amount != 100 || claim.Balance != 140 {
+		t.Fatalf("ClaimDailyBonus = %+v, want amount 100 and balance 140", claim)
+	}
+
+	uc = newRewardTestUser(40, activeHold(model.TournamentHoldPrefix+"t1", 20))
+	claim, err = uc.ClaimRefill(ctx, 1)
+	if err != nil {
+		t.Fatalf("ClaimRefill with a tournament buy-in held: %v", err)
+	}
+	if claim.Amount != 460 || claim.Balance != 500 {
+		t.Fatalf("ClaimRefill = %+v, want amount 460 and balance 500", claim)
+	}
+}
+
+func TestClaimsRejectedWhileGameStakeHeld(t *testing.T) {
+	ctx := context.Background()
+	holds := []model.ChipHold{activeHold(model.TournamentHoldPrefix+"t1", 20), activeHold("game-1", 10)}
+
+	if _, err := newRewardTestUser(40, holds...).ClaimDailyBonus(ctx, 1); !errors.Is(err, model.ErrChipsHeld) {
+		t.Fatalf("ClaimDailyBonus during a game = %v, want ErrChipsHeld", err)
+	}
+	if _, err := newRewardTestUser(40, holds...).ClaimRefill(ctx, 1); !errors.Is(err, model.ErrChipsHeld) {
+		t.Fatalf("ClaimRefill during a game = %v, want ErrChipsHeld", err)
+	}
+}
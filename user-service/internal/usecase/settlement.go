@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"user_svc/internal/model"
+)
+
+// SettleMatch applies per-player deltas of a match in one transaction and releases
+// the chips held for it. Deltas and the rake must sum to zero; a repeated call for
+// the same game changes nothing and returns current balances.
+func (uc *User) SettleMatch(ctx context.Context, settlement model.Settlement) (model.SettlementResult, error) {
+	if settlement.GameID == "" || len(settlement.Deltas) == 0 {
+		return model.SettlementResult{}, model.ErrInvalidInput
+	}
+	if settlement.Rake < 0 || settlement.JackpotContribution < 0 || settlement.JackpotContribution > settlement.Rake {
+		return model.SettlementResult{}, model.ErrInvalidInput
+	}
+
+	postings := make([]model.Posting, 0, len(settlement.Deltas))
+	seen := make(map[int64]struct{}, len(settlement.Deltas))
+	for _, d := range settlement.Deltas {
+		if d.UserID <= 0 {
+			return model.SettlementResult{}, model.ErrInvalidInput
+		}
+		if _, ok := seen[d.UserID]; ok {
+			return model.SettlementResult{}, model.ErrInvalidInput
+		}
+		seen[d.UserID] = struct{}{}
+
+		switch {
+		case d.Delta < 0:
+			postings = append(postings, model.Posting{AccountID: d.UserID, Kind: model.EntryKindGameStake, Amount: d.Delta})
+		case d.Delta > 0:
+			postings = append(postings, model.Posting{AccountID: d.UserID, Kind: model.EntryKindWinnings, Amount: d.Delta})
+		}
+	}
+	if houseCut := settlement.Rake - settlement.JackpotContribution; houseCut > 0 {
+		postings = append(postings, model.Posting{AccountID: model.HouseAccountID, Kind: model.EntryKindRake, Amount: houseCut})
+	}
+	if settlement.JackpotContribution > 0 {
+		postings = append(postings, model.Posting{AccountID: model.JackpotAccountID, Kind: model.EntryKindJackpotFunding, Amount: settlement.JackpotContribution})
+	}
+	if _, ok := seen[settlement.JackpotWinnerID]; settlement.JackpotWinnerID != 0 && !ok {
+		return model.SettlementResult{}, model.ErrInvalidInput
+	}
+
+	var result model.SettlementResult
+	txFn := func(ctx context.Context) error {
+		for _, d := range settlement.Deltas {
+			if err := uc.releaseHoldTx(ctx, d.UserID, settlement.GameID); err != nil {
+				return err
+			}
+		}
+
+		balances := make(map[int64]int64)
+		if len(postings) > 0 {
+			var applied bool
+			var err error
+			balances, applied, err = uc.post(ctx, settlement.GameID, "settle:"+settlement.GameID, postings)
+			if err != nil {
+				return err
+			}
+			result.AlreadySettled = !applied
+		}
+
+		if settlement.JackpotWinnerID != 0 && !result.AlreadySettled {
+			payout, winnerBalance, err := uc.payJackpot(ctx, settlement.GameID, settlement.JackpotWinnerID)
+			if err != nil {
+				return err
+			}
+			if payout > 0 {
+				result.JackpotPayout = payout
+				balances[settlement.JackpotWinnerID] = winnerBalance
+			}
+		}
+
+		result.Balances = make([]model.PlayerBalance, 0, len(settlement.Deltas))
+		for _, d := range settlement.Deltas {
+			balance, ok := balances[d.UserID]
+			if !ok {
+				var err error
+				if balance, err = uc.repo.GetBalanceForUpdate(ctx, d.UserID); err != nil {
+					if errors.Is(err, model.ErrNotFound) {
+						return model.ErrUserNotFound
+					}
+					return err
+				}
+			}
+			result.Balances = append(result.Balances, model.PlayerBalance{UserID: d.UserID, Balance: balance})
+		}
+		return nil
+	}
+	if err := uc.callTx(ctx, txFn); err != nil {
+		return model.SettlementResult{}, fmt.Errorf("settle match transaction failed: %w", err)
+	}
+
+	for _, b := range result.Balances {
+		_ = uc.cache.SetBalance(ctx, b.UserID, b.Balance)
+	}
+	return result, nil
+}
+
+// payJackpot moves the whole jackpot pool to the winner. It must be called inside uc.callTx.
+func (uc *User) payJackpot(ctx context.Context, gameID string, winnerID int64) (payout int64, winnerBalance int64, err error) {
+	pool, err := uc.ledgerRepo.GetSystemBalanceForUpdate(ctx, model.JackpotAccountID)
+	if err != nil {
+		return 0, 0, err
+	}
+	if pool <= 0 {
+		return 0, 0, nil
+	}
+
+	balances, applied, err := uc.post(ctx, gameID, "jackpot:"+gameID, []model.Posting{
+		{AccountID: winnerID, Kind: model.EntryKindJackpot, Amount: pool},
+		{AccountID: model.JackpotAccountID, Kind: model.EntryKindJackpot, Amount: -pool},
+	})
+	if err != nil || !applied {
+		return 0, 0, err
+	}
+	return pool, balances[winnerID], nil
+}
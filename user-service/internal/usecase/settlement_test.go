@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"user_svc/internal/model"
+)
+
+func newSettlementTestUser(balances map[int64]int64, holds []model.ChipHold, ledger *fakeLedgerRepo) *User {
+	callTx := func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) }
+	return NewUser(
+		&fakeUserRepo{balances: balances},
+		&fakeHoldRepo{holds: holds},
+		ledger,
+		nil,
+		nil,
+		callTx,
+		fakeCache{},
+		nil,
+		time.Minute,
+		model.RewardRules{},
+		model.TransferRules{},
+		model.ResponsibleGamingRules{},
+	)
+}
+
+func gameHold(id, userID, amount int64, gameID string) model.ChipHold {
+	return model.ChipHold{ID: id, UserID: userID, Amount: amount, Reference: gameID, Status: model.HoldStatusHeld, ExpiresAt: time.Now().Add(time.Hour)}
+}
+
+func TestSettleMatchRejectsInvalidSettlements(t *testing.T) {
+	tests := []struct {
+		name       string
+		settlement model.Settlement
+	}{
+		{name: "no game id", settlement: model.Settlement{Deltas: []model.PlayerDelta{{UserID: 1, Delta: -10}, {UserID: 2, Delta: 10}}}},
+		{name: "no deltas", settlement: model.Settlement{GameID: "g1"}},
+		{name: "deltas don't sum to zero", settlement: model.Settlement{GameID: "g1", Deltas: []model.PlayerDelta{{UserID: 1, Delta: -10}, {UserID: 2, Delta: 5}}}},
+		{name: "same player twice", settlement: model.Settlement{GameID: "g1", Deltas: []model.PlayerDelta{{UserID: 1, Delta: -10}, {UserID: 1, Delta: 10}}}},
+		{name: "invalid user id", settlement: model.Settlement{GameID: "g1", Deltas: []model.PlayerDelta{{UserID: 0, Delta: -10}, {UserID: 2, Delta: 10}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ledger := &fakeLedgerRepo{}
+			uc := newSettlementTestUser(map[int64]int64{1: 100, 2: 100}, nil, ledger)
+			if _, err := uc.SettleMatch(context.Background(), tt.settlement); !errors.Is(err, model.ErrInvalidInput) {
+				t.Fatalf("SettleMatch = %v, want ErrInvalidInput", err)
+			}
+			if len(ledger.entries) != 0 {
+				t.Errorf("rejected settlement wrote %d entries", len(ledger.entries))
+			}
+		})
+	}
+}
+
+func TestSettleMatchSettlesAGameOnce(t *testing.T) {
+	ctx := context.Background()
+	ledger := &fakeLedgerRepo{}
+	holds := []model.ChipHold{gameHold(1, 1, 50, "g1"), gameHold(2, 2, 50, "g1")}
+	uc := newSettlementTestUser(map[int64]int64{1: 100, 2: 100}, holds, ledger)
+	settlement := model.Settlement{GameID: "g1", Deltas: []model.PlayerDelta{{UserID: 1, Delta: -50}, {UserID: 2, Delta: 50}}}
+
+	result, err := uc.SettleMatch(ctx, settlement)
+	if err != nil {
+		t.Fatalf("SettleMatch: %v", err)
+	}
+	want := []model.PlayerBalance{{UserID: 1, Balance: 50}, {UserID: 2, Balance: 150}}
+	if result.AlreadySettled || !reflect.DeepEqual(result.Balances, want) {
+		t.Errorf("SettleMatch = %+v, want balances %+v", result, want)
+	}
+	hr := uc.holdRepo.(*fakeHoldRepo)
+	for _, h := range hr.holds {
+		if h.Status != model.HoldStatusReleased {
+			t.Errorf("hold of user %d is %s, want released", h.UserID, h.Status)
+		}
+	}
+
+	// A retried settlement of the same game reports the balances without moving chips again
+	result, err = uc.SettleMatch(ctx, settlement)
+	if err != nil {
+		t.Fatalf("repeated SettleMatch: %v", err)
+	}
+	if !result.AlreadySettled || !reflect.DeepEqual(result.Balances, want) {
+		t.Errorf("repeated SettleMatch = %+v, want already settled with balances %+v", result, want)
+	}
+	if len(ledger.entries) != 2 {
+		t.Errorf("ledger has %d entries after the retry, want 2", len(ledger.entries))
+	}
+}
+
+func TestSettleMatchSplitsRakeAndPaysJackpot(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name        string
+		settlement  model.Settlement
+		pool        int64 // jackpot pool before the game
+		wantErr     error
+		wantHouse   int64
+		wantJackpot int64
+		wantPayout  int64
+		wantBalance map[int64]int64
+	}{
+		{
+			name:        "rake split between house and jackpot",
+			settlement:  model.Settlement{GameID: "g1", Deltas: []model.PlayerDelta{{UserID: 1, Delta: -100}, {UserID: 2, Delta: 90}}, Rake: 10, JackpotContribution: 3},
+			pool:        500,
+			wantHouse:   7,
+			wantJackpot: 503,
+			wantBalance: map[int64]int64{1: 0, 2: 190},
+		},
+		{
+			name:        "whole rake to the jackpot",
+			settlement:  model.Settlement{GameID: "g1", Deltas: []model.PlayerDelta{{UserID: 1, Delta: -100}, {UserID: 2, Delta: 90}}, Rake: 10, JackpotContribution: 10},
+			wantJackpot: 10,
+			wantBalance: map[int64]int64{1: 0, 2: 190},
+		},
+		{
+			name: "jackpot winner takes the pool with this game's contribution",
+			settlement: model.Settlement{
+				GameID: "g1", Deltas: []model.PlayerDelta{{UserID: 1, Delta: -100}, {UserID: 2, Delta: 90}},
+				Rake: 10, JackpotContribution: 3, JackpotWinnerID: 2,
+			},
+			pool:        500,
+			wantHouse:   7,
+			wantJackpot: 0,
+			wantPayout:  503,
+			wantBalance: map[int64]int64{1: 0, 2: 693},
+		},
+		{
+			name:       "contribution above the rake",
+			settlement: model.Settlement{GameID: "g1", Deltas: []model.PlayerDelta{{UserID: 1, Delta: -100}, {UserID: 2, Delta: 90}}, Rake: 10, JackpotContribution: 11},
+			wantErr:    model.ErrInvalidInput,
+		},
+		{
+			name:       "deltas don't cover the rake",
+			settlement: model.Settlement{GameID: "g1", Deltas: []model.PlayerDelta{{UserID: 1, Delta: -100}, {UserID: 2, Delta: 100}}, Rake: 10},
+			wantErr:    model.ErrInvalidInput,
+		},
+		{
+			name: "jackpot winner didn't play",
+			settlement: model.Settlement{
+				GameID: "g1", Deltas: []model.PlayerDelta{{UserID: 1, Delta: -100}, {UserID: 2, Delta: 90}},
+				Rake: 10, JackpotWinnerID: 3,
+			},
+			wantErr: model.ErrInvalidInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ledger := &fakeLedgerRepo{system: map[int64]int64{model.JackpotAccountID: tt.pool}}
+			uc := newSettlementTestUser(map[int64]int64{1: 100, 2: 100}, nil, ledger)
+
+			result, err := uc.SettleMatch(ctx, tt.settlement)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("SettleMatch = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SettleMatch: %v", err)
+			}
+			if result.JackpotPayout != tt.wantPayout {
+				t.Errorf("jackpot payout = %d, want %d", result.JackpotPayout, tt.wantPayout)
+			}
+			if ledger.system[model.HouseAccountID] != tt.wantHouse || ledger.system[model.JackpotAccountID] != tt.wantJackpot {
+				t.Errorf("house %d, jackpot %d; want %d and %d",
+					ledger.system[model.HouseAccountID], ledger.system[model.JackpotAccountID], tt.wantHouse, tt.wantJackpot)
+			}
+			for _, b := range result.Balances {
+				if b.Balance != tt.wantBalance[b.UserID] {
+					t.Errorf("balance of user %d = %d, want %d", b.UserID, b.Balance, tt.wantBalance[b.UserID])
+				}
+			}
+
+			// The retry neither takes the rake nor pays the jackpot again
+			result, err = uc.SettleMatch(ctx, tt.settlement)
+			if err != nil || !result.AlreadySettled || result.JackpotPayout != 0 {
+				t.Fatalf("repeated SettleMatch = (%+v, %v), want already settled without a payout", result, err)
+			}
+			if ledger.system[model.HouseAccountID] != tt.wantHouse || ledger.system[model.JackpotAccountID] != tt.wantJackpot {
+				t.Errorf("after the retry: house %d, jackpot %d; want %d and %d",
+					ledger.system[model.HouseAccountID], ledger.system[model.JackpotAccountID], tt.wantHouse, tt.wantJackpot)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"user_svc/internal/model"
+
+	"github.com/google/uuid"
+)
+
+// TransferChips moves chips between two players. Both accounts must be older than
+// the minimum account age and the transfer must fit into the daily caps of the
+// sender and the receiver. Chips held by running games can't be sent.
+func (uc *User) TransferChips(ctx context.Context, transfer model.Transfer) (model.TransferResult, error) {
+	if transfer.Amount <= 0 || transfer.FromUserID <= 0 || transfer.ToUserID <= 0 || transfer.FromUserID == transfer.ToUserID {
+		return model.TransferResult{}, model.ErrInvalidInput
+	}
+	if transfer.IdempotencyKey == "" {
+		transfer.IdempotencyKey = uuid.NewString()
+	}
+
+	now := time.Now().UTC()
+	dayStart := now.Truncate(24 * time.Hour)
+
+	var result model.TransferResult
+	txFn := func(ctx context.Context) error {
+		// Lock both balances in ascending ID order so opposite transfers can't deadlock.
+		balances := make(map[int64]int64, 2)
+		for _, userID := range []int64{min(transfer.FromUserID, transfer.ToUserID), max(transfer.FromUserID, transfer.ToUserID)} {
+			balance, err := uc.repo.GetBalanceForUpdate(ctx, userID)
+			if err != nil {
+				if errors.Is(err, model.ErrNotFound) {
+					return model.ErrUserNotFound
+				}
+				return err
+			}
+			balances[userID] = balance
+		}
+
+		for _, userID := range []int64{transfer.FromUserID, transfer.ToUserID} {
+			if err := uc.checkTransferAccount(ctx, userID, now); err != nil {
+				return err
+			}
+		}
+
+		sent, err := uc.ledgerRepo.SumEntriesSince(ctx, transfer.FromUserID, dayStart, model.EntryKindTransfer)
+		if err != nil {
+			return err
+		}
+		if sent.Debit+transfer.Amount > uc.transfers.DailySendCap {
+			return model.ErrTransferLimitExceeded
+		}
+		received, err := uc.ledgerRepo.SumEntriesSince(ctx, transfer.ToUserID, dayStart, model.EntryKindTransfer)
+		if err != nil {
+			return err
+		}
+		if received.Credit+transfer.Amount > uc.transfers.DailyReceiveCap {
+			return model.ErrTransferLimitExceeded
+		}
+
+		held, err := uc.holdRepo.SumActive(ctx, transfer.FromUserID)
+		if err != nil {
+			return err
+		}
+		if balances[transfer.FromUserID]-held < transfer.Amount {
+			return model.ErrNotEnoughBalance
+		}
+
+		posted, applied, err := uc.post(ctx, "transfer", fmt.Sprintf("transfer:%d:%s", transfer.FromUserID, transfer.IdempotencyKey), []model.Posting{
+			{AccountID: transfer.FromUserID, Kind: model.EntryKindTransfer, Amount: -transfer.Amount},
+			{AccountID: transfer.ToUserID, Kind: model.EntryKindTransfer, Amount: transfer.Amount},
+		})
+		if err != nil {
+			return err
+		}
+		if !applied {
+			result = model.TransferResult{
+				FromBalance:    balances[transfer.FromUserID],
+				ToBalance:      balances[transfer.ToUserID],
+				AlreadyApplied: true,
+			}
+			return nil
+		}
+
+		result = model.TransferResult{
+			FromBalance: posted[transfer.FromUserID],
+			ToBalance:   posted[transfer.ToUserID],
+		}
+		return nil
+	}
+	if err := uc.callTx(ctx, txFn); err != nil {
+		return model.TransferResult{}, fmt.Errorf("transfer chips transaction failed: %w", err)
+	}
+
+	_ = uc.cache.SetBalance(ctx, transfer.FromUserID, result.FromBalance)
+	_ = uc.cache.SetBalance(ctx, transfer.ToUserID, result.ToBalance)
+	if result.AlreadyApplied {
+		return result, nil
+	}
+
+	log.Printf("transferred %d chips from user %d to user %d (key %s)", transfer.Amount, transfer.FromUserID, transfer.ToUserID, transfer.IdempotencyKey)
+	uc.notifyTransfer(ctx, model.TransferNotification{
+		UserID:         transfer.FromUserID,
+		CounterpartyID: transfer.ToUserID,
+		Direction:      model.TransferDirectionSent,
+		Amount:         transfer.Amount,
+		Balance:        result.FromBalance,
+		CreatedAt:      now,
+	})
+	uc.notifyTransfer(ctx, model.TransferNotification{
+		UserID:         transfer.ToUserID,
+		CounterpartyID: transfer.FromUserID,
+		Direction:      model.TransferDirectionReceived,
+		Amount:         transfer.Amount,
+		Balance:        result.ToBalance,
+		CreatedAt:      now,
+	})
+
+	return result, nil
+}
+
+// checkTransferAccount makes sure the user exists and is old enough to take part in transfers.
+func (uc *User) checkTransferAccount(ctx context.Context, userID int64, now time.Time) error {
+	user, err := uc.repo.GetWithFilter(ctx, model.UserFilter{ID: &userID})
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return model.ErrUserNotFound
+		}
+		return err
+	}
+	if user.IsDeleted {
+		return model.ErrUserNotFound
+	}
+	if now.Sub(user.CreatedAt) < uc.transfers.MinAccountAge {
+		return model.ErrAccountTooNew
+	}
+	return nil
+}
+
+// notifyTransfer publishes the notification. The transfer is already committed,
+// so a failed publish is only logged.
+func (uc *User) notifyTransfer(ctx context.Context, notification model.TransferNotification) {
+	if err := uc.producer.PushChipTransfer(ctx, notification); err != nil {
+		log.Printf("failed to push chip transfer notification for user %d: %v", notification.UserID, err)
+	}
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"user_svc/internal/model"
+)
+
+type fakeTransferProducer struct {
+	UserEventProducer
+	sent []model.TransferNotification
+}
+
+func (p *fakeTransferProducer) PushChipTransfer(_ context.Context, n model.TransferNotification) error {
+	p.sent = append(p.sent, n)
+	return nil
+}
+
+var testTransferRules = model.TransferRules{DailySendCap: 1000, DailyReceiveCap: 1500, MinAccountAge: 7 * 24 * time.Hour}
+
+// newTransferTestUser registers users 1..3 with 500 chips and user 4 with 5000; user 3 signed up yesterday.
+func newTransferTestUser(ledger *fakeLedgerRepo, holds ...model.ChipHold) *User {
+	callTx := func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) }
+	old := time.Now().AddDate(0, -1, 0)
+	users := &fakeUserRepo{
+		balances: map[int64]int64{1: 500, 2: 500, 3: 500, 4: 5000},
+		created:  map[int64]time.Time{1: old, 2: old, 3: time.Now().AddDate(0, 0, -1), 4: old},
+	}
+	return NewUser(
+		users,
+		&fakeHoldRepo{holds: holds},
+		ledger,
+		nil,
+		nil,
+		callTx,
+		fakeCache{},
+		&fakeTransferProducer{},
+		time.Minute,
+		model.RewardRules{},
+		testTransferRules,
+		model.ResponsibleGamingRules{},
+	)
+}
+
+// transferEntry is a transfer made earlier today.
+func transferEntry(accountID, amount int64) model.LedgerEntry {
+	return model.LedgerEntry{AccountID: accountID, Kind: model.EntryKindTransfer, Amount: amount}
+}
+
+func TestTransferChips(t *testing.T) {
+	tests := []struct {
+		name     string
+		transfer model.Transfer
+		earlier  []model.LedgerEntry
+		holds    []model.ChipHold
+		wantErr  error
+		wantFrom int64
+		wantTo   int64
+	}{
+		{name: "transfer", transfer: model.Transfer{FromUserID: 1, ToUserID: 2, Amount: 200}, wantFrom: 300, wantTo: 700},
+		{name: "to oneself", transfer: model.Transfer{FromUserID: 1, ToUserID: 1, Amount: 200}, wantErr: model.ErrInvalidInput},
+		{name: "nothing", transfer: model.Transfer{FromUserID: 1, ToUserID: 2}, wantErr: model.ErrInvalidInput},
+		{name: "unknown receiver", transfer: model.Transfer{FromUserID: 1, ToUserID: 9, Amount: 10}, wantErr: model.ErrUserNotFound},
+		{name: "new sender", transfer: model.Transfer{FromUserID: 3, ToUserID: 2, Amount: 10}, wantErr: model.ErrAccountTooNew},
+		{name: "new receiver", transfer: model.Transfer{FromUserID: 1, ToUserID: 3, Amount: 10}, wantErr: model.ErrAccountTooNew},
+		{
+			name:     "up to the send cap",
+			transfer: model.Transfer{FromUserID: 4, ToUserID: 2, Amount: 400},
+			earlier:  []model.LedgerEntry{transferEntry(4, -600)},
+			wantFrom: 4600, wantTo: 900,
+		},
+		{
+			name:     "over the send cap",
+			transfer: model.Transfer{FromUserID: 4, ToUserID: 2, Amount: 401},
+			earlier:  []model.LedgerEntry{transferEntry(4, -600)},
+			wantErr:  model.ErrTransferLimitExceeded,
+		},
+		{
+			// Chips the sender received today don't count against what they can send
+			name:     "received chips don't use up the send cap",
+			transfer: model.Transfer{FromUserID: 1, ToUserID: 2, Amount: 500},
+			earlier:  []model.LedgerEntry{transferEntry(1, 900)},
+			wantFrom: 0, wantTo: 1000,
+		},
+		{
+			name:     "over the receive cap",
+			transfer: model.Transfer{FromUserID: 1, ToUserID: 2, Amount: 100},
+			earlier:  []model.LedgerEntry{transferEntry(2, 1000), transferEntry(2, 450)},
+			wantErr:  model.ErrTransferLimitExceeded,
+		},
+		{
+			name:     "held chips can't be sent",
+			transfer: model.Transfer{FromUserID: 1, ToUserID: 2, Amount: 400},
+			holds:    []model.ChipHold{gameHold(1, 1, 150, "g1")},
+			wantErr:  model.ErrNotEnoughBalance,
+		},
+		{
+			name:     "free chips next to held ones can",
+			transfer: model.Transfer{FromUserID: 1, ToUserID: 2, Amount: 350},
+			holds:    []model.ChipHold{gameHold(1, 1, 150, "g1")},
+			wantFrom: 150, wantTo: 850,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ledger := &fakeLedgerRepo{entries: tt.earlier}
+			uc := newTransferTestUser(ledger, tt.holds...)
+
+			result, err := uc.TransferChips(context.Background(), tt.transfer)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("TransferChips = %v, want %v", err, tt.wantErr)
+				}
+				if len(ledger.entries) != len(tt.earlier) {
+					t.Errorf("rejected transfer wrote %d entries", len(ledger.entries)-len(tt.earlier))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TransferChips: %v", err)
+			}
+			if result.FromBalance != tt.wantFrom || result.ToBalance != tt.wantTo {
+				t.Errorf("TransferChips = %+v, want balances %d and %d", result, tt.wantFrom, tt.wantTo)
+			}
+			if sent := uc.producer.(*fakeTransferProducer).sent; len(sent) != 2 {
+				t.Errorf("pushed %d notifications, want 2", len(sent))
+			}
+		})
+	}
+}
+
+func TestTransferChipsAppliesAKeyOnce(t *testing.T) {
+	ctx := context.Background()
+	ledger := &fakeLedgerRepo{}
+	uc := newTransferTestUser(ledger)
+	transfer := model.Transfer{FromUserID: 1, ToUserID: 2, Amount: 100, IdempotencyKey: "gift-1"}
+
+	if _, err := uc.TransferChips(ctx, transfer); err != nil {
+		t.Fatalf("TransferChips: %v", err)
+	}
+	result, err := uc.TransferChips(ctx, transfer)
+	if err != nil {
+		t.Fatalf("repeated TransferChips: %v", err)
+	}
+	if !result.AlreadyApplied || result.FromBalance != 400 || result.ToBalance != 600 {
+		t.Errorf("repeated TransferChips = %+v, want already applied with balances 400 and 600", result)
+	}
+	if sent := uc.producer.(*fakeTransferProducer).sent; len(sent) != 2 {
+		t.Errorf("pushed %d notifications, want 2 for the single transfer", len(sent))
+	}
+}
@@ -4,26 +4,56 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 	"user_svc/pkg/transactor"
 
 	"user_svc/internal/model"
+
+	"github.com/google/uuid"
 )
 
 type User struct {
-	repo   UserRepo
-	callTx transactor.WithinTransactionFunc
-	cache  UserCache
+	repo       UserRepo
+	holdRepo   HoldRepo
+	ledgerRepo LedgerRepo
+	rewardRepo RewardRepo
+	gamingRepo ResponsibleGamingRepo
+	callTx     transactor.WithinTransactionFunc
+	cache      UserCache
+	producer   UserEventProducer
+	holdTTL    time.Duration
+	rewards    model.RewardRules
+	transfers  model.TransferRules
+	gaming     model.ResponsibleGamingRules
 }
 
 func NewUser(
 	repo UserRepo,
+	holdRepo HoldRepo,
+	ledgerRepo LedgerRepo,
+	rewardRepo RewardRepo,
+	gamingRepo ResponsibleGamingRepo,
 	callTx transactor.WithinTransactionFunc,
 	cache UserCache,
+	producer UserEventProducer,
+	holdTTL time.Duration,
+	rewards model.RewardRules,
+	transfers model.TransferRules,
+	gaming model.ResponsibleGamingRules,
 ) *User {
 	return &User{
-		repo:   repo,
-		callTx: callTx,
-		cache:  cache,
+		repo:       repo,
+		holdRepo:   holdRepo,
+		ledgerRepo: ledgerRepo,
+		rewardRepo: rewardRepo,
+		gamingRepo: gamingRepo,
+		callTx:     callTx,
+		cache:      cache,
+		producer:   producer,
+		holdTTL:    holdTTL,
+		rewards:    rewards,
+		transfers:  transfers,
+		gaming:     gaming,
 	}
 }
 
@@ -49,8 +79,21 @@ func (uc *User) UpdateUsername(ctx context.Context, updateData model.UserUpdateD
 }
 
 func (uc *User) RecordUser(ctx context.Context, request model.User) error {
-	err := uc.repo.Create(ctx, request)
-	if err != nil {
+	txFn := func(ctx context.Context) error {
+		if err := uc.repo.Create(ctx, request); err != nil {
+			return err
+		}
+		// Пользователь создаётся со стартовым балансом по умолчанию, заносим его в журнал
+		balance, err := uc.repo.GetBalanceForUpdate(ctx, request.ID)
+		if err != nil {
+			return err
+		}
+		if balance == 0 {
+			return nil
+		}
+		return uc.recordOpeningBalance(ctx, request.ID, balance)
+	}
+	if err := uc.callTx(ctx, txFn); err != nil {
 		if errors.Is(err, model.ErrEmailAlreadyRegistered) {
 			return model.ErrEmailAlreadyRegistered
 		}
@@ -77,34 +120,63 @@ func (uc *User) GetBalance(ctx context.Context, userID int64) (int64, error) {
 	return balance, nil
 }
 
-func (uc *User) AddBalance(ctx context.Context, userID int64, delta int64) error {
-	balance, err := uc.GetBalance(ctx, userID)
-	if err != nil {
-		return err
+// AddBalance credits the user as an admin adjustment. A repeated idempotency key is a no-op;
+// an empty key makes every call a new adjustment.
+func (uc *User) AddBalance(ctx context.Context, userID int64, delta int64, idempotencyKey string) error {
+	if delta <= 0 {
+		return model.ErrInvalidInput
 	}
-	newBalance := balance + delta
+	return uc.adjustBalance(ctx, userID, delta, idempotencyKey)
+}
 
-	if err := uc.repo.UpdateBalance(ctx, userID, newBalance); err != nil {
-		return err
+// SubtractBalance debits the user as an admin adjustment. Chips held by running games can't be taken.
+func (uc *User) SubtractBalance(ctx context.Context, userID int64, delta int64, idempotencyKey string) error {
+	if delta <= 0 {
+		return model.ErrInvalidInput
 	}
-
-	return uc.cache.SetBalance(ctx, userID, newBalance)
+	return uc.adjustBalance(ctx, userID, -delta, idempotencyKey)
 }
 
-func (uc *User) SubtractBalance(ctx context.Context, userID int64, delta int64) error {
-	balance, err := uc.GetBalance(ctx, userID)
-	if err != nil {
-		return err
+func (uc *User) adjustBalance(ctx context.Context, userID int64, delta int64, idempotencyKey string) error {
+	if idempotencyKey == "" {
+		idempotencyKey = uuid.NewString()
 	}
-	if balance < delta {
-		return model.ErrNotEnoughBalance
-	}
-	newBalance := balance - delta
 
-	if err := uc.repo.UpdateBalance(ctx, userID, newBalance); err != nil {
+	var newBalance int64
+	var applied bool
+	txFn := func(ctx context.Context) error {
+		balance, err := uc.repo.GetBalanceForUpdate(ctx, userID)
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				return model.ErrUserNotFound
+			}
+			return err
+		}
+		if delta < 0 {
+			held, err := uc.holdRepo.SumActive(ctx, userID)
+			if err != nil {
+				return err
+			}
+			if balance-held < -delta {
+				return model.ErrNotEnoughBalance
+			}
+		}
+
+		// The key comes from the caller, so it is scoped to the user and kept apart from the keys of other postings
+		balances, ok, err := uc.post(ctx, "admin", fmt.Sprintf("admin:%d:%s", userID, idempotencyKey), []model.Posting{
+			{AccountID: userID, Kind: model.EntryKindAdminAdjustment, Amount: delta},
+			{AccountID: model.MintAccountID, Kind: model.EntryKindAdminAdjustment, Amount: -delta},
+		})
+		newBalance, applied = balances[userID], ok
 		return err
 	}
+	if err := uc.callTx(ctx, txFn); err != nil {
+		return fmt.Errorf("adjust balance transaction failed: %w", err)
+	}
 
+	if !applied {
+		return nil
+	}
 	return uc.cache.SetBalance(ctx, userID, newBalance)
 }
 
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS chip_holds (
+    id         BIGSERIAL PRIMARY KEY,
+    user_id    BIGINT      NOT NULL REFERENCES users (id),
+    amount     BIGINT      NOT NULL CHECK (amount > 0),
+    reference  TEXT        NOT NULL,
+    status     TEXT        NOT NULL DEFAULT 'held',
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, reference)
+);
+
+CREATE INDEX IF NOT EXISTS chip_holds_active_idx ON chip_holds (user_id) WHERE status = 'held';
+CREATE INDEX IF NOT EXISTS chip_holds_expires_at_idx ON chip_holds (expires_at) WHERE status = 'held';
@@ -0,0 +1,51 @@
+CREATE TABLE IF NOT EXISTS ledger_transactions (
+    id              BIGSERIAL PRIMARY KEY,
+    reference       TEXT        NOT NULL,
+    idempotency_key TEXT        NOT NULL UNIQUE,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- account_id is a user ID, negative IDs are system accounts (-1 = mint).
+CREATE TABLE IF NOT EXISTS ledger_entries (
+    id             BIGSERIAL PRIMARY KEY,
+    transaction_id BIGINT      NOT NULL REFERENCES ledger_transactions (id),
+    account_id     BIGINT      NOT NULL,
+    kind           TEXT        NOT NULL,
+    amount         BIGINT      NOT NULL CHECK (amount <> 0),
+    balance_after  BIGINT,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS ledger_entries_account_idx ON ledger_entries (account_id, id DESC);
+CREATE INDEX IF NOT EXISTS ledger_entries_transaction_idx ON ledger_entries (transaction_id);
+
+-- Entries are immutable, corrections are posted as new transactions.
+CREATE OR REPLACE FUNCTION ledger_entries_immutable() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'ledger entries are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS ledger_entries_immutable ON ledger_entries;
+CREATE TRIGGER ledger_entries_immutable
+    BEFORE UPDATE OR DELETE ON ledger_entries
+    FOR EACH ROW EXECUTE FUNCTION ledger_entries_immutable();
+
+-- Existing balances become opening entries so the ledger adds up to users.balance.
+INSERT INTO ledger_transactions (reference, idempotency_key)
+SELECT 'opening', 'opening:' || id FROM users WHERE balance <> 0
+ON CONFLICT (idempotency_key) DO NOTHING;
+
+INSERT INTO ledger_entries (transaction_id, account_id, kind, amount, balance_after)
+SELECT t.id, u.id, 'opening_balance', u.balance, u.balance
+FROM users u
+JOIN ledger_transactions t ON t.idempotency_key = 'opening:' || u.id
+WHERE u.balance <> 0
+  AND NOT EXISTS (SELECT 1 FROM ledger_entries e WHERE e.transaction_id = t.id);
+
+INSERT INTO ledger_entries (transaction_id, account_id, kind, amount)
+SELECT t.id, -1, 'opening_balance', -u.balance
+FROM users u
+JOIN ledger_transactions t ON t.idempotency_key = 'opening:' || u.id
+WHERE u.balance <> 0
+  AND NOT EXISTS (SELECT 1 FROM ledger_entries e WHERE e.transaction_id = t.id AND e.account_id = -1);
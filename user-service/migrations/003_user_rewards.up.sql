@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS user_rewards (
+    user_id             BIGINT PRIMARY KEY REFERENCES users (id),
+    daily_streak        BIGINT      NOT NULL DEFAULT 0,
+    last_daily_claim_at TIMESTAMPTZ,
+    last_refill_at      TIMESTAMPTZ,
+    updated_at          TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
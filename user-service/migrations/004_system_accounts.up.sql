@@ -0,0 +1,15 @@
+-- Balances of system accounts, kept next to their ledger entries like users.balance.
+CREATE TABLE IF NOT EXISTS system_accounts (
+    id      BIGINT PRIMARY KEY CHECK (id < 0),
+    name    TEXT   NOT NULL UNIQUE,
+    balance BIGINT NOT NULL DEFAULT 0
+);
+
+INSERT INTO system_accounts (id, name) VALUES
+    (-1, 'mint'),
+    (-2, 'house'),
+    (-3, 'jackpot')
+ON CONFLICT (id) DO NOTHING;
+
+UPDATE system_accounts a
+SET balance = COALESCE((SELECT SUM(e.amount) FROM ledger_entries e WHERE e.account_id = a.id), 0);
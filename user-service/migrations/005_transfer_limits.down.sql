@@ -0,0 +1 @@
+DROP INDEX IF EXISTS ledger_entries_account_kind_created_idx;
@@ -0,0 +1,2 @@
+-- Daily transfer caps sum today's transfer entries of an account.
+CREATE INDEX IF NOT EXISTS ledger_entries_account_kind_created_idx ON ledger_entries (account_id, kind, created_at);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS chip_holds_user_created_idx;
+DROP TABLE IF EXISTS self_exclusions;
+DROP TABLE IF EXISTS gaming_limits;
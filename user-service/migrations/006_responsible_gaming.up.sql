@@ -0,0 +1,20 @@
+-- Limits players set on their own play. Loosening a limit is stored as a pending
+-- value that takes over once pending_from has passed.
+CREATE TABLE IF NOT EXISTS gaming_limits (
+    user_id       BIGINT      NOT NULL REFERENCES users (id),
+    kind          TEXT        NOT NULL,
+    value         BIGINT      NOT NULL DEFAULT 0 CHECK (value >= 0),
+    pending_value BIGINT CHECK (pending_value >= 0),
+    pending_from  TIMESTAMPTZ,
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, kind)
+);
+
+CREATE TABLE IF NOT EXISTS self_exclusions (
+    user_id        BIGINT PRIMARY KEY REFERENCES users (id),
+    excluded_until TIMESTAMPTZ NOT NULL,
+    updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Wager limits sum the holds a user placed during the day or week.
+CREATE INDEX IF NOT EXISTS chip_holds_user_created_idx ON chip_holds (user_id, created_at);
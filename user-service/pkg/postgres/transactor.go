@@ -54,3 +54,18 @@ func TxFromCtx(ctx context.Context) (*sql.Tx, bool) {
 	tx, ok := ctx.Value(txKey{}).(*sql.Tx)
 	return tx, ok
 }
+
+// Executor is the subset of *sql.DB and *sql.Tx used by repositories.
+type Executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// ExecutorFromCtx returns the transaction stored in ctx, falling back to db.
+func ExecutorFromCtx(ctx context.Context, db *sql.DB) Executor {
+	if tx, ok := TxFromCtx(ctx); ok {
+		return tx
+	}
+	return db
+}